@@ -8,6 +8,9 @@ import (
 
 	"github.com/Pro7ech/lattigo/utils/concurrency"
 
+	"app/oracle"
+	"app/refresh"
+
 	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
@@ -18,33 +21,37 @@ type Bootstrapper struct {
 	hefloat.Parameters
 	Bootstrappers []he.Bootstrapper[rlwe.Ciphertext]
 	Count         int
-	Sk            *rlwe.SecretKey
-	Debug         bool
+
+	// Oracle, if not nil, decrypts the ciphertexts before and
+	// after the bootstrapping to print their range and error.
+	Oracle oracle.Oracle
 }
 
 func (btp *Bootstrapper) BootstrappingParameters() bootstrapping.Parameters {
 	return btp.Bootstrappers[0].(*bootstrapping.Evaluator).Parameters
 }
 
-func NewBootstrapper(NumCPU int, btpParams bootstrapping.Parameters, sk *rlwe.SecretKey) *Bootstrapper {
-
-	fmt.Println(btpParams.BootstrappingParameters.LogN(), btpParams.BootstrappingParameters.LogQP())
+// NewBootstrapper generates the bootstrapping keys under sk and returns a
+// Bootstrapper with NumCPU bootstrapping evaluators sharing them.
+func NewBootstrapper(NumCPU int, btpParams bootstrapping.Parameters, sk *rlwe.SecretKey) (*Bootstrapper, error) {
 
-	fmt.Println("Generating Bootstrapping Keys")
-	now := time.Now()
 	evkBTP, _, err := GenEvaluationKeys(NumCPU, sk, btpParams)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("[bootstrapping].GenEvaluationKeys: %w", err)
 	}
-	fmt.Printf("%s\n", time.Since(now))
 
-	fmt.Println("Instantiating Bootstrapper")
-	now = time.Now()
+	return NewBootstrapperFromKeys(NumCPU, btpParams, evkBTP)
+}
+
+// NewBootstrapperFromKeys returns a Bootstrapper with NumCPU bootstrapping
+// evaluators sharing the given keys, e.g. generated by the client and loaded
+// with keys.LoadBootstrappingKeys.
+func NewBootstrapperFromKeys(NumCPU int, btpParams bootstrapping.Parameters, evkBTP *bootstrapping.EvaluationKeys) (*Bootstrapper, error) {
+
 	btp, err := bootstrapping.NewEvaluator(btpParams, evkBTP)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("[bootstrapping].NewEvaluator: %w", err)
 	}
-	fmt.Printf("%s\n", time.Since(now))
 
 	Bootstrappers := make([]he.Bootstrapper[rlwe.Ciphertext], NumCPU)
 	Bootstrappers[0] = btp
@@ -52,7 +59,7 @@ func NewBootstrapper(NumCPU int, btpParams bootstrapping.Parameters, sk *rlwe.Se
 		Bootstrappers[i+1] = btp.ShallowCopy()
 	}
 
-	return &Bootstrapper{Bootstrappers: Bootstrappers, Parameters: btpParams.ResidualParameters, Sk: sk}
+	return &Bootstrapper{Bootstrappers: Bootstrappers, Parameters: btpParams.ResidualParameters}, nil
 }
 
 func NewDummyBootstrapper(NumCPU int, params hefloat.Parameters, sk *rlwe.SecretKey) *Bootstrapper {
@@ -62,31 +69,46 @@ func NewDummyBootstrapper(NumCPU int, params hefloat.Parameters, sk *rlwe.Secret
 		Bootstrappers[i] = bootstrapping.NewSecretKeyBootstrapper(params, sk)
 	}
 
-	return &Bootstrapper{Bootstrappers: Bootstrappers, Parameters: params, Sk: sk}
+	return &Bootstrapper{Bootstrappers: Bootstrappers, Parameters: params}
+}
+
+// NewInteractiveBootstrapper returns a Bootstrapper that refreshes the ciphertexts
+// with the help of the client through the given transport (see package refresh).
+func NewInteractiveBootstrapper(NumCPU int, params hefloat.Parameters, p refresh.Parameters, transport refresh.Transport) *Bootstrapper {
+
+	Bootstrappers := make([]he.Bootstrapper[rlwe.Ciphertext], NumCPU)
+	for i := range NumCPU {
+		btp, err := refresh.NewBootstrapper(params, p, transport)
+		if err != nil {
+			panic(err)
+		}
+		Bootstrappers[i] = btp
+	}
+
+	return &Bootstrapper{Bootstrappers: Bootstrappers, Parameters: params}
 }
 
 func (btp *Bootstrapper) Bootstrap(ct *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
-	cts := []rlwe.Ciphertext{*ct}
-	var err error
-	cts, err = btp.BootstrapMany(cts)
-	return &cts[0], err
+	cts, err := btp.BootstrapMany([]rlwe.Ciphertext{*ct})
+	if err != nil {
+		return nil, err
+	}
+	return &cts[0], nil
 }
 
 func (btp *Bootstrapper) BootstrapMany(cts []rlwe.Ciphertext) ([]rlwe.Ciphertext, error) {
 
-	var dec *rlwe.Decryptor
-	var ecd *hefloat.Encoder
+	var err error
 	var before [][]float64
-	if btp.Debug {
-		dec = rlwe.NewDecryptor(btp.Parameters, btp.Sk)
-		ecd = hefloat.NewEncoder(btp.Parameters)
+	if btp.Oracle != nil {
 
 		before = make([][]float64, len(cts))
 
 		Max := []float64{}
 		for i := range cts {
-			before[i] = make([]float64, cts[i].Slots())
-			ecd.Decode(dec.DecryptNew(&cts[i]), before[i])
+			if before[i], err = btp.Oracle.DecryptNew("Bootstrapper.BootstrapMany: before", &cts[i]); err != nil {
+				return nil, fmt.Errorf("[oracle.Oracle][DecryptNew]: %w", err)
+			}
 			Max = append(Max, slices.Max(before[i]), slices.Min(before[i]))
 		}
 
@@ -95,7 +117,6 @@ func (btp *Bootstrapper) BootstrapMany(cts []rlwe.Ciphertext) ([]rlwe.Ciphertext
 		fmt.Printf("	BootstrapMany %d %f: %d->%d ", len(cts), cts[0].LogScale(), cts[0].Level(), btp.OutputLevel())
 	}
 
-	var err error
 	m := concurrency.NewRessourceManager[he.Bootstrapper[rlwe.Ciphertext]](btp.Bootstrappers)
 	now := time.Now()
 	for i := 0; i < (len(cts)+1)>>1; i++ {
@@ -124,12 +145,13 @@ func (btp *Bootstrapper) BootstrapMany(cts []rlwe.Ciphertext) ([]rlwe.Ciphertext
 
 	since := time.Since(now)
 
-	if btp.Debug {
+	if btp.Oracle != nil {
 		after := make([][]float64, len(cts))
 		Max := []float64{}
 		for i := range cts {
-			after[i] = make([]float64, cts[i].Slots())
-			ecd.Decode(dec.DecryptNew(&cts[i]), after[i])
+			if after[i], err = btp.Oracle.DecryptNew("Bootstrapper.BootstrapMany: after", &cts[i]); err != nil {
+				return nil, fmt.Errorf("[oracle.Oracle][DecryptNew]: %w", err)
+			}
 			Max = append(Max, slices.Max(after[i]), slices.Min(after[i]))
 		}
 
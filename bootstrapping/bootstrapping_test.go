@@ -1,10 +1,12 @@
-package bootstrapping
+package bootstrapping_test
 
 import (
 	"fmt"
 	"testing"
 	"time"
 
+	"app/lib"
+
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
 	"github.com/Pro7ech/lattigo/utils/sampling"
@@ -14,10 +16,10 @@ import (
 
 func TestBootstrapping(t *testing.T) {
 
-	params := lib.NewParametersAtLevel(12)
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -26,7 +28,7 @@ func TestBootstrapping(t *testing.T) {
 	sk := kgen.GenSecretKeyNew()
 	enc := rlwe.NewEncryptor(params, sk)
 	dec := rlwe.NewDecryptor(params, sk)
-	btp := NewBootstrapper(sk)
+	btp := lib.NewBootstrapper(params, sk)
 
 	/*
 		c := client.NewClient(params, sk)
@@ -1,6 +1,8 @@
 package bootstrapping
 
 import (
+	"fmt"
+
 	"app/keys"
 
 	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
@@ -53,7 +55,9 @@ func GenEvaluationKeys(NumCPU int, skN1 *rlwe.SecretKey, p bootstrapping.Paramet
 
 	galEls := append(p.GaloisElements(paramsN2), paramsN2.GaloisElementForComplexConjugation())
 	km := keys.NewManager(NumCPU, paramsN2, len(galEls), skN2)
-	km.LoadGaloisKeys(galEls)
+	if err = km.LoadGaloisKeys(galEls); err != nil {
+		return nil, nil, fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+	}
 
 	return &bootstrapping.EvaluationKeys{
 		EvkN1ToN2:           EvkN1ToN2,
@@ -0,0 +1,326 @@
+package bootstrapping
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+)
+
+// NoiseModel is the distribution of the error that a bootstrapping adds to
+// each slot: a centered Gaussian, in the unit of the messages (on the real
+// and on the imaginary part of the slots of the standard ring), whose
+// standard deviation depends on the root mean square r of the slots of the
+// bootstrapped ciphertext:
+//
+//	sqrt(2^(2*LogStd) + (2^LogLinear * r)^2 + (2^LogCubic * r^3)^2)
+//
+// The first term does not depend on the messages, the second one is the
+// relative error of the DFTs and the third one is the error of the
+// approximation of the modular reduction, which grows with the cube of the
+// coefficients of the messages. It is measured with MeasureNoise on
+// residual parameters of degree 2^LogN.
+//
+// Each term grows, in bits per doubling of the degree, by GrowthStd,
+// GrowthLinear and GrowthCubic, measured with WithGrowth or WithExcessGrowth
+// (see At).
+type NoiseModel struct {
+	LogN      int
+	LogStd    float64
+	LogLinear float64
+	LogCubic  float64
+
+	GrowthStd    float64
+	GrowthLinear float64
+	GrowthCubic  float64
+}
+
+func (n NoiseModel) String() string {
+	return fmt.Sprintf("{LogN=%d, LogStd=%.2f, LogLinear=%.2f, LogCubic=%.2f, Growth=[%.2f %.2f %.2f]}", n.LogN, n.LogStd, n.LogLinear, n.LogCubic, n.GrowthStd, n.GrowthLinear, n.GrowthCubic)
+}
+
+// StdDev returns the standard deviation of the error of the bootstrapping
+// of messages of root mean square rms.
+func (n NoiseModel) StdDev(rms float64) float64 {
+	return math.Sqrt(math.Exp2(2*n.LogStd) + math.Exp2(2*n.LogLinear)*math.Pow(rms, 2) + math.Exp2(2*n.LogCubic)*math.Pow(rms, 6))
+}
+
+// At returns the model on residual parameters of degree 2^LogN, whose
+// terms grow linearly in LogN by the growth of the model. For bootstrapping
+// parameters whose message ratio decreases by one bit when the degree
+// doubles, as those of lib.Configuration, the coefficients of the messages
+// grow by half a bit relative to the modulus, so that the cubic term grows
+// by about one bit per doubling.
+func (n NoiseModel) At(LogN int) NoiseModel {
+	d := float64(LogN - n.LogN)
+	n.LogStd += d * n.GrowthStd
+	n.LogLinear += d * n.GrowthLinear
+	n.LogCubic += d * n.GrowthCubic
+	n.LogN = LogN
+	return n
+}
+
+// WithGrowth returns n with the growth of its terms measured between the
+// model low, of a smaller degree, and n. The growths are at least zero, and
+// the one of the cubic term at least one bit (see At), so that the error is
+// not underestimated when the model is brought to larger degrees. The
+// growth of a term that is not resolved (-Inf) at both degrees is zero.
+func (n NoiseModel) WithGrowth(low NoiseModel) (NoiseModel, error) {
+
+	if low.LogN >= n.LogN {
+		return n, fmt.Errorf("invalid noise model: LogN=%d of the low model is not smaller than LogN=%d", low.LogN, n.LogN)
+	}
+
+	growth := func(lo, hi float64) float64 {
+		if math.IsInf(lo, -1) || math.IsInf(hi, -1) {
+			return 0
+		}
+		return max((hi-lo)/float64(n.LogN-low.LogN), 0)
+	}
+
+	n.GrowthStd = growth(low.LogStd, n.LogStd)
+	n.GrowthLinear = growth(low.LogLinear, n.LogLinear)
+	n.GrowthCubic = max(growth(low.LogCubic, n.LogCubic), 1)
+
+	return n, nil
+}
+
+// WithExcessGrowth returns n with the growth of its terms measured as by
+// WithGrowth, except that the growth of the constant and linear terms is
+// measured on the part of their variance which exceeds the one of base, of
+// a smaller degree than low, if this part is at least the one of base at
+// the degree of low. If a term is the sum of a part which does not depend
+// on the degree and of a part which grows, the growth of the latter is
+// larger than the one of the sum, which WithGrowth would underestimate at
+// larger degrees. The cubic term has no part which does not depend on the
+// degree.
+func (n NoiseModel) WithExcessGrowth(base, low NoiseModel) (NoiseModel, error) {
+
+	if base.LogN >= low.LogN {
+		return n, fmt.Errorf("invalid noise model: LogN=%d of the base model is not smaller than LogN=%d", base.LogN, low.LogN)
+	}
+
+	var err error
+	if n, err = n.WithGrowth(low); err != nil {
+		return n, err
+	}
+
+	growth := func(b, lo, hi, g float64) float64 {
+		vb, vlo, vhi := math.Exp2(2*b), math.Exp2(2*lo), math.Exp2(2*hi)
+		if math.IsInf(b, -1) || vlo < 2*vb || vhi <= vlo {
+			return g
+		}
+		return max((math.Log2(vhi-vb)-math.Log2(vlo-vb))/float64(2*(n.LogN-low.LogN)), g)
+	}
+
+	n.GrowthStd = growth(base.LogStd, low.LogStd, n.LogStd, n.GrowthStd)
+	n.GrowthLinear = growth(base.LogLinear, low.LogLinear, n.LogLinear, n.GrowthLinear)
+
+	return n, nil
+}
+
+// SimulatedBootstrapper is an implementation of he.Bootstrapper that, as
+// the secret-key bootstrapper of NewDummyBootstrapper, decrypts and
+// re-encrypts the ciphertexts at the output level, but adds to each slot
+// an error drawn from Noise, given the root mean square of the slots of
+// each ciphertext, so that the precision of the circuit matches the one
+// with the real bootstrapper without its cost.
+type SimulatedBootstrapper struct {
+	hefloat.Parameters
+	*hefloat.Encoder
+	*rlwe.Decryptor
+	*rlwe.Encryptor
+	Noise  NoiseModel
+	rand   *rand.Rand
+	values []complex128
+}
+
+// NewSimulatedBootstrapper returns a Bootstrapper with NumCPU simulated
+// bootstrappers, which bootstrap the ciphertexts of params under sk with
+// the error of the given model. The errors are drawn from a generator
+// seeded with seed, so that experiments can be reproduced.
+func NewSimulatedBootstrapper(NumCPU int, params hefloat.Parameters, sk *rlwe.SecretKey, noise NoiseModel, seed [32]byte) *Bootstrapper {
+
+	source := sampling.NewSource(seed)
+
+	Bootstrappers := make([]he.Bootstrapper[rlwe.Ciphertext], NumCPU)
+	for i := range NumCPU {
+		Bootstrappers[i] = &SimulatedBootstrapper{
+			Parameters: params,
+			Encoder:    hefloat.NewEncoder(params),
+			Decryptor:  rlwe.NewDecryptor(params, sk),
+			Encryptor:  rlwe.NewEncryptor(params, sk),
+			Noise:      noise,
+			rand:       rand.New(rand.NewChaCha8(source.NewSeed())),
+			values:     make([]complex128, params.MaxSlots()),
+		}
+	}
+
+	return &Bootstrapper{Bootstrappers: Bootstrappers, Parameters: params}
+}
+
+func (b *SimulatedBootstrapper) Bootstrap(ct *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
+
+	values := b.values[:ct.Slots()]
+
+	if err := b.Decode(b.DecryptNew(ct), values); err != nil {
+		return nil, fmt.Errorf("[hefloat.Encoder].Decode: %w", err)
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += real(v)*real(v) + imag(v)*imag(v)
+	}
+
+	std := b.Noise.StdDev(math.Sqrt(sum / float64(len(values))))
+	for i := range values {
+		values[i] += complex(b.rand.NormFloat64()*std, 0)
+	}
+
+	if b.RingType() == ring.Standard {
+		for i := range values {
+			values[i] += complex(0, b.rand.NormFloat64()*std)
+		}
+	}
+
+	pt := hefloat.NewPlaintext(b.Parameters, b.MaxLevel())
+	pt.MetaData = ct.MetaData
+	pt.Scale = b.DefaultScale()
+
+	if err := b.Encode(values, pt); err != nil {
+		return nil, fmt.Errorf("[hefloat.Encoder].Encode: %w", err)
+	}
+
+	ct.ResizeQ(b.MaxLevel())
+	ct.ResizeDegree(1)
+
+	if err := b.Encrypt(pt, ct); err != nil {
+		return nil, fmt.Errorf("[rlwe.Encryptor].Encrypt: %w", err)
+	}
+
+	return ct, nil
+}
+
+func (b *SimulatedBootstrapper) BootstrapMany(cts []rlwe.Ciphertext) ([]rlwe.Ciphertext, error) {
+	for i := range cts {
+		ct, err := b.Bootstrap(&cts[i])
+		if err != nil {
+			return nil, err
+		}
+		cts[i] = *ct
+	}
+	return cts, nil
+}
+
+func (b *SimulatedBootstrapper) Depth() int {
+	return 0
+}
+
+func (b *SimulatedBootstrapper) MinimumInputLevel() int {
+	return 0
+}
+
+func (b *SimulatedBootstrapper) OutputLevel() int {
+	return b.MaxLevel()
+}
+
+// MeasureNoise returns the model of the error of btp, fitted on the errors
+// of the bootstrapping with btp of encryptions under sk of uniform values in
+// [-a, a] at level 0, for a = 1, 8 and 64. The terms of the model that the
+// measurements do not resolve are set to zero (-Inf).
+func MeasureNoise(btp he.Bootstrapper[rlwe.Ciphertext], params hefloat.Parameters, sk *rlwe.SecretKey, source *sampling.Source) (noise NoiseModel, err error) {
+
+	// Variances of the error, linear in Std^2, Linear^2 and Cubic^2
+	var A [3][4]float64
+	for i, a := range []float64{1, 8, 64} {
+
+		var rms, std float64
+		if rms, std, err = MeasureError(btp, params, sk, source, a); err != nil {
+			return noise, fmt.Errorf("[MeasureError][%v]: %w", a, err)
+		}
+
+		A[i] = [4]float64{1, math.Pow(rms, 2), math.Pow(rms, 6), std * std}
+	}
+
+	// Gauss-Jordan elimination with partial pivoting
+	for i := range A {
+		p := i
+		for j := i + 1; j < len(A); j++ {
+			if math.Abs(A[j][i]) > math.Abs(A[p][i]) {
+				p = j
+			}
+		}
+		A[i], A[p] = A[p], A[i]
+		for j := range A {
+			if j != i {
+				f := A[j][i] / A[i][i]
+				for k := range A[j] {
+					A[j][k] -= f * A[i][k]
+				}
+			}
+		}
+	}
+
+	var x [3]float64
+	for i := range x {
+		x[i] = A[i][3] / A[i][i]
+	}
+
+	if x[0] <= 0 {
+		return noise, fmt.Errorf("invalid noise measurement: negative variance %v", x[0])
+	}
+
+	return NoiseModel{
+		LogN:      params.LogN(),
+		LogStd:    math.Log2(x[0]) / 2,
+		LogLinear: math.Log2(max(x[1], 0)) / 2,
+		LogCubic:  math.Log2(max(x[2], 0)) / 2,
+	}, nil
+}
+
+// MeasureError bootstraps with btp an encryption under sk of uniform values
+// in [-a, a] at level 0 and returns the root mean square of the values and
+// the standard deviation of the error.
+func MeasureError(btp he.Bootstrapper[rlwe.Ciphertext], params hefloat.Parameters, sk *rlwe.SecretKey, source *sampling.Source, a float64) (rms, std float64, err error) {
+
+	ecd := hefloat.NewEncoder(params)
+
+	want := make([]float64, params.MaxSlots())
+	for i := range want {
+		want[i] = source.Float64(-a, a)
+	}
+
+	pt := hefloat.NewPlaintext(params, 0)
+	if err = ecd.Encode(want, pt); err != nil {
+		return 0, 0, fmt.Errorf("[hefloat.Encoder].Encode: %w", err)
+	}
+
+	ct := hefloat.NewCiphertext(params, 1, 0)
+	if err = rlwe.NewEncryptor(params, sk).Encrypt(pt, ct); err != nil {
+		return 0, 0, fmt.Errorf("[rlwe.Encryptor].Encrypt: %w", err)
+	}
+
+	if ct, err = btp.Bootstrap(ct); err != nil {
+		return 0, 0, fmt.Errorf("[he.Bootstrapper].Bootstrap: %w", err)
+	}
+
+	have := make([]float64, params.MaxSlots())
+	if err = ecd.Decode(rlwe.NewDecryptor(params, sk).DecryptNew(ct), have); err != nil {
+		return 0, 0, fmt.Errorf("[hefloat.Encoder].Decode: %w", err)
+	}
+
+	var sumWant, sumErr float64
+	for i := range want {
+		sumWant += want[i] * want[i]
+		sumErr += (have[i] - want[i]) * (have[i] - want[i])
+	}
+
+	n := float64(len(want))
+
+	return math.Sqrt(sumWant / n), math.Sqrt(sumErr / n), nil
+}
@@ -21,6 +21,16 @@ func NewClient(params hefloat.Parameters, sk *rlwe.SecretKey) *Client {
 	}
 }
 
+// NewEncryptionClient returns the Client of a data-collection endpoint,
+// which only holds the public key: it can load and encrypt the samples,
+// but not decrypt.
+func NewEncryptionClient(params hefloat.Parameters, pk *rlwe.PublicKey) *Client {
+	return &Client{
+		Parameters: params,
+		Encryptor:  NewEncryptor(params, pk),
+	}
+}
+
 func (c *Client) GetKeyManager(maxconcurrentkeys int, sk *rlwe.SecretKey) (evk *keys.Manager) {
 	return keys.NewManager(lib.NumCPU, c.Parameters, maxconcurrentkeys, sk)
 }
@@ -13,14 +13,16 @@ type Encryptor struct {
 	*matrix.Encryptor
 }
 
-func NewEncryptor(params hefloat.Parameters, sk *rlwe.SecretKey) *Encryptor {
+// NewEncryptor returns an Encryptor under the given key, which can be a
+// *rlwe.SecretKey or a *rlwe.PublicKey.
+func NewEncryptor(params hefloat.Parameters, key rlwe.EncryptionKey) *Encryptor {
 	return &Encryptor{
-		Encryptor: matrix.NewEncryptor(params, sk),
+		Encryptor: matrix.NewEncryptor(params, key),
 	}
 }
 
-func (enc *Encryptor) WithKey(sk *rlwe.SecretKey) *Encryptor {
-	return &Encryptor{Encryptor: enc.Encryptor.WithKey(sk)}
+func (enc *Encryptor) WithKey(key rlwe.EncryptionKey) *Encryptor {
+	return &Encryptor{Encryptor: enc.Encryptor.WithKey(key)}
 }
 
 func (enc *Encryptor) EncryptNew(in []*mat.Dense, padd, matPerCt int) (cts []rlwe.Ciphertext, err error) {
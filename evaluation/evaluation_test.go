@@ -3,6 +3,7 @@ package evaluation
 import (
 	"flag"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"app/client"
 	"app/lib"
+	"app/oracle"
 	"app/server"
 	"app/utils"
 
@@ -28,8 +30,8 @@ func TestServer(t *testing.T) {
 
 	params := lib.NewParameters()
 
-	if tot := nbMatPerCt * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		t.Fatalf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot)
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		t.Fatal(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -41,7 +43,7 @@ func TestServer(t *testing.T) {
 
 	btp := lib.NewDummyBootstrapper(params, sk)
 	//btp := lib.NewBootstrapper(params, sk)
-	btp.Debug = true
+	btp.Oracle = oracle.NewSecretKeyOracle(params, sk, os.Stdout)
 
 	now := time.Now()
 	fmt.Printf("Server: ")
@@ -105,7 +107,7 @@ func TestServer(t *testing.T) {
 	var Q, K, V []rlwe.Ciphertext
 	QWant, KWant, VWant := s.QKVApproximate(inWant)
 	t.Run("QKV", func(t *testing.T) {
-		Q, K, V, err = s.QKVEncrypted(ct)
+		Q, K, V, err = s.QKVEncrypted(ct, 0)
 		require.NoError(t, err)
 		QHave, err := c.DecryptNew(Q, lib.Rows, lib.Cols, 0, nbMatPerCt)
 		require.NoError(t, err)
@@ -126,26 +128,31 @@ func TestServer(t *testing.T) {
 
 	QSplitWant, KSplitWant, VSplitWant := s.SplitHeadsApproximate(QWant, KWant, VWant)
 	t.Run("SplitHeads", func(t *testing.T) {
-		require.NoError(t, s.SplitHeadsEncrypted(Q, K, V))
-		QSplitHave, err := c.DecryptNew(Q, lib.Rows, lib.Cols/lib.Split, lib.Padding, nbMatPerCt*lib.Split)
+		var err error
+		Q, K, V, err = s.SplitHeadsEncrypted(Q, K, V)
 		require.NoError(t, err)
+		QSplitHave, err := c.DecryptNew(Q, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
+		require.NoError(t, err)
+		QSplitHave = unpadHeads(QSplitHave, lib.Rows, s.Attention.HeadCols)
 		for i := range QSplitWant {
 			for j := range QSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, QSplitWant[i][j].RawMatrix().Data, QSplitHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, QSplitWant[i][j].RawMatrix().Data, QSplitHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
-		KHave, err := c.DecryptNew(K, lib.Rows, lib.Cols/lib.Split, lib.Padding, nbMatPerCt*lib.Split)
+		KHave, err := c.DecryptNew(K, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 		require.NoError(t, err)
+		KHave = unpadHeads(KHave, lib.Rows, s.Attention.HeadCols)
 		for i := range KSplitWant {
 			for j := range KSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, KSplitWant[i][j].RawMatrix().Data, KHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, KSplitWant[i][j].RawMatrix().Data, KHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
-		VHave, err := c.DecryptNew(V, lib.Rows, lib.Cols/lib.Split, lib.Padding, nbMatPerCt*lib.Split)
+		VHave, err := c.DecryptNew(V, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 		require.NoError(t, err)
+		VHave = unpadHeads(VHave, lib.Rows, s.Attention.HeadCols)
 		for i := range VSplitWant {
 			for j := range VSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, VSplitWant[i][j].RawMatrix().Data, VHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, VSplitWant[i][j].RawMatrix().Data, VHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
 	})
@@ -155,11 +162,12 @@ func TestServer(t *testing.T) {
 	t.Run("QMulKT", func(t *testing.T) {
 		QMulKT = Q
 		require.NoError(t, s.QMulKTEncrypted(Q, K, QMulKT))
-		QMulKTHave, err := c.DecryptNew(QMulKT, lib.Rows, lib.Rows, 0, nbMatPerCt*lib.Split)
+		QMulKTHave, err := c.DecryptNew(QMulKT, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 		require.NoError(t, err)
+		QMulKTHave = unpadHeads(QMulKTHave, lib.Rows, lib.Rows)
 		for i := range QMulKTSplitWant {
 			for j := range QMulKTSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, QMulKTSplitWant[i][j].RawMatrix().Data, QMulKTHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, QMulKTSplitWant[i][j].RawMatrix().Data, QMulKTHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
 	})
@@ -167,11 +175,12 @@ func TestServer(t *testing.T) {
 	t.Run("Bootstrap_0", func(t *testing.T) {
 		QMulKT, err = btp.BootstrapMany(QMulKT)
 		require.NoError(t, err)
-		QMulKTHave, err := c.DecryptNew(QMulKT, lib.Rows, lib.Rows, 0, nbMatPerCt*lib.Split)
+		QMulKTHave, err := c.DecryptNew(QMulKT, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 		require.NoError(t, err)
+		QMulKTHave = unpadHeads(QMulKTHave, lib.Rows, lib.Rows)
 		for i := range QMulKTSplitWant {
 			for j := range QMulKTSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, QMulKTSplitWant[i][j].RawMatrix().Data, QMulKTHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, QMulKTSplitWant[i][j].RawMatrix().Data, QMulKTHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
 	})
@@ -179,11 +188,12 @@ func TestServer(t *testing.T) {
 	s.SoftMaxApproximate(QMulKTSplitWant)
 	t.Run("SoftMaxQMulKT", func(t *testing.T) {
 		require.NoError(t, s.SoftMaxEncrypted(QMulKT, btp))
-		QMulKTHave, err := c.DecryptNew(QMulKT, lib.Rows, lib.Rows, 0, nbMatPerCt*lib.Split)
+		QMulKTHave, err := c.DecryptNew(QMulKT, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 		require.NoError(t, err)
+		QMulKTHave = unpadHeads(QMulKTHave, lib.Rows, lib.Rows)
 		for i := range QMulKTSplitWant {
 			for j := range QMulKTSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, QMulKTSplitWant[i][j].RawMatrix().Data, QMulKTHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, QMulKTSplitWant[i][j].RawMatrix().Data, QMulKTHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
 	})
@@ -193,18 +203,21 @@ func TestServer(t *testing.T) {
 	t.Run("QKTMulV", func(t *testing.T) {
 		QKTMulV = QMulKT
 		require.NoError(t, s.QKTMulVEncrypted(QMulKT, V, QKTMulV, btp))
-		QKTMulVHave, err := c.DecryptNew(QKTMulV, lib.Rows, lib.Cols/lib.Split, lib.Padding, nbMatPerCt*lib.Split)
+		QKTMulVHave, err := c.DecryptNew(QKTMulV, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 		require.NoError(t, err)
+		QKTMulVHave = unpadHeads(QKTMulVHave, lib.Rows, s.Attention.HeadCols)
 		for i := range QKTMulVSplitWant {
 			for j := range QKTMulVSplitWant[i] {
-				hefloat.VerifyTestVectors(params, ecd, nil, QKTMulVSplitWant[i][j].RawMatrix().Data, QKTMulVHave[i*lib.Split+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
+				hefloat.VerifyTestVectors(params, ecd, nil, QKTMulVSplitWant[i][j].RawMatrix().Data, QKTMulVHave[i*s.Attention.Heads+j].RawMatrix().Data, minprec, 0, *printPrecisionStats, t)
 			}
 		}
 	})
 
 	QKTMulVWant := s.MergeHeadsApproximate(QKTMulVSplitWant)
 	t.Run("MergeHeads", func(t *testing.T) {
-		require.NoError(t, s.MergeHeadsEncrypted(QKTMulV))
+		var err error
+		QKTMulV, err = s.MergeHeadsEncrypted(QKTMulV)
+		require.NoError(t, err)
 		QKTMulVHave, err := c.DecryptNew(QKTMulV, lib.Rows, lib.Cols, 0, nbMatPerCt)
 		require.NoError(t, err)
 		for i := range QKTMulVWant {
@@ -288,7 +301,7 @@ func TestServer(t *testing.T) {
 
 	inWant = s.PoolingApproximate(inWant)
 	t.Run("Pooling", func(t *testing.T) {
-		ct, err = s.PoolingEncrypted(ct)
+		ct, err = s.PoolingEncrypted(ct, 0)
 		require.NoError(t, err)
 		ctHave, err := c.DecryptNew(ct, 1, lib.Cols, 0, lib.Rows*nbMatPerCt)
 		require.NoError(t, err)
@@ -313,7 +326,7 @@ func TestServer(t *testing.T) {
 
 	inWant = s.ClassifierApproximate(inWant)
 	t.Run("Classifier", func(t *testing.T) {
-		require.NoError(t, s.ClassifierEncrypted(ct))
+		require.NoError(t, s.ClassifierEncrypted(ct, 0))
 		ctHave, err := c.DecryptNew(ct, 1, lib.Classes, lib.Cols-lib.Classes, lib.Rows*nbMatPerCt)
 		require.NoError(t, err)
 		ctHave = client.GetResults(ctHave)
@@ -357,3 +370,12 @@ func GetLabelFromPrediction(in []*mat.Dense) (out []int) {
 	}
 	return
 }
+
+// unpadHeads returns the top left rows x cols matrices of the heads.
+func unpadHeads(heads []*mat.Dense, rows, cols int) (unpadded []*mat.Dense) {
+	unpadded = make([]*mat.Dense, len(heads))
+	for i := range heads {
+		unpadded[i] = mat.DenseCopyOf(heads[i].Slice(0, rows, 0, cols))
+	}
+	return
+}
@@ -0,0 +1,336 @@
+package keys
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// FormatVersion is the version of the format of the files written by
+// StoreBootstrappingKeys, included in the fingerprint so that files of
+// another version are rejected.
+const FormatVersion = 2
+
+// Fingerprint returns the SHA-256 digest of the bootstrapping parameters,
+// i.e. of the residual and bootstrapping rings, of the DFT, of the modular
+// reduction and of the iterations, and of FormatVersion. Bootstrapping keys
+// are only valid for the parameters of the same fingerprint.
+func Fingerprint(p bootstrapping.Parameters) (fp [sha256.Size]byte, err error) {
+
+	h := sha256.New()
+
+	if _, err = fmt.Fprintf(h, "v%d", FormatVersion); err != nil {
+		return fp, fmt.Errorf("fmt.Fprintf: %w", err)
+	}
+
+	for _, params := range []hefloat.Parameters{p.ResidualParameters, p.BootstrappingParameters} {
+		if _, err = params.WriteTo(h); err != nil {
+			return fp, fmt.Errorf("[hefloat.Parameters][WriteTo]: %w", err)
+		}
+	}
+
+	if err = json.NewEncoder(h).Encode([]any{p.EvalRound, p.S2C, p.Mod1, p.C2S, p.Iterations, p.EphemeralSecretWeight}); err != nil {
+		return fp, fmt.Errorf("[json.Encoder][Encode]: %w", err)
+	}
+
+	copy(fp[:], h.Sum(nil))
+
+	return
+}
+
+// StoreBootstrappingKeys writes the fingerprint of the bootstrapping
+// parameters followed by the bootstrapping evaluation keys in the file at
+// path, which the server loads with LoadBootstrappingKeys instead of
+// generating them at each run. The relinearization key is written first,
+// so that CheckBootstrappingKeysFile reads it without the other keys.
+func StoreBootstrappingKeys(path string, p bootstrapping.Parameters, evk *bootstrapping.EvaluationKeys) (err error) {
+	return store(path, 0o644, p, func(w io.Writer) (err error) {
+
+		if _, err = evk.MemEvaluationKeySet.WriteTo(w); err != nil {
+			return fmt.Errorf("[rlwe.MemEvaluationKeySet][WriteTo]: %w", err)
+		}
+
+		for _, key := range evaluationKeys(evk) {
+			if err = writeEvaluationKey(w, *key); err != nil {
+				return
+			}
+		}
+
+		return
+	})
+}
+
+// LoadBootstrappingKeys reads the bootstrapping evaluation keys written by
+// StoreBootstrappingKeys and returns an error if they were written for
+// parameters of another fingerprint or if Galois keys are missing.
+func LoadBootstrappingKeys(path string, p bootstrapping.Parameters) (evk *bootstrapping.EvaluationKeys, err error) {
+
+	evk = &bootstrapping.EvaluationKeys{}
+
+	err = load(path, p, func(r io.Reader) (err error) {
+
+		evk.MemEvaluationKeySet = new(rlwe.MemEvaluationKeySet)
+		if _, err = evk.MemEvaluationKeySet.ReadFrom(r); err != nil {
+			return fmt.Errorf("[rlwe.MemEvaluationKeySet][ReadFrom]: %w", err)
+		}
+
+		for _, key := range evaluationKeys(evk) {
+			if *key, err = readEvaluationKey(r); err != nil {
+				return
+			}
+		}
+
+		return
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, galEl := range p.GaloisElements(p.BootstrappingParameters) {
+		if _, err = evk.GetGaloisKey(galEl); err != nil {
+			return nil, fmt.Errorf("[keys.LoadBootstrappingKeys]: %w", err)
+		}
+	}
+
+	return
+}
+
+// StoreBootstrappingSecretKeys writes the fingerprint of the bootstrapping
+// parameters followed by the secret key skN1 of the residual parameters and
+// the secret key skN2 of the bootstrapping parameters, under which the
+// bootstrapping keys were generated, in the file at path. The client reuses
+// skN1 with LoadBootstrappingSecretKeys so that the stored bootstrapping keys
+// remain valid for its ciphertexts. The file is only readable and writable
+// by its owner.
+func StoreBootstrappingSecretKeys(path string, p bootstrapping.Parameters, skN1, skN2 *rlwe.SecretKey) (err error) {
+	return store(path, 0o600, p, func(w io.Writer) (err error) {
+
+		if _, err = skN1.WriteTo(w); err != nil {
+			return fmt.Errorf("[rlwe.SecretKey][WriteTo]: %w", err)
+		}
+
+		if _, err = skN2.WriteTo(w); err != nil {
+			return fmt.Errorf("[rlwe.SecretKey][WriteTo]: %w", err)
+		}
+
+		return
+	})
+}
+
+// LoadBootstrappingSecretKeys reads the secret keys written by StoreBootstrappingSecretKeys.
+func LoadBootstrappingSecretKeys(path string, p bootstrapping.Parameters) (skN1, skN2 *rlwe.SecretKey, err error) {
+
+	skN1 = new(rlwe.SecretKey)
+	skN2 = new(rlwe.SecretKey)
+
+	err = load(path, p, func(r io.Reader) (err error) {
+
+		if _, err = skN1.ReadFrom(r); err != nil {
+			return fmt.Errorf("[rlwe.SecretKey][ReadFrom]: %w", err)
+		}
+
+		if _, err = skN2.ReadFrom(r); err != nil {
+			return fmt.Errorf("[rlwe.SecretKey][ReadFrom]: %w", err)
+		}
+
+		return
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// skN1 may be at a higher level than the residual parameters, e.g. at the encryption level.
+	if skN1.N() != p.ResidualParameters.N() || skN1.LevelQ() < p.ResidualParameters.MaxLevelQ() || skN2.BinarySize() != rlwe.NewSecretKey(p.BootstrappingParameters).BinarySize() {
+		return nil, nil, fmt.Errorf("[keys.LoadBootstrappingSecretKeys]: secret keys do not match the parameters")
+	}
+
+	return
+}
+
+// MaxLogKeyNoise is the upper bound on the log2 of the standard deviation of
+// the noise of valid keys used by CheckBootstrappingKeys.
+const MaxLogKeyNoise = 16
+
+// CheckBootstrappingKeys returns an error if the bootstrapping keys evk were
+// not generated under the secret key skN2 of the bootstrapping parameters,
+// i.e. if the noise of their relinearization key under skN2 is not small.
+func CheckBootstrappingKeys(p bootstrapping.Parameters, evk *bootstrapping.EvaluationKeys, skN2 *rlwe.SecretKey) (err error) {
+
+	rlk, err := evk.GetRelinearizationKey()
+	if err != nil {
+		return fmt.Errorf("[bootstrapping.EvaluationKeys][GetRelinearizationKey]: %w", err)
+	}
+
+	return checkRelinearizationKey(p, rlk, skN2)
+}
+
+// CheckBootstrappingKeysFile returns an error if the file at path was not
+// written by StoreBootstrappingKeys for p or if its keys were not generated
+// under skN2, see CheckBootstrappingKeys. Only the fingerprint and the
+// relinearization key are read, not the Galois keys, which are checked by
+// LoadBootstrappingKeys.
+func CheckBootstrappingKeysFile(path string, p bootstrapping.Parameters, skN2 *rlwe.SecretKey) (err error) {
+
+	rlk := new(rlwe.RelinearizationKey)
+
+	if err = load(path, p, func(r io.Reader) (err error) {
+
+		var present [1]byte
+		if _, err = io.ReadFull(r, present[:]); err != nil {
+			return fmt.Errorf("io.ReadFull: %w", err)
+		}
+
+		if present[0] == 0 {
+			return fmt.Errorf("invalid file %s: no relinearization key", path)
+		}
+
+		if _, err = rlk.ReadFrom(r); err != nil {
+			return fmt.Errorf("[rlwe.RelinearizationKey][ReadFrom]: %w", err)
+		}
+
+		return
+	}); err != nil {
+		return
+	}
+
+	return checkRelinearizationKey(p, rlk, skN2)
+}
+
+// checkRelinearizationKey returns an error if the noise of rlk under skN2 is not small.
+func checkRelinearizationKey(p bootstrapping.Parameters, rlk *rlwe.RelinearizationKey, skN2 *rlwe.SecretKey) (err error) {
+
+	// The noise of a key generated under skN2 is of the order of the standard
+	// deviation of the error distribution, that of a key generated under another
+	// secret key of the order of the modulus.
+	params := p.BootstrappingParameters.GetRLWEParameters()
+	if noise := rlwe.NoiseRelinearizationKey(rlk, skN2, *params); noise > MaxLogKeyNoise {
+		return fmt.Errorf("bootstrapping keys were not generated under the secret key: log2(noise std)=%.2f > %d", noise, MaxLogKeyNoise)
+	}
+
+	return
+}
+
+// evaluationKeys returns the optional evaluation keys of evk, in the order of the file.
+func evaluationKeys(evk *bootstrapping.EvaluationKeys) []**rlwe.EvaluationKey {
+	return []**rlwe.EvaluationKey{
+		&evk.EvkN1ToN2,
+		&evk.EvkN2ToN1,
+		&evk.EvkRealToCmplx,
+		&evk.EvkCmplxToReal,
+		&evk.EvkDenseToSparse,
+		&evk.EvkSparseToDense,
+	}
+}
+
+// store writes the fingerprint of p followed by the output of write in the
+// file at path, with permissions perm.
+func store(path string, perm os.FileMode, p bootstrapping.Parameters, write func(w io.Writer) error) (err error) {
+
+	fp, err := Fingerprint(p)
+	if err != nil {
+		return
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("os.OpenFile(%s): %w", path, err)
+	}
+	defer f.Close()
+
+	// os.OpenFile does not change the permissions of an existing file.
+	if err = f.Chmod(perm); err != nil {
+		return fmt.Errorf("[os.File][Chmod]: %w", err)
+	}
+
+	w := bufio.NewWriter(f)
+
+	if _, err = w.Write(fp[:]); err != nil {
+		return fmt.Errorf("[bufio.Writer][Write]: %w", err)
+	}
+
+	if err = write(w); err != nil {
+		return
+	}
+
+	if err = w.Flush(); err != nil {
+		return fmt.Errorf("[bufio.Writer][Flush]: %w", err)
+	}
+
+	return f.Close()
+}
+
+// load checks the fingerprint of the file at path against p and calls read on the rest of the file.
+func load(path string, p bootstrapping.Parameters, read func(r io.Reader) error) (err error) {
+
+	want, err := Fingerprint(p)
+	if err != nil {
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("os.Open(%s): %w", path, err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	var have [sha256.Size]byte
+	if _, err = io.ReadFull(r, have[:]); err != nil {
+		return fmt.Errorf("io.ReadFull: %w", err)
+	}
+
+	if have != want {
+		return fmt.Errorf("invalid file %s: written for bootstrapping parameters of fingerprint %x but parameters have fingerprint %x", path, have, want)
+	}
+
+	return read(r)
+}
+
+// writeEvaluationKey writes whether evk is nil followed by evk if it is not.
+func writeEvaluationKey(w io.Writer, evk *rlwe.EvaluationKey) (err error) {
+
+	if evk == nil {
+		_, err = w.Write([]byte{0})
+		return
+	}
+
+	if _, err = w.Write([]byte{1}); err != nil {
+		return
+	}
+
+	if _, err = evk.WriteTo(w); err != nil {
+		return fmt.Errorf("[rlwe.EvaluationKey][WriteTo]: %w", err)
+	}
+
+	return
+}
+
+// readEvaluationKey reads an evaluation key written by writeEvaluationKey.
+func readEvaluationKey(r io.Reader) (evk *rlwe.EvaluationKey, err error) {
+
+	var present [1]byte
+	if _, err = io.ReadFull(r, present[:]); err != nil {
+		return nil, fmt.Errorf("io.ReadFull: %w", err)
+	}
+
+	if present[0] == 0 {
+		return
+	}
+
+	evk = new(rlwe.EvaluationKey)
+	if _, err = evk.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("[rlwe.EvaluationKey][ReadFrom]: %w", err)
+	}
+
+	return
+}
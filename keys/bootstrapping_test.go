@@ -0,0 +1,127 @@
+package keys
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootstrappingKeys(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+		LogN:            10,
+		LogQ:            []int{60, 40},
+		LogP:            []int{61},
+		LogDefaultScale: 40,
+		LogNthRoot:      12,
+		RingType:        ring.ConjugateInvariant,
+	})
+	require.NoError(t, err)
+
+	literal := bootstrapping.NewParametersLiteral()
+	literal.LogN = 11
+	literal.LogSlots = 4
+
+	btpParams, err := bootstrapping.NewParametersFromLiteral(params, literal)
+	require.NoError(t, err)
+
+	sk := rlwe.NewKeyGenerator(params).GenSecretKeyNew()
+
+	evk, skN2, err := btpParams.GenEvaluationKeys(sk)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	pathEvk := filepath.Join(dir, "btp.evk")
+	pathSk := filepath.Join(dir, "btp.sk")
+
+	require.NoError(t, StoreBootstrappingKeys(pathEvk, btpParams, evk))
+	require.NoError(t, StoreBootstrappingSecretKeys(pathSk, btpParams, sk, skN2))
+
+	t.Run("Load", func(t *testing.T) {
+
+		evkHave, err := LoadBootstrappingKeys(pathEvk, btpParams)
+		require.NoError(t, err)
+		require.Equal(t, evk.BinarySize(), evkHave.BinarySize())
+		require.Nil(t, evkHave.EvkN1ToN2)
+		require.True(t, evk.EvkCmplxToReal.Equal(evkHave.EvkCmplxToReal))
+		require.True(t, evk.EvkDenseToSparse.Equal(evkHave.EvkDenseToSparse))
+
+		for _, galEl := range evk.GetGaloisKeysList() {
+			want, err := evk.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			have, err := evkHave.GetGaloisKey(galEl)
+			require.NoError(t, err)
+			require.True(t, want.Equal(have))
+		}
+
+		// The loaded keys bootstrap the ciphertexts encrypted under sk.
+		_, err = bootstrapping.NewEvaluator(btpParams, evkHave)
+		require.NoError(t, err)
+
+		skHave, skN2Have, err := LoadBootstrappingSecretKeys(pathSk, btpParams)
+		require.NoError(t, err)
+		require.True(t, sk.Equal(skHave))
+		require.True(t, skN2.Equal(skN2Have))
+	})
+
+	t.Run("Permissions", func(t *testing.T) {
+
+		info, err := os.Stat(pathSk)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		// Overwriting a file does not keep its permissions.
+		path := filepath.Join(dir, "world-readable.sk")
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+		require.NoError(t, StoreBootstrappingSecretKeys(path, btpParams, sk, skN2))
+
+		info, err = os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("SecretKey", func(t *testing.T) {
+
+		require.NoError(t, CheckBootstrappingKeys(btpParams, evk, skN2))
+
+		skOther := rlwe.NewKeyGenerator(btpParams.BootstrappingParameters).GenSecretKeyNew()
+		require.Error(t, CheckBootstrappingKeys(btpParams, evk, skOther))
+
+		require.NoError(t, CheckBootstrappingKeysFile(pathEvk, btpParams, skN2))
+		require.Error(t, CheckBootstrappingKeysFile(pathEvk, btpParams, skOther))
+	})
+
+	t.Run("Fingerprint", func(t *testing.T) {
+
+		literal.LogMessageRatio++
+		other, err := bootstrapping.NewParametersFromLiteral(params, literal)
+		require.NoError(t, err)
+
+		_, err = LoadBootstrappingKeys(pathEvk, other)
+		require.ErrorContains(t, err, "fingerprint")
+
+		_, _, err = LoadBootstrappingSecretKeys(pathSk, other)
+		require.ErrorContains(t, err, "fingerprint")
+
+		err = CheckBootstrappingKeysFile(pathEvk, other, skN2)
+		require.ErrorContains(t, err, "fingerprint")
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+
+		data, err := os.ReadFile(pathEvk)
+		require.NoError(t, err)
+
+		path := filepath.Join(dir, "truncated.evk")
+		require.NoError(t, os.WriteFile(path, data[:len(data)/2], 0o600))
+
+		_, err = LoadBootstrappingKeys(path, btpParams)
+		require.Error(t, err)
+	})
+}
@@ -45,7 +45,31 @@ func NewManager(NumCPU int, params hefloat.Parameters, maxconcurrentkeys int, sk
 	}
 }
 
+// NewManagerFromKeys returns a Manager holding the given pre-generated keys,
+// for example collective keys (see package multiparty), without secret key.
+// Its LoadGaloisKeys only checks that the requested keys are present.
+func NewManagerFromKeys(rlk *rlwe.RelinearizationKey, gks []*rlwe.GaloisKey) *Manager {
+	GaloisKeys := map[uint64]*rlwe.GaloisKey{}
+	for _, gk := range gks {
+		GaloisKeys[gk.GaloisElement] = gk
+	}
+	return &Manager{
+		GaloisKeys:         GaloisKeys,
+		RelinearizationKey: rlk,
+	}
+}
+
 func (km *Manager) LoadGaloisKeys(galEls []uint64) (err error) {
+
+	if km.Sk == nil {
+		for _, galEl := range galEls {
+			if _, ok := km.GaloisKeys[galEl]; !ok {
+				return fmt.Errorf("missing Galois Key %d", galEl)
+			}
+		}
+		return
+	}
+
 	previousGalEls := maps.Keys(km.GaloisKeys)
 	currentGalEls := map[uint64]bool{}
 	for _, galEl := range galEls {
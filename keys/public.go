@@ -0,0 +1,103 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"app/security"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// StorePublicKey writes the parameters followed by the public key in the file
+// at path, which is the export format of the encryption key for the
+// data-collection endpoints (see LoadPublicKey).
+func StorePublicKey(path string, params hefloat.Parameters, pk *rlwe.PublicKey) (err error) {
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("os.Create(%s): %w", path, err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+
+	if _, err = params.WriteTo(w); err != nil {
+		return fmt.Errorf("[hefloat.Parameters][WriteTo]: %w", err)
+	}
+
+	if _, err = pk.WriteTo(w); err != nil {
+		return fmt.Errorf("[rlwe.PublicKey][WriteTo]: %w", err)
+	}
+
+	if err = w.Flush(); err != nil {
+		return fmt.Errorf("[bufio.Writer][Flush]: %w", err)
+	}
+
+	return f.Close()
+}
+
+// LoadPublicKey reads the parameters and the public key written by StorePublicKey.
+// It returns an error if the parameters of the file are not the given parameters,
+// e.g. those of the circuit, or if their security is not at least lambda bits
+// (see security.Check), so that an insecure parameter set cannot be handed to a
+// data-collection endpoint through a key file.
+func LoadPublicKey(path string, params hefloat.Parameters, lambda float64) (pk *rlwe.PublicKey, err error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open(%s): %w", path, err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	var have hefloat.Parameters
+	if _, err = have.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("[hefloat.Parameters][ReadFrom]: %w", err)
+	}
+
+	if !have.Equal(&params) {
+		return nil, fmt.Errorf("[keys.LoadPublicKey]: %s: parameters of the public key do not match the expected parameters", path)
+	}
+
+	if err = security.Check(have, lambda); err != nil {
+		return nil, fmt.Errorf("[keys.LoadPublicKey]: %s: %w", path, err)
+	}
+
+	pk = new(rlwe.PublicKey)
+	if _, err = pk.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("[rlwe.PublicKey][ReadFrom]: %w", err)
+	}
+
+	if err = CheckPublicKey(params, pk); err != nil {
+		return nil, fmt.Errorf("[keys.LoadPublicKey]: %w", err)
+	}
+
+	return
+}
+
+// CheckPublicKey returns an error if the ring degree, the levels or the
+// size of the public key do not match the parameters.
+func CheckPublicKey(params hefloat.Parameters, pk *rlwe.PublicKey) (err error) {
+
+	if pk.N() != params.N() {
+		return fmt.Errorf("public key N=%d != parameters N=%d", pk.N(), params.N())
+	}
+
+	if pk.LevelQ() != params.MaxLevelQ() || pk.LevelP() != params.MaxLevelP() {
+		return fmt.Errorf("public key levels (Q=%d, P=%d) != parameters levels (Q=%d, P=%d)", pk.LevelQ(), pk.LevelP(), params.MaxLevelQ(), params.MaxLevelP())
+	}
+
+	if pk.Size() != 2 {
+		return fmt.Errorf("public key size=%d != 2", pk.Size())
+	}
+
+	if !pk.IsNTT || !pk.IsMontgomery {
+		return fmt.Errorf("public key is not in the NTT and Montgomery domain")
+	}
+
+	return
+}
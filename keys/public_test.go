@@ -0,0 +1,109 @@
+package keys
+
+import (
+	"math/rand/v2"
+	"path/filepath"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/stretchr/testify/require"
+
+	"app/matrix"
+)
+
+func TestPublicKey(t *testing.T) {
+
+	// 128 bits of security (LogQP <= 109, see security.Bounds)
+	params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+		LogN:            12,
+		LogQ:            []int{50},
+		LogP:            []int{50},
+		LogDefaultScale: 40,
+		RingType:        ring.ConjugateInvariant,
+	})
+	require.NoError(t, err)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+	pk := kgen.GenPublicKeyNew(sk)
+
+	path := filepath.Join(t.TempDir(), "pk.bin")
+	require.NoError(t, StorePublicKey(path, params, pk))
+
+	pkHave, err := LoadPublicKey(path, params, 128)
+	require.NoError(t, err)
+
+	want, err := pk.MarshalBinary()
+	require.NoError(t, err)
+	have, err := pkHave.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, want, have)
+
+	// Encrypted by the endpoint, decrypted by the owner of the secret key
+	rows, cols := 4, 8
+	r := rand.New(rand.NewPCG(0, 0))
+	in := make([]*mat.Dense, 3)
+	for i := range in {
+		m := make([]float64, rows*cols)
+		for j := range m {
+			m[j] = 2*r.Float64() - 1
+		}
+		in[i] = mat.NewDense(rows, cols, m)
+	}
+
+	cts, err := matrix.NewEncryptor(params, pkHave).EncryptNew(in, 0, len(in))
+	require.NoError(t, err)
+
+	out, err := matrix.NewDecryptor(params, sk).DecryptNew(cts, rows, cols, 0, len(in))
+	require.NoError(t, err)
+
+	for i := range in {
+		require.True(t, mat.EqualApprox(in[i], out[i], 1e-6))
+	}
+
+	_, err = LoadPublicKey(filepath.Join(t.TempDir(), "missing.bin"), params, 128)
+	require.Error(t, err)
+
+	// Public key of other parameters
+	paramsOther, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+		LogN:            11,
+		LogQ:            []int{60},
+		LogP:            []int{60},
+		LogDefaultScale: 45,
+		RingType:        ring.ConjugateInvariant,
+	})
+	require.NoError(t, err)
+
+	pkOther := rlwe.NewKeyGenerator(paramsOther).GenPublicKeyNew(rlwe.NewKeyGenerator(paramsOther).GenSecretKeyNew())
+
+	path = filepath.Join(t.TempDir(), "pk.bin")
+	require.NoError(t, StorePublicKey(path, params, pkOther))
+	_, err = LoadPublicKey(path, params, 128)
+	require.Error(t, err)
+
+	// Other parameters than the expected ones
+	path = filepath.Join(t.TempDir(), "pk.bin")
+	require.NoError(t, StorePublicKey(path, paramsOther, pkOther))
+	_, err = LoadPublicKey(path, params, 128)
+	require.Error(t, err)
+
+	// Insecure parameters, even if expected
+	paramsInsecure, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+		LogN:            12,
+		LogQ:            []int{60, 45, 45},
+		LogP:            []int{60},
+		LogDefaultScale: 45,
+		RingType:        ring.ConjugateInvariant,
+	})
+	require.NoError(t, err)
+
+	kgen = rlwe.NewKeyGenerator(paramsInsecure)
+	path = filepath.Join(t.TempDir(), "pk.bin")
+	require.NoError(t, StorePublicKey(path, paramsInsecure, kgen.GenPublicKeyNew(kgen.GenSecretKeyNew())))
+	_, err = LoadPublicKey(path, paramsInsecure, 128)
+	require.ErrorContains(t, err, "security")
+}
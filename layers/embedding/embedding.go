@@ -0,0 +1,301 @@
+package embedding
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/utils/bignum"
+)
+
+// Parameters are the parameters of the per-column polynomial
+// interpolation of an embedding table.
+type Parameters struct {
+	A      float64 // Scaling of the tokenizer affine map x = token * A + B
+	B      float64 // Offset of the tokenizer affine map x = token * A + B
+	K      int     // Largest token index (tokens are 0, 1, ..., K)
+	MaxErr float64 // Target maximum error of the interpolant around each token
+	Delta  float64 // Half-width of the interval around each token on which the error is minimized
+	MaxDeg int     // Maximum degree of the interpolant
+	Prec   uint    // Bit-precision of the minimax approximation
+}
+
+// NewParameters returns default Parameters for the affine map x = token * A + B with tokens in [0, K].
+func NewParameters(A, B, K float64) Parameters {
+	return Parameters{
+		A:      A,
+		B:      B,
+		K:      int(K),
+		MaxErr: 1.0 / (1 << 12),
+		Delta:  1.0 / 4096,
+		MaxDeg: 127,
+		Prec:   256,
+	}
+}
+
+// Fit returns, for each column of the embedding table, the coefficients
+// in the Chebyshev basis over [-1, 1] of a polynomial p such that
+// |p(token * A + B) - table[token+1][col]| <= MaxErr for every token in [0, K].
+// Row 0 of the table is the padding token and is skipped.
+func Fit(table *mat.Dense, p Parameters) (coeffs [][]float64, err error) {
+
+	rows, cols := table.Dims()
+
+	if rows < p.K+2 {
+		return nil, fmt.Errorf("invalid table: #rows=%d < K+2=%d", rows, p.K+2)
+	}
+
+	coeffs = make([][]float64, cols)
+
+	y := make([]float64, p.K+1)
+
+	for j := range cols {
+
+		for i := range y {
+			y[i] = table.At(i+1, j)
+		}
+
+		if coeffs[j], err = FitColumn(y, p); err != nil {
+			return nil, fmt.Errorf("[FitColumn][%d]: %w", j, err)
+		}
+	}
+
+	return
+}
+
+// FitColumn returns the coefficients in the Chebyshev basis over [-1, 1]
+// of a polynomial p such that |p(i * A + B) - y[i]| <= MaxErr for all i.
+// The number of nodes is increased until the target error is reached or
+// the degree exceeds MaxDeg.
+func FitColumn(y []float64, p Parameters) (coeffs []float64, err error) {
+
+	if len(y) != p.K+1 {
+		return nil, fmt.Errorf("invalid input: len(y)=%d != K+1=%d", len(y), p.K+1)
+	}
+
+	prec := p.Prec
+
+	w := make([]big.Float, len(y))
+	for i := range w {
+		w[i] = *bignum.NewFloat(float64(i)*p.A+p.B, prec)
+	}
+
+	yBig := make([]big.Float, len(y))
+	for i := range yBig {
+		yBig[i] = *bignum.NewFloat(y[i], prec)
+	}
+
+	f := interpolant(w, yBig, prec)
+
+	for base := 2; ; base++ {
+
+		intervals := make([]bignum.Interval, len(y))
+
+		var deg int
+		for i := range intervals {
+			x := float64(i)*p.A + p.B
+			intervals[i] = bignum.Interval{
+				A:     *bignum.NewFloat(x-p.Delta, prec),
+				B:     *bignum.NewFloat(x+p.Delta, prec),
+				Nodes: base + edgeNodes(min(i, p.K-i)),
+			}
+			deg += intervals[i].Nodes
+		}
+
+		if deg-2 > p.MaxDeg {
+			return nil, fmt.Errorf("cannot reach MaxErr=%e with degree <= %d", p.MaxErr, p.MaxDeg)
+		}
+
+		r := bignum.NewRemez(bignum.RemezParameters{
+			Function:  f,
+			Basis:     bignum.Chebyshev,
+			Intervals: intervals,
+			Prec:      prec,
+		})
+
+		r.Approximate(200, 1e-10)
+
+		if maxErr, _ := r.MaxErr.Float64(); maxErr <= p.MaxErr {
+
+			// The Remez basis is defined over the union of the intervals,
+			// so the coefficients are re-interpolated over [-1, 1].
+			poly := bignum.NewPolynomial(bignum.Chebyshev, r.Coeffs, &bignum.Interval{
+				A: intervals[0].A,
+				B: intervals[len(intervals)-1].B,
+			})
+
+			g := func(x *big.Float) (y *big.Float) {
+				return &poly.Evaluate(x)[0]
+			}
+
+			cheby := bignum.ChebyshevApproximation(g, bignum.Interval{
+				A:     *bignum.NewFloat(-1, prec),
+				B:     *bignum.NewFloat(1, prec),
+				Nodes: len(r.Coeffs) - 1,
+			})
+
+			coeffs = make([]float64, len(cheby.Coeffs))
+			for i := range coeffs {
+				coeffs[i], _ = cheby.Coeffs[i][0].Float64()
+			}
+
+			return
+		}
+	}
+}
+
+// edgeNodes returns the number of additional nodes allocated to
+// the interval at distance d of the edge of [-1, 1], where the
+// Chebyshev basis is the least stable.
+func edgeNodes(d int) int {
+	switch {
+	case d == 0:
+		return 3
+	case d == 1:
+		return 2
+	case d < 5:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// interpolant returns a smooth function h such that h(w[i]) = v[i], which
+// transitions between two consecutive nodes with a flat bump function.
+// Outside of [w[0], w[n-1]], h is mirrored.
+func interpolant(w, v []big.Float, prec uint) func(x *big.Float) (y *big.Float) {
+
+	one := bignum.NewFloat(1, prec)
+	two := bignum.NewFloat(2, prec)
+
+	// Below exp(-prec), f(x) is negligible in f(x) + f(1-x) >= exp(-1/2).
+	// It is flushed to zero, since adding it would allocate a mantissa
+	// spanning the difference of the exponents.
+	underflow := bignum.NewFloat(-float64(prec), prec)
+
+	// f(x) = exp((x-1)/(2x)) if x > 0 else 0
+	f := func(x *big.Float) (y *big.Float) {
+		y = new(big.Float).SetPrec(prec)
+		if x.Cmp(y) > 0 {
+			y.Sub(x, one)
+			y.Quo(y, x)
+			y.Quo(y, two)
+			if y.Cmp(underflow) < 0 {
+				return y.SetFloat64(0)
+			}
+			return bignum.Exp(y)
+		}
+		return
+	}
+
+	// g(x) = f(x) / (f(x) + f(1-x))
+	g := func(x *big.Float) (y *big.Float) {
+		y = f(x)
+		d := f(new(big.Float).Sub(one, x))
+		d.Add(d, y)
+		return y.Quo(y, d)
+	}
+
+	h := func(x *big.Float) (z *big.Float) {
+		for i := 0; i < len(w)-1; i++ {
+			if x.Cmp(&w[i]) >= 0 && x.Cmp(&w[i+1]) <= 0 {
+				t := new(big.Float).Sub(x, &w[i])
+				t.Quo(t, new(big.Float).Sub(&w[i+1], &w[i]))
+				t = g(t)
+				z = new(big.Float).Mul(t, &v[i+1])
+				t.Sub(one, t)
+				return z.Add(z, t.Mul(t, &v[i]))
+			}
+		}
+		panic(fmt.Errorf("input outside of range"))
+	}
+
+	return func(x *big.Float) (z *big.Float) {
+
+		// 2*w - x
+		if x.Cmp(&w[0]) < 0 {
+			return h(new(big.Float).Sub(new(big.Float).Mul(two, &w[0]), x))
+		}
+
+		if x.Cmp(&w[len(w)-1]) > 0 {
+			return h(new(big.Float).Sub(new(big.Float).Mul(two, &w[len(w)-1]), x))
+		}
+
+		return h(x)
+	}
+}
+
+// Key returns the hexadecimal SHA-256 digest of the embedding table, of
+// the tokenizer affine map x = token * A + B with tokens in [0, K] and of
+// the coefficients file written by Write from the coefficients returned
+// by Fit, so that an edited or truncated file does not match its key.
+func Key(table *mat.Dense, A, B, K float64, coeffs []byte) string {
+
+	h := sha256.New()
+
+	rows, cols := table.Dims()
+
+	buf := make([]byte, 8)
+	for _, v := range []float64{A, B, K, float64(rows), float64(cols)} {
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(v))
+		h.Write(buf)
+	}
+
+	for i := range rows {
+		for j := range cols {
+			binary.LittleEndian.PutUint64(buf, math.Float64bits(table.At(i, j)))
+			h.Write(buf)
+		}
+	}
+
+	h.Write(coeffs)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Write writes the coefficients in path, one column per line,
+// in the format expected by server.GetEmbeddingPolynmials.
+func Write(path string, coeffs [][]float64) (err error) {
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("os.Create(%s): %w", path, err)
+	}
+
+	defer func() {
+		if errClose := f.Close(); err == nil && errClose != nil {
+			err = fmt.Errorf("f.Close: %w", errClose)
+		}
+	}()
+
+	writer := bufio.NewWriter(f)
+
+	for i := range coeffs {
+		for j := range coeffs[i] {
+			if j != 0 {
+				if err = writer.WriteByte(','); err != nil {
+					return fmt.Errorf("writer.WriteByte: %w", err)
+				}
+			}
+			if _, err = fmt.Fprintf(writer, "%.15f", coeffs[i][j]); err != nil {
+				return fmt.Errorf("fmt.Fprintf: %w", err)
+			}
+		}
+		if err = writer.WriteByte('\n'); err != nil {
+			return fmt.Errorf("writer.WriteByte: %w", err)
+		}
+	}
+
+	if err = writer.Flush(); err != nil {
+		return fmt.Errorf("writer.Flush: %w", err)
+	}
+
+	return
+}
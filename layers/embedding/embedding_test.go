@@ -0,0 +1,76 @@
+package embedding
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"app/lib"
+	"app/weights"
+
+	"github.com/stretchr/testify/require"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestFit(t *testing.T) {
+
+	K := 5.0
+	E := 1 / 64.0
+
+	// The target error is 2^-12, so that 128 bits of precision are enough.
+	p := NewParameters(2/(K+2*E), -K/(K+2*E), K)
+	p.Prec = 128
+
+	r := rand.New(rand.NewPCG(0, 0))
+
+	// Row 0 is the padding token.
+	table := mat.NewDense(p.K+2, 2, nil)
+	for i := 1; i < p.K+2; i++ {
+		for j := 0; j < 2; j++ {
+			table.Set(i, j, 0.1*(2*r.Float64()-1))
+		}
+	}
+
+	coeffs, err := Fit(table, p)
+	require.NoError(t, err)
+	require.Len(t, coeffs, 2)
+
+	for j := range coeffs {
+		require.LessOrEqual(t, len(coeffs[j])-1, p.MaxDeg)
+		for i := 0; i <= p.K; i++ {
+			have := chebyshev(coeffs[j], float64(i)*p.A+p.B)
+			require.LessOrEqual(t, math.Abs(have-table.At(i+1, j)), p.MaxErr, "column %d, token %d", j, i)
+		}
+	}
+}
+
+// BenchmarkFit fits the first column of the 25x128 embedding table of the
+// model: the coefficients of the full table, written by server.WriteEmbeddingCoefficients,
+// take lib.Cols times longer.
+func BenchmarkFit(b *testing.B) {
+
+	lut := weights.LoadEmbeddingLUT("../../weights")
+	rows, _ := lut.Dims()
+
+	p := NewParameters(lib.A, lib.B, lib.K)
+
+	table := mat.NewDense(rows, 1, mat.Col(nil, 0, lut))
+
+	for range b.N {
+		coeffs, err := Fit(table, p)
+		require.NoError(b, err)
+		for i := 0; i <= p.K; i++ {
+			require.LessOrEqual(b, math.Abs(chebyshev(coeffs[0], float64(i)*p.A+p.B)-table.At(i+1, 0)), p.MaxErr, "token %d", i)
+		}
+	}
+}
+
+// chebyshev evaluates the Chebyshev series of coeffs at x in [-1, 1].
+func chebyshev(coeffs []float64, x float64) float64 {
+	var b0, b1 float64
+	for i := len(coeffs) - 1; i > 0; i-- {
+		b0, b1 = 2*x*b0-b1+coeffs[i], b0
+	}
+	return x*b0 - b1 + coeffs[0]
+}
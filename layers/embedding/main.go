@@ -1,685 +0,0 @@
-package main
-
-import (
-	"bufio"
-	"fmt"
-	"image"
-	"image/color"
-	"image/draw"
-	"image/png"
-	"math/big"
-	"os"
-
-	"app/utils"
-
-	"github.com/Pro7ech/lattigo/utils/bignum"
-
-	"github.com/vdobler/chart"
-	"github.com/vdobler/chart/imgg"
-)
-
-var prec uint = 256
-var two = bignum.NewFloat(2, prec)
-
-func fBig(x, one *big.Float) (y *big.Float) {
-	y = new(big.Float).SetPrec(x.Prec())
-	if x.Cmp(y) > 0 {
-		y.Sub(y, one)
-		y.Quo(y, x)
-		y.Quo(y, two)
-		return bignum.Exp(y)
-	}
-	return
-}
-
-func gBig(x, one *big.Float) (y *big.Float) {
-	y = fBig(x, one)
-	d := new(big.Float).Sub(one, x)
-	d = fBig(d, one)
-	d.Add(d, y)
-	y.Quo(y, d)
-	return
-}
-
-func main() {
-	y, err := utils.ReadFile("embedding.csv", ',', 0, false, 1)
-	if err != nil {
-		panic(err)
-	}
-
-	yD2 := make([][]float64, 25)
-	for i := range yD2 {
-		yD2[i] = make([]float64, 128)
-		for j := range 128 {
-			yD2[i][j] = y[0][i*128+j]
-		}
-	}
-
-	// Drops the first coefficient
-	yT := make([][]float64, 128)
-	for i := range yT {
-		yT[i] = make([]float64, 24)
-	}
-
-	for i := range yT {
-		for j := range yT[i] {
-			yT[i][j] = yD2[j+1][i]
-		}
-	}
-
-	yTIndex := 0
-
-	f, err := os.OpenFile("embedding_value_coefficients.csv", os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
-
-	if err != nil {
-		panic(err)
-	}
-
-	writer := bufio.NewWriter(f)
-
-	for yTIndex < 128 {
-
-		fmt.Println("YT", yTIndex)
-
-		yTi := yT[yTIndex]
-
-		a := 0.0
-		b := float64(len(yTi) - 1)
-
-		w := make([]float64, len(yTi))
-		for i := range w {
-			w[i] = (2*float64(i) - a - b) / (b - a)
-		}
-
-		fmt.Println(w)
-
-		stepBig := new(big.Float).SetPrec(prec).SetFloat64(b)
-		stepBig.Quo(bignum.NewFloat(2, prec), stepBig)
-
-		wBig := make([]big.Float, len(w))
-		for i := range wBig {
-			wBig[i] = *new(big.Float).SetPrec(prec).SetFloat64(w[i])
-		}
-
-		yTBig := make([]big.Float, len(yTi))
-		for i := range yTBig {
-			yTBig[i] = *new(big.Float).SetPrec(prec).SetFloat64(yTi[i])
-		}
-
-		one := new(big.Float).SetPrec(prec).SetInt64(1)
-		tmp := new(big.Float)
-
-		hBig := func(x *big.Float) (y *big.Float) {
-			for i := range wBig {
-				if x.Cmp(&wBig[i]) >= 0 && x.Cmp(&wBig[i+1]) <= 0 {
-					a := bignum.NewFloat(i, prec)
-					a.Mul(a, stepBig)
-					a.Sub(a, one)
-					b := new(big.Float).Add(a, stepBig)
-					d := new(big.Float).Sub(x, a)
-					d.Quo(d, a.Sub(b, a))
-					d = gBig(d, one)
-					y = new(big.Float).Mul(d, &yTBig[i+1])
-					d.Sub(one, d)
-					y.Add(y, tmp.Mul(d, &yTBig[i]))
-					return
-				}
-			}
-			panic(fmt.Errorf("input outside of range"))
-		}
-
-		f := func(x *big.Float) (y *big.Float) {
-
-			if x.Cmp(&wBig[0]) < 0 {
-				dd := new(big.Float).Sub(&wBig[0], x)
-				dd.Neg(dd)
-				dd.Add(dd, dd)
-				dd.Sub(x, dd)
-				return hBig(dd)
-			}
-
-			if x.Cmp(&wBig[len(wBig)-1]) > 0 {
-				dd := new(big.Float).Sub(&wBig[len(wBig)-1], x)
-				dd.Neg(dd)
-				dd.Add(dd, dd)
-				dd.Sub(x, dd)
-				return hBig(dd)
-			}
-
-			return hBig(x)
-		}
-
-		e := 1.0 / (4096)
-		intervals := []bignum.Interval{
-			{A: *bignum.NewFloat(w[0]-e, prec), B: *bignum.NewFloat(w[0]+e, prec), Nodes: 5},
-			{A: *bignum.NewFloat(w[1]-e, prec), B: *bignum.NewFloat(w[1]+e, prec), Nodes: 4},
-			{A: *bignum.NewFloat(w[2]-e, prec), B: *bignum.NewFloat(w[2]+e, prec), Nodes: 3},
-			{A: *bignum.NewFloat(w[3]-e, prec), B: *bignum.NewFloat(w[3]+e, prec), Nodes: 3},
-			{A: *bignum.NewFloat(w[4]-e, prec), B: *bignum.NewFloat(w[4]+e, prec), Nodes: 3},
-			{A: *bignum.NewFloat(w[5]-e, prec), B: *bignum.NewFloat(w[5]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[6]-e, prec), B: *bignum.NewFloat(w[6]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[7]-e, prec), B: *bignum.NewFloat(w[7]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[8]-e, prec), B: *bignum.NewFloat(w[8]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[9]-e, prec), B: *bignum.NewFloat(w[9]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[10]-e, prec), B: *bignum.NewFloat(w[10]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[11]-e, prec), B: *bignum.NewFloat(w[11]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[12]-e, prec), B: *bignum.NewFloat(w[12]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[13]-e, prec), B: *bignum.NewFloat(w[13]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[14]-e, prec), B: *bignum.NewFloat(w[14]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[15]-e, prec), B: *bignum.NewFloat(w[15]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[16]-e, prec), B: *bignum.NewFloat(w[16]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[17]-e, prec), B: *bignum.NewFloat(w[17]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[18]-e, prec), B: *bignum.NewFloat(w[18]+e, prec), Nodes: 2},
-			{A: *bignum.NewFloat(w[19]-e, prec), B: *bignum.NewFloat(w[19]+e, prec), Nodes: 3},
-			{A: *bignum.NewFloat(w[20]-e, prec), B: *bignum.NewFloat(w[20]+e, prec), Nodes: 3},
-			{A: *bignum.NewFloat(w[21]-e, prec), B: *bignum.NewFloat(w[21]+e, prec), Nodes: 3},
-			{A: *bignum.NewFloat(w[22]-e, prec), B: *bignum.NewFloat(w[22]+e, prec), Nodes: 4},
-			{A: *bignum.NewFloat(w[23]-e, prec), B: *bignum.NewFloat(w[23]+e, prec), Nodes: 5},
-		}
-
-		/*
-			for i := range intervals{
-				fmt.Println(&intervals[i].A, &intervals[i].B)
-			}
-		*/
-
-		params := bignum.RemezParameters{
-			Function:  f,
-			Basis:     bignum.Chebyshev,
-			Intervals: intervals,
-			Prec:      prec,
-			Debug:     false,
-		}
-
-		r := bignum.NewRemez(params)
-		_ = r
-		r.Approximate(200, 1e-10)
-		r.ShowCoeffs(20)
-		r.ShowError(50)
-
-		var data string
-		for i := range r.Coeffs {
-			if i != len(r.Coeffs)-1 {
-				data += fmt.Sprintf("%.15f,", &r.Coeffs[i])
-			} else {
-				data += fmt.Sprintf("%.15f", &r.Coeffs[i])
-			}
-		}
-		writer.WriteString(data + "\n")
-		writer.Flush()
-
-		fmt.Println(len(r.Coeffs))
-		pMinimax := bignum.NewPolynomial(bignum.Chebyshev, r.Coeffs, [2]float64{w[0], w[len(w)-1]})
-		pChebyshev := bignum.ChebyshevApproximation(f, bignum.Interval{A: *bignum.NewFloat(w[0], prec), B: *bignum.NewFloat(w[len(w)-1], prec), Nodes: len(r.Coeffs) - 1})
-
-		hminimax := func(x float64) (y float64) {
-			y, _ = pMinimax.Evaluate(x)[0].Float64()
-			return
-		}
-
-		hchebyshev := func(x float64) (y float64) {
-			y, _ = pChebyshev.Evaluate(x)[0].Float64()
-			return
-		}
-
-		dumper := NewDumper(fmt.Sprintf("function_%d", yTIndex), 1, 1, 4*1080, 4*720)
-
-		fF64 := func(x float64) (y float64) {
-			y, _ = f(new(big.Float).SetPrec(prec).SetFloat64(x)).Float64()
-			return
-		}
-
-		p := chart.ScatterChart{Title: "Functions"}
-		p.NSamples = 1 << 24
-		p.XRange.Label, p.YRange.Label = "X - Value", "Y - Value"
-		p.XRange.MinMode.Fixed, p.XRange.MaxMode.Fixed = true, true
-		p.XRange.MinMode.Value, p.XRange.MaxMode.Value = -1-1/256.0, 1+1/256.0
-		p.YRange.MinMode.Fixed, p.YRange.MaxMode.Fixed = true, true
-		p.YRange.MinMode.Value, p.YRange.MaxMode.Value = -2, 2
-		p.XRange.TicSetting.Delta = 1 / 12.0
-		p.XRange.TicSetting.Format = func(x float64) string { return fmt.Sprintf("%3.2f", x) }
-		p.YRange.TicSetting.Delta = 1 / 10.0
-		p.YRange.TicSetting.Format = func(x float64) string { return fmt.Sprintf("%3.2f", x) }
-		p.AddFunc("hBig(x)", fF64, chart.PlotStyleLines, chart.Style{Symbol: 'o', LineWidth: 1, LineColor: color.NRGBA{0x00, 0x00, 0xFF, 0xa0}, LineStyle: 0})
-		p.AddFunc("minimax", hminimax, chart.PlotStyleLines, chart.Style{Symbol: 'o', LineWidth: 1, LineColor: color.NRGBA{0x00, 0xFF, 0xa0, 0xff}, LineStyle: 0})
-		p.AddFunc("chebyshev", hchebyshev, chart.PlotStyleLines, chart.Style{Symbol: 'o', LineWidth: 1, LineColor: color.NRGBA{0xFF, 0x00, 0x00, 0xa0}, LineStyle: 0})
-		hx := make([]float64, len(w))
-		for i := range hx {
-			hx[i] = fF64(w[i])
-		}
-		p.AddDataPair("Points", w, hx, chart.PlotStylePoints, chart.Style{})
-
-		dumper.Plot(&p)
-		dumper.Close()
-
-		yTIndex++
-	}
-
-	f.Close()
-
-}
-
-var Background = color.RGBA{0xff, 0xff, 0xff, 0xff}
-
-// -------------------------------------------------------------------------
-// Dumper
-
-// Dumper helps saving plots of size WxH in a NxM grid layout
-// in several formats
-type Dumper struct {
-	N, M, W, H, Cnt int
-	I               *image.RGBA
-	imgFile         *os.File
-}
-
-func NewDumper(name string, n, m, w, h int) *Dumper {
-	var err error
-	dumper := Dumper{N: n, M: m, W: w, H: h}
-
-	dumper.imgFile, err = os.Create(name + ".png")
-	if err != nil {
-		panic(err)
-	}
-	dumper.I = image.NewRGBA(image.Rect(0, 0, n*w, m*h))
-	bg := image.NewUniform(color.RGBA{0xff, 0xff, 0xff, 0xff})
-	draw.Draw(dumper.I, dumper.I.Bounds(), bg, image.ZP, draw.Src)
-
-	return &dumper
-}
-func (d *Dumper) Close() {
-	png.Encode(d.imgFile, d.I)
-	d.imgFile.Close()
-}
-
-func (d *Dumper) Plot(c chart.Chart) {
-	row, col := d.Cnt/d.N, d.Cnt%d.N
-	igr := imgg.AddTo(d.I, col*d.W, row*d.H, d.W, d.H, color.RGBA{0xff, 0xff, 0xff, 0xff}, nil, nil)
-	c.Plot(igr)
-	d.Cnt++
-}
-
-// e := 1/1536
-
-// Iteration: 18 - 0.0001542847375963664311932 0.0001542847375963664312164
-var coeffs_0 = []float64{12.36171352932718993003, 117.90265029319975565401, 21.09324539844407217819, 87.92638471676305010353, 10.95221414031150379940, 36.87869501574058252265, -1.95256892797441477584, -20.68276892227670580063, -14.73056938939200474883, -69.93246072010232470437, -22.76237791952745679347, -98.14523691953502241581, -23.17672611690130616580, -100.54357082393507745631, -16.72164506799048886577, -79.02599784354713064161, -5.01345724821981552469, -41.28843043223360258297, 7.06822932787667372922, 1.68929979651644058124, 15.41043211158595277495, 38.84344592406092420804, 17.90523233889278304416, 60.90035204383960266162, 14.81901061728767224604, 64.45418551138254117048, 8.43659754820670492009, 51.26014144125149612656, 0.81819954266832350591, 27.88437476724415644024, -5.42673633424268017962, 3.24311151307283371365, -9.01410224407268921379, -16.08077967104622060411, -9.59888729181724663380, -26.33710642339690045979, -7.48922176813188494337, -27.46521027404239129665, -3.84857162629317529242, -21.58405739617908236380, 0.20947584453047506979, -12.22897965888145642915, 3.10178632599440398602, -2.91503266601107613110, 4.25697133713363053720, 4.24562091976729538231, 3.70498959889640097405, 7.86648758144637102983, 2.00779712663984165022, 8.19569635518841529084, 0.28209825451421416141, 6.09611895319078515076, -0.83640603591531561201, 2.92197863871999353464, -1.00449075841916877477, 0.19504745026938588533, -0.68697708513291107612, -1.37417738885004381212, -0.24329363012874684636, -1.58150468035770386424, 0.06684533894651650687, -0.98797331236195006061, 0.02631916816535006749, -0.31696772889694083162}
-
-// Iteration: 13 - 0.0001224075933345312728197 0.0001224075933345312728363
-var coeffs_1 = []float64{40.70490577522961635507, 41.61762154575018678684, 70.58029034451753471330, 31.12519797152359290088, 41.26362179358070359574, 13.11301650393680295029, 1.81936982762334833055, -7.16682955529271673089, -36.22859276018931378768, -24.62717682978514255000, -62.56683971525772826116, -34.64925478381053526064, -70.39296488385396024771, -35.48090342273768051580, -59.62814288840047115890, -28.08324957333266424998, -34.67096600766037651090, -14.85313587301848739562, -3.68619040749098090306, 0.35743971542580405603, 24.00632137167421576572, 13.52805580592606342894, 41.32456946501650362277, 21.38867181684163371924, 45.05025722886308373938, 22.79297741390509025350, 36.64216135737290493615, 18.26567267080481992193, 20.39589683360305274209, 10.10873372476193865142, 2.57545591386235905368, 1.35761582316366822720, -11.71133238845980503250, -5.45289485191722667924, -19.50732118938527121277, -9.26832742138501418834, -20.24916949175656345027, -9.75473717656315787451, -15.61760509638451295318, -7.69101178264133001625, -8.12350135739371827041, -4.45789812652658110380, -0.84443283367553852733, -1.14428573148194924452, 4.28439970596689048601, 1.39638557102681147076, 6.46447832424362795910, 2.76596472021403426956, 5.95382567323732066855, 2.90151672275505230826, 3.92328016895343789738, 2.18987451517810279371, 1.51923758767125903917, 1.08982848564252537963, -0.25871295047731628235, 0.11579643408706727186, -1.11376251537190815647, -0.46663133174011520812, -1.08043201437685761290, -0.56062736655967857619, -0.62955173673556616564, -0.37503439596507312023, -0.21009560213930490368, -0.11978648818584167814}
-
-// Iteration: 19 - 0.0001254550952422431341012 0.0001254550952422431398111
-var coeffs_2 = []float64{-31.51999122403957946529, -87.89809731022529929423, -54.54485588089017968017, -65.77545275241689913671, -31.56975703567007629309, -27.64112412022574811052, -0.55597442191826391614, 15.29569901653625421024, 29.18575272706494954701, 51.89255008174002935106, 49.38976873493423893522, 73.11010258514937570467, 55.05445109201261509325, 75.09062170319462023831, 45.72870261415559147658, 59.20445768114031962048, 25.22448205345123376989, 31.24149577731418279409, 0.51983463656817242875, -0.77056911618127798131, -20.88211194797269600093, -28.64956684300620106469, -33.60722532691855980632, -45.29602730097678120269, -35.22520611469198066541, -48.17026436635834904939, -27.56115093900237754274, -38.44473208532646373725, -14.19451721246168927970, -21.27033686028291211696, -0.16351137233103724093, -2.86022703625405136878, 10.69268841292618022243, 11.73013955088894466786, 16.18687930297657796095, 19.56286040054097634739, 16.02366079372810492223, 20.54500641792067289711, 11.78036022229748122028, 16.28983447930127838570, 5.49565130659395799189, 9.34750161102677761135, -0.33486560299060370623, 2.36301467503247948840, -4.20082922774426962696, -3.00595868777036941900, -5.50552311097537544375, -5.83399842827189103757, -4.65464314748572391824, -6.14166853226415641825, -2.79496310778454692660, -4.64301482056357359952, -0.78672984132620669559, -2.25462096257406831619, 0.50112911045380658921, -0.22360846029346204098, 0.98723985338383028471, 0.98105477508778717356, 0.81540412293745135274, 1.20718888947927435777, 0.41608542286493987294, 0.78167623366718409509, 0.10947989794927678316, 0.24194783997134695109}
-
-// Iteration: 30 - 0.0000812869189750575324738 0.0000812869189750575327752
-var coeffs_3 = []float64{24.93878848827374046097, 19.70887726464806240616, 43.21571518315064712769, 14.73040886025481507867, 25.15400876336022180772, 6.29567459512424210476, 0.97311801525455722297, -3.28206849123219272612, -22.38122002655753392569, -11.60830391465601934293, -38.55243084161912233350, -16.35904513339487280446, -43.22541198624565093133, -16.92153346210971356119, -36.40736257117486266211, -13.35675549859397275055, -20.90504061419029666256, -7.12989053878809501037, -1.79228685245439205636, 0.05091274342888864232, 15.15998822921370719396, 6.34733867331811656186, 25.61751011921159117375, 10.00597628279214775204, 27.60646360533540854338, 10.80710918043798687547, 22.30373287663826298020, 8.76448855045021500494, 12.21640707384206138964, 4.86715541337804421637, 1.17289266770739956126, 0.76618660208186762367, -7.46068810345909151825, -2.47806504180535182059, -12.13621886395507802385, -4.33571074624492380382, -12.49967982291292616810, -4.64595649614414697242, -9.50104846944663441294, -3.68504932101292277247, -4.80390054630056626107, -2.16039853978254928610, -0.35212592051305816936, -0.62468490179108596524, 2.80558429820941897261, 0.63818303598773661515, 4.05722517632285932479, 1.28304685030455740905, 3.64938421444840144856, 1.36082130776898498568, 2.35180263530298124816, 1.06188799433511798619, 0.85556506471776380871, 0.52415617540259578958, -0.20745185629652886853, 0.09022858810098728564, -0.70579586938170894628, -0.19786194809552389107, -0.65380746420788155279, -0.26622549433269593126, -0.37095908522854278412, -0.17752686907044312408, -0.12382949396412204504, -0.07480859075985657488}
-
-// Iteration: 19 - 0.0000595292116643923899856 0.0000595292116643923899877
-var coeffs_4 = []float64{-12.76405016303512236817, -8.69756260578311616331, -22.05348473505451873468, -6.59001512238859836759, -12.78621762210266212261, -2.73435058171812578073, -0.09061385255629284069, 1.62413497306597056010, 12.18507287246424823409, 5.22332291942026246639, 20.35971455174471547855, 7.27596198037110962191, 22.52887038394568968950, 7.37266933773788367992, 18.51451885943667790282, 5.85375603391531848754, 9.78934850195283222349, 3.01466347808887674155, -0.46223312145276132829, -0.14721644397486369595, -9.11467013192950304976, -2.89082944691575638280, -14.02712510974662688414, -4.53259316452853632013, -14.47266614513443686338, -4.81088907493412119716, -10.97894397642929062486, -3.73695662623618245958, -5.34090281293985687974, -2.05774400857555326936, 0.39630178588730747660, -0.22128820203282611931, 4.81878000794343882585, 1.25231362649714285342, 6.87438493653034662206, 1.96298156494116848652, 6.60433821255102730188, 2.02725625271709123373, 4.71355025587131694793, 1.60705106402980731089, 2.05486093710507285791, 0.87275621603356283448, -0.43525587415055812346, 0.19026225250360085682, -1.93493840739167412864, -0.30587278393168840158, -2.36607316495443533369, -0.60396643199145177241, -1.92519793674047949930, -0.61288404555812530020, -1.05660784072089114572, -0.45471041094458929705, -0.22243886749028088658, -0.20780594467750508427, 0.28277521887086660786, -0.01074598804250380560, 0.42219268915207842855, 0.11640200129673237798, 0.33452025640474598171, 0.12030472126543753144, 0.14899473834996575046, 0.07930212605540363408, 0.03391522783072487833, 0.01109611929417473956}
-
-// Iteration: 18 - 0.0001051043670172367707076 0.0001051043670172367804243
-var coeffs_5 = []float64{20.54663476673823521030, 30.19468410983447055341, 35.54022473614832988806, 22.63147254895343039248, 20.28796088904722801398, 9.60268656703997431698, -0.22513025998339988467, -5.13245927092237120369, -19.90613696690654179380, -17.84244375456024092764, -33.06729416749107020183, -25.13563716476004083653, -36.29738874257387970754, -25.82281968033898944669, -29.42598240576307709312, -20.46374588035087164854, -15.30091184934287672453, -10.83948597398955677806, 1.51262203569012311526, 0.23434129977165211525, 15.40758139217125994922, 9.77115410960378400875, 23.04215898839305537879, 15.43038421360464836513, 23.26261919844407641501, 16.60712810533567437764, 17.41002556150330645545, 13.31585411390898441959, 8.06443448642309244005, 7.40117301325957042632, -1.28932964595719740559, 1.03347360999453406176, -8.23874790498673673278, -3.89018020621726289838, -11.32586239693210488716, -6.69957864100673088498, -10.78064318488010278768, -7.10994420560581596018, -7.43990869838024969039, -5.62115360539779021005, -3.01182902606816182320, -3.25864537312222441697, 1.00397332328944681631, -0.86575271286410283000, 3.36919426546208564396, 0.97553677803209054675, 3.98090886277671885695, 2.01799803451009274035, 3.09768111793051146598, 2.09469764802461351868, 1.61054508056013431609, 1.60470572829753261340, 0.23843459638372456095, 0.79523527181051570682, -0.53429821196335647987, 0.10682195087988692129, -0.75320306762084545100, -0.32396103117159692240, -0.51123147235822905230, -0.40830462976971545638, -0.21881867362497350379, -0.27791214998761702960, -0.03651842049554254464, -0.09465662279652763517}
-
-// Iteration: 33 - 0.0000810856031097835150861 0.0000810856031097835153148
-var coeffs_6 = []float64{14.84115889911272517159, 9.03943448921579971616, 25.76485840120080270238, 6.83797241449359159480, 14.62857718220532636072, 2.99133775193949231091, -0.34167292004794024057, -1.47250428098767908955, -14.66093152216831438318, -5.38143358426383449079, -24.13244765370223215501, -7.63127840872226567029, -26.37309253549450998534, -7.81352348730892411144, -21.15179594307618258153, -6.26288358569255646453, -10.82239286494722824063, -3.27949234445126832101, 1.46531479400372690669, 0.09372124932779382562, 11.62862260905212551049, 2.89286652649374037223, 16.93966120511794182471, 4.61403033196989932032, 16.92745794918616595509, 5.04314293633316895488, 12.46784618394615090839, 4.10970807229452376912, 5.53845427152383659692, 2.22840710819719518283, -1.25095940265893972801, 0.33235363049447289160, -6.27407036617034247468, -1.14648920305014335131, -8.38312110868791890460, -2.00062652005984310137, -7.89507553895342679880, -2.17895949793561559782, -5.31663035386813759921, -1.72241715844385066019, -2.03913546215458493214, -0.97836972174411089864, 0.92047234009436726584, -0.28896540891461951344, 2.57034557190969601044, 0.29003122062709560620, 2.98920629193502472307, 0.60568335808593914708, 2.25915054388083716640, 0.64415522388362949638, 1.10965281519070738305, 0.47312444995658853720, 0.12544394252137051838, 0.25261109466173681044, -0.45416489320542156275, 0.04344614330437947716, -0.57154004477646333921, -0.09474025703215701499, -0.36920582350217414990, -0.12279537536910801809, -0.14839021820974166865, -0.09388291978463780255, -0.01154478986035604321, -0.02677181251843290658}
-
-// Iteration: 15 - 0.0001345873284609734119348 0.0001345873284609734119851
-var coeffs_7 = []float64{41.83948625913336271462, 31.06375530857237593440, 72.50631517725490317485, 23.23887029332050165764, 42.34896098383630118461, 9.73675653751017062275, 1.65314590601807636701, -5.40028700575671817045, -37.48328771354501706613, -18.39501449255467283362, -64.50732339188040863847, -25.83274004731349428570, -72.59734092175407762863, -26.42353767441457755581, -61.30418344884323678304, -20.94163699706590458097, -35.20656241517501934824, -11.04806776964373880174, -3.11224970925616019540, 0.27794818102723297235, 25.28293192159634674393, 10.14047887834779506512, 42.85636551524704252445, 15.94537918565230285644, 46.47207882349045130534, 17.00343976664905911634, 37.45502116224262796049, 13.60131715939122443401, 20.55888666795540932444, 7.52518639719622909173, 2.20529132576435664284, 0.95432445605951238582, -12.50480751241043789753, -4.05012028340837561082, -20.31169410543965218264, -6.93873568052563887817, -20.92518287041200144715, -7.28188781864106735105, -15.97135100640620310797, -5.70665812590359232935, -8.21043952497992123706, -3.32387146547356842991, -0.56876472573215187286, -0.83006489024381792953, 4.62917351710258166029, 1.03870408443523049553, 6.76551500093227004975, 2.07569205539583248568, 6.14953637511922340659, 2.15809646071994212790, 3.96476559840678501771, 1.63950354516759497439, 1.45802442181675653019, 0.80022905501013310069, -0.34986634746897944401, 0.08593241308829791822, -1.15400358677317278407, -0.35816449825315456215, -1.09864375198940299108, -0.41422953992945864046, -0.63821572639533497036, -0.27992069626588808504, -0.20752368478377798610, -0.08625640214976719510}
-
-// Iteration: 22 - 0.0000964625735051454526551 0.0000964625735051454526612
-var coeffs_8 = []float64{27.05099012462974350725, 5.17722165065449417309, 46.95872349374826701504, 3.91463663902482091837, 27.29837348006993524507, 1.67918095230076614470, 0.73693038583316334196, -0.88112092963210366367, -24.67612570739952744136, -3.16652570237294942845, -42.07477662745596193911, -4.36495347450472147811, -47.17407582598486980257, -4.33736220911304841343, -39.42923322503518909854, -3.49307104517363284134, -22.29641701352945671687, -1.85664138932103580750, -1.28625183438222797813, 0.02927389271193881233, 17.18301628710248077854, 1.72328653196396138178, 28.25282446517901430115, 2.54562928793590574732, 30.17863579655687261051, 2.83178707122436829969, 24.02028477473600615684, 2.38308240683061535294, 12.76518823507942156212, 1.22733456385132347772, 0.79986697029144308372, 0.14778625881806063155, -8.64840754633947581633, -0.59441689734899132831, -13.47621874917634634967, -1.16790272918416821857, -13.70624644486728320899, -1.26747074845561690219, -10.21583798802208684978, -0.91711628494406796005, -5.04114065379734011810, -0.55240204584343907178, -0.03071558115742109719, -0.18566048001837462066, 3.26212133607679168451, 0.18194927965524443782, 4.57244900502536580734, 0.34367147095949310951, 3.99273719262598710003, 0.34873212248468638509, 2.48302556787614255425, 0.28195169107571886870, 0.83525395943649516842, 0.13805971222080594690, -0.33669526341890798110, 0.03129547492685074541, -0.80136916945001354196, -0.06799565018445395799, -0.70438728164426775092, -0.06422004183079976185, -0.39703350607571306475, -0.05311749244483004765, -0.10667527910454630679, -0.01417058626682093069}
-
-// Iteration: 33 - 0.0000447525806377146726931 0.0000447525806377146727439
-var coeffs_9 = []float64{1.44421871068998713211, 7.80760866714813462551, 2.31814647647329529208, 5.83719798932642263846, 0.99367661793532422893, 2.40114760070650101720, -0.87425844436118463008, -1.38814987148907832764, -2.62617371738871079627, -4.55635844220359271905, -3.52271556744383475636, -6.49366492503915371617, -3.32464520260132482243, -6.65760334983805984463, -1.65048707937805520621, -5.08916923197941610754, 0.87182582483192265563, -2.78688372093238068451, 2.86486707727332081242, 0.01045558049010096639, 3.82081443414645604346, 2.48920967718017363807, 3.43806199154799266117, 3.97363379977453175613, 2.05861170154464946120, 4.22473080648272049258, 0.31442722276593530767, 3.36424524787435815222, -1.23831593404323404301, 1.97463498302069575318, -2.23077925658463500268, 0.27107769841485633742, -2.46520753474571562589, -0.99057551904677958356, -2.05941206884188524868, -1.74551021738727669648, -1.18698940055008089540, -1.79251067213857692341, -0.19415418987428293517, -1.42327839513218867880, 0.69248102702516476746, -0.88120104408892877795, 1.20928765009439849779, -0.20390214305856353484, 1.27336244411137467270, 0.23953009793854212896, 0.86691710311054827362, 0.51406805253864057429, 0.27923366127736507997, 0.51967763266619548799, -0.19216994952282575563, 0.43008124474342463504, -0.42545336817145645040, 0.20708942582917180069, -0.38424067991778006585, 0.02025835857461647679, -0.16292223467135627952, -0.06329304567067780335, -0.00785769786125714583, -0.11729537813216618113, 0.06495622912918756474, -0.07111703645360943380, 0.04127190642005949400, -0.02446250511863746791}
-
-// Iteration: 45 - 0.0000819313755711078941089 0.0000819313755711079111400
-var coeffs_10 = []float64{20.19460648896725509054, -4.42487172220002807676, 34.94035903191720611172, -3.29041842796661201037, 20.17208837917135633310, -1.49735464063158566037, 0.35245087988857235290, 0.63935418580629170987, -18.71351403931822524983, 2.59056609383852424111, -31.71249019875424451039, 3.71160043566648867672, -35.33276614223281167167, 3.87282280426875540299, -29.28229333480229305618, 3.22026543246294167675, -16.01145363959499512066, 1.56725040346029491078, -0.28782602998826845795, -0.01075544218671170372, 13.55883201990257561136, -1.35510288089869171513, 21.56982069769470416388, -2.28395578427373901873, 22.62283150532085228916, -2.52185116453986433140, 17.60731473554031470993, -1.97737608893820823099, 9.02538240470572866917, -1.15323582845910035106, 0.02104209899743239027, -0.22889820837891684939, -6.94603571314788978493, 0.59621069112177557096, -10.42769299635711228335, 0.94063003572129031186, -10.29693570349268272769, 1.04728994416713883497, -7.52525969884406068686, 0.91223856002759273016, -3.49948578759329014958, 0.48542776540316024719, 0.26306042141664521180, 0.13810298787218063447, 2.73488978473670924413, -0.10992320336005960932, 3.56439693715545518896, -0.29972041001915303251, 2.98614273505053508168, -0.32972793895600730064, 1.76158173067818083268, -0.22526369046682801432, 0.51645477719528097890, -0.11872492635326529573, -0.35546352698983347376, -0.04450010904342489148, -0.62197247952099117594, 0.05030945491412503289, -0.53488313091861007303, 0.04964914432792794751, -0.25444329707046173282, 0.05595493786443163949, -0.07338294608860521290, 0.01465052474927652489}
-
-// Iteration: 38 - 0.0000824671593268597067359 0.0000824671593268597067373
-var coeffs_11 = []float64{-21.65924536363497997727, -48.94652730904555794693, -37.53176166677364814646, -36.48997985430821479001, -21.68035279436376007976, -15.38168735949769993460, -0.46598798687106026734, 8.41555918487435296741, 19.84664908484053545995, 28.90226682865789967354, 33.81014885736705233426, 40.67882235497522521134, 37.80576061487155604081, 41.69596364345124965310, 31.41295054641066524589, 33.02932990249048633320, 17.53731166151283917359, 17.31359825854475316962, 0.65175751735478884397, -0.41199303102536456745, -14.03988167165390525168, -15.91429259651849526646, -22.82811788868032877109, -25.20331552127689645336, -24.15781282304337552969, -26.75777453512504328101, -19.03044494122352302225, -21.43782739848250974108, -9.93082003277605457276, -11.81125459684631718832, -0.44224435602942217119, -1.57018834453483682155, 7.17799907373802028644, 6.48716745512271937847, 10.92846002858471478252, 10.88331147789266007766, 10.99897246658419707825, 11.43944628517011984900, 8.08332153477505945070, 9.04709727063598813230, 3.94558598215737674639, 5.21261754736510494676, -0.14474489944071052571, 1.31376080438830804456, -2.72381039966476120608, -1.67907834944647695011, -3.73526634961081675576, -3.22770787241297446019, -3.18220668026647584400, -3.43617617637830036838, -1.94346646519922693243, -2.56162168391442974084, -0.60082077876056345642, -1.26220061397330487429, 0.30287872395711040328, -0.13914967374284443126, 0.65597910657154042353, 0.56905140267858295246, 0.54841588840115830004, 0.65334188368927204890, 0.30699995094860097676, 0.44169608950786528314, 0.08449110707672382324, 0.13396204708467909467}
-
-// Iteration: 29 - 0.0001047699980793367890211 0.0001047699980793367952235
-var coeffs_12 = []float64{-30.31002944429030410088, -72.83742778546922281770, -52.44726254123362808480, -54.37293556865925148958, -30.44840503271954938838, -22.86000501052235528659, -1.00220196529879802407, 12.60328757312589387482, 27.29409147989108951752, 43.00862125554721961038, 46.92597673921200418028, 60.52342978067231071221, 52.69588037757800247719, 62.09896798178246917954, 44.15387082493871081351, 49.09292437657799045749, 25.02908486166498021412, 25.79804116755882195174, 1.78425188214697490033, -0.64264858290313738208, -18.77113316907974634814, -23.73242524135817815338, -31.42567211201269527058, -37.60743200923477781393, -33.66299217297013978437, -39.83321970490307543492, -26.82990065337466108805, -31.81755477782636290699, -14.51221543914866716393, -17.55477418214316925842, -1.22876068442494731436, -2.34373409447645337912, 9.41304031479290296230, 9.75939003020949529965, 14.94156704308108480229, 16.20294249988872649325, 15.22954801650661858812, 16.99179646777865292867, 11.41261717473465018297, 13.47588229486818414449, 5.78089922240758984383, 7.72698356262602583022, 0.20622586315839753535, 1.93281877275401990895, -3.56125061532695529130, -2.51950804746302600509, -5.01439489573808433768, -4.81055835873558873961, -4.43237057862404629094, -5.11276159415036980957, -2.80709200227531009120, -3.81416647739635087961, -0.97055373899980111987, -1.87264000792332275991, 0.32033610426514774407, -0.17926931721985210694, 0.86368292829492188335, 0.84445958091601784487, 0.78094041306722560373, 0.98136989804800219165, 0.44474775569418701156, 0.64266360475916451668, 0.14151917908092477585, 0.19913141827149928072}
-
-// Iteration: 41 - 0.0000620829464603990288069 0.0000620829464603990288070
-var coeffs_13 = []float64{5.77013518689187283968, 38.94829932727773147452, 9.95922572194810295036, 29.16343948032433350791, 5.34330457045017595351, 12.35208546722532858103, -0.88517636500153445921, -6.72363126099537131166, -6.74970039928554390821, -23.07874101413857028035, -10.38382665359331935515, -32.44106722927616488699, -10.85310422071521057177, -33.32218278467444898523, -7.88139385196534862287, -26.31550668500639009302, -2.69875853482270808806, -13.85033696766357031236, 2.85835003124591234518, 0.37250456582838357995, 6.73990450575324310448, 12.69895605842675024934, 8.13939618514286551108, 20.07834323294817992185, 6.90395151141149404463, 21.43445233834205746012, 4.10836413434692185409, 17.06495196441455079999, 0.69738426390092415070, 9.42413341896316002311, -2.27468541394557349467, 1.27259300472431224424, -3.94789965235791255233, -5.23908985285948504902, -4.29947463256245965766, -8.65976999588298170650, -3.43110010858603022007, -9.12350621686595645770, -1.80801094682963327134, -7.26162812898290983036, -0.02121576321615326535, -4.12308192268363022161, 1.26615146743048815445, -1.05168030556860148482, 1.85943609352328466130, 1.33190860992959910115, 1.62918265651450122454, 2.60161485589186322638, 0.93675412042931452532, 2.71973552570252212886, 0.19156087798361086780, 2.06044152150060808147, -0.33359177788134034134, 1.00714687101424216309, -0.44609253928773826223, 0.10346773296439612948, -0.32817093786976931680, -0.44893095453096327976, -0.10970678733537533779, -0.53339379561115863881, 0.00593897988971123276, -0.34077649425464481939, 0.03246627044676690657, -0.11103627148317131552}
-
-// Iteration: 33 - 0.0000545263717645394676329 0.0000545263717645394676335
-var coeffs_14 = []float64{8.88833476646095462669, 40.32174472491654224756, 15.29713467170131742062, 30.04476599871551702909, 8.54342639403714224917, 12.61603725836848214236, -0.33500907235938509142, -6.97905127803591608991, -8.77614452299747481689, -23.77260024379596591515, -14.50517662966864240936, -33.48492054325007332611, -15.87419708678601252477, -34.38627422587240566800, -12.56140651817456168209, -27.05293988019893096830, -6.14594253295546676567, -14.37543658220452425914, 1.27535507188286308924, 0.37841110836134186631, 7.32126647412590833824, 13.13545983086722205225, 10.41750313794659400064, 20.73312449200684726936, 10.11317188806804066322, 22.05853375287312223181, 7.25159566898116324001, 17.60599212265109364141, 3.05162061699297034116, 9.73028511571958775791, -1.10744345251158949092, 1.30742960200729964834, -3.96179146702970229518, -5.36260436911005585622, -5.21630624770715057934, -8.95804472493424238388, -4.72244945953138476195, -9.41053131645404276420, -3.14204188590920861541, -7.45233197714844685317, -1.04165370893673009511, -4.28240810191325500694, 0.66369410567909964766, -1.09401199004108297021, 1.73136495947445993593, 1.38396033621951717053, 1.85529261708125510664, 2.67836275746999728170, 1.34716388689623177465, 2.79443490226344662895, 0.61297118775681023592, 2.13111003484706834815, -0.00220286811389740860, 1.04734715239419998161, -0.32345704017460600682, 0.08739268733213535486, -0.35296804213263701552, -0.44546726847888238152, -0.20214230468664944702, -0.55479311010005058468, -0.07670232604083571379, -0.34641957006363818488, -0.00577846774064048863, -0.11833682541190744073}
-
-// Iteration: 25 - 0.0000715047008566719831252 0.0000715047008566719832271
-var coeffs_15 = []float64{30.36528706544705404329, 32.76579109629607396086, 52.59723172386196268542, 24.33799402606575209886, 30.92463131006596748876, 10.30229276008354839902, 1.91570193414391729739, -5.52170448090946381390, -26.11891226063486286429, -19.33158350701468459536, -45.93937428191442432862, -27.16455447589013166528, -52.18991829466964837715, -27.85630000528759070921, -44.71687428082938952931, -22.09144897354146466216, -26.79396053048907166164, -11.63801417809972962936, -4.27032315862197294499, 0.16802155126128672851, 16.21573194092737307555, 10.54546546990628933527, 29.76608514963974570078, 16.81762811029035161326, 33.32518063402840175483, 17.84884607801192130969, 27.74776726263397311391, 14.31939160268651356886, 16.25625858635418929839, 8.01704703461932511253, 3.22953017337787820119, 1.14995671960419813223, -7.66992821860115501145, -4.26979303561674161628, -13.81333841931563338494, -7.24724274003018008793, -14.83402021646066000672, -7.61811082185615627354, -11.77633750059904898785, -6.06501330553598331821, -6.65303560754370599917, -3.54626732255776200685, -1.27964572438020924684, -0.90875196472843754864, 2.62857103281858347990, 1.07800375047054435774, 4.46712190419777146256, 2.14150566454727486613, 4.37569058679647737501, 2.28420424200662927413, 3.09695555017255638184, 1.71421688214820075422, 1.37295015464171422924, 0.86694446605388601907, 0.01870616112156039266, 0.11268707611975321034, -0.73958322395974218474, -0.37294127878159399421, -0.80372369457347778838, -0.42673154368372588369, -0.52654342866261571807, -0.29281361122677967958, -0.19372609367436461892, -0.10499183541112325596}
-
-// Iteration: 19 - 0.0000715786737342899222319 0.0000715786737342899386028
-var coeffs_16 = []float64{23.93343700951065914877, -37.53618937239005586017, 41.54267356250944575665, -28.17223719086500066836, 24.26692768576459506563, -11.81393902604899561329, 1.11783919139016108787, 6.58299581393652265800, -21.26657860855155519897, 22.15264524303246792389, -36.78213846544956180313, 31.29935211900154755745, -41.39384951313561703653, 32.16296931322459885238, -35.14489570725616146033, 25.24856484767648956690, -20.38456314050542627735, 13.38588443438647278336, -2.23663684190735076634, -0.35765194930799884876, 14.03741715657086645059, -12.25495318524707282681, 24.30601247154113211149, -19.43699143721491705685, 26.49693193909035820816, -20.58123546751984838778, 21.55247305645891531550, -16.42938330156531548181, 12.02332979432970065623, -9.07689884119452978685, 1.60093435131407687789, -1.21794489781932622139, -6.88407277762075402340, 5.06106647434587423142, -11.42583491133233209129, 8.37767981725819813949, -11.93057087827053926945, 8.77419552145143233583, -9.14595791133128321253, 6.96175110299748720005, -4.84493435470683696666, 3.98986316408164987416, -0.49821693564618219798, 0.99950194034064625436, 2.49689327616203745517, -1.30784748395816714988, 3.79519963339695440100, -2.48884908967153501924, 3.48640597138973362495, -2.63380302010294474859, 2.32387619541628492588, -1.97964437910076916820, 0.89341816276426724196, -0.96744569594981374126, -0.14352623512390534601, -0.07296935898995536158, -0.64595779933793986212, 0.40611642664217567097, -0.64137487712805390698, 0.52949775727466147201, -0.36299390149772626658, 0.33056872408472661212, -0.13011461248169693137, 0.09884321221129480816}
-
-// Iteration: 26 - 0.0000427590233749553051601 0.0000427590233749553051601
-var coeffs_17 = []float64{-17.21102604971671914742, -15.26683915620370136068, -29.74117539238901765600, -11.25099662091265185645, -17.41080741275920165377, -4.77919310986966964958, -0.99161217830107318957, 2.54835330706151976520, 14.85184375785866327628, 8.97095907949559039042, 26.05738444123381068168, 12.60825405130825549472, 29.57712785906862165899, 12.94523923134248307277, 25.26504621791748950578, 10.22536648392754600922, 15.07733566410849469641, 5.47332600064490550809, 2.27186551947950555273, -0.07448732821160053779, -9.47468294754442468343, -4.89995353737112511078, -17.00614288922351341664, -7.75477830869848905766, -18.92112881371700813647, -8.27112975730630438368, -15.63992534841522171847, -6.70643296053169462562, -9.04487967388745938112, -3.71877601281900605515, -1.61403002887842730226, -0.54519471954330853795, 4.47386094015407342655, 1.92184027389722650612, 7.94017617988370612590, 3.36152539332120921898, 8.41496218070847648061, 3.55988748963468917206, 6.66598925355080132062, 2.80077239585072098795, 3.65370094906200991865, 1.65982128077561623823, 0.65182542780634890502, 0.45525136174854877138, -1.57428511938999845350, -0.50317449654145054761, -2.58797917356274440059, -0.98905664704218055003, -2.49282910986159721736, -1.04990107300523436351, -1.71878566306777212685, -0.80216382694814069918, -0.75869144444491714687, -0.41191122962182105588, 0.03787920089470142022, -0.05142902397305628014, 0.43547262442960975570, 0.16132846736756148155, 0.45770516645409211215, 0.20160804080345490782, 0.28582096466864281700, 0.13243916469898519202, 0.10169293190342293273, 0.05476073172112195488}
-
-// Iteration: 16 - 0.0001114023417894759247085 0.0001114023417894759365745
-var coeffs_18 = []float64{31.34433949469858100669, 57.60194319765253096255, 54.39871023925530790200, 43.19694586840875420855, 31.56085872279743614355, 18.11204077251656224856, 0.97651049965405505012, -10.04145522232247221607, -28.42702118047425466642, -33.97146613897280392547, -48.74528304039206768145, -48.01163655209876994390, -54.65971390985408434456, -49.31152420321743983164, -45.78599507593694606762, -38.84865771799162402236, -25.83547375261279798105, -20.47059100517769629050, -1.58644011603455446409, 0.53525686489240200958, 19.73084677947942156115, 18.81146091778238390695, 32.67158378616435285222, 29.82881547684915020002, 34.96154777557502817367, 31.61172683795261182314, 27.77803059140739127544, 25.16175581980486123650, 14.94306568420256346054, 13.95420484927973372197, 1.00643158694197934636, 1.83586611346713189423, -9.89497575147127442054, -7.76050073245204948065, -15.57744731702709220141, -12.86096400953418974617, -15.83935738592533603130, -13.45630105671536049044, -11.83959017444261753883, -10.68977846857102435369, -5.89517231972589947791, -6.13205867108649643370, -0.12748251090177236143, -1.50092723572605823695, 3.79178759384226754054, 1.98274008913131711569, 5.22810785398479402478, 3.83779144082953886575, 4.62896089021360383669, 4.04328762822786186160, 2.87255932733646859721, 3.03598077587990992681, 0.98097585415041143302, 1.47110656951015032553, -0.36346816260676331000, 0.12844019102380987829, -0.90808728268473388167, -0.64192730962404702252, -0.81477664984376095148, -0.79651860127120351517, -0.45036893740143933423, -0.51740222039103147354, -0.14249790971005696536, -0.14835901305644320196}
-
-// Iteration: 34 - 0.0000495753821192154673055 0.0000495753821192154673110
-var coeffs_19 = []float64{-8.46214047900024108996, -49.59947547923193118156, -14.53580816098495182701, -36.91319313859252560020, -8.34049281361299497726, -15.33711402771036654640, -0.12402333370454943835, 9.01533918510372232511, 7.80725620344409155070, 29.75298044319083795557, 13.30621125977792150597, 41.52764573510758649755, 14.79236610445172433849, 42.34344396902913113146, 12.26453698830200824954, 32.83594466693045622354, 6.65192423348747374556, 16.71504003780367230091, 0.08599576242815892643, -1.60707631631522725397, -5.73741422407427454097, -16.98939979570008980528, -9.13132210697326129292, -25.83814476623834932640, -9.58798160068670598965, -26.84342779382503406557, -7.49839699454235768783, -20.97485893145219852526, -3.84624069881165256375, -11.06311122622168839293, -0.05213363724739368344, -0.76411634829799974016, 2.96211575480850173205, 7.11760961950339518585, 4.49256281479600724072, 11.22343826712636553768, 4.43545068106698884931, 11.44510542384390885290, 3.29522098443144164288, 8.75883079387513103590, 1.56086320559506178431, 4.75517151414572850752, -0.06076224275666120037, 0.85265134776009633456, -1.13820705931815122375, -2.00574802914872648131, -1.54273355340718826543, -3.35472230791717813028, -1.31573895154237653859, -3.31398044295621135760, -0.81748906081688042935, -2.36301566226810273804, -0.27202943702269629254, -1.07023383452859047795, 0.11110786257881676061, 0.01078517207645378463, 0.24443031192544992761, 0.55404763539972494713, 0.24324917388596782075, 0.62572549215669565784, 0.13741945587812884964, 0.36756015148257826654, 0.05791859802671333225, 0.12693597086873507823}
-
-// Iteration: 15 - 0.0000970419611123094697545 0.0000970419611123094705918
-var coeffs_20 = []float64{-31.33465257441748761737, -58.70781968860172060258, -54.26714919952900008508, -43.84635315852638914938, -31.65553224960023830842, -18.51621386766030921446, -1.40597223441789193480, 10.01572706595955912698, 27.81339982431947169238, 34.58393640776520535910, 48.20315793142602782576, 48.82716738474703532640, 54.30192840836159023984, 50.17932236794564077292, 45.91189494822889133333, 39.58720852619244029558, 26.49096362831905162999, 20.96989013947598861893, 2.71992285051870066013, -0.40269130191492807325, -18.59546085370936769818, -18.94709629165564188204, -31.80242229897874999748, -30.15562092621607906114, -34.71675517594537393251, -32.09413948944248237767, -28.08979063010315046691, -25.81463856734479611302, -15.66031351453905320947, -14.32737331121537037437, -1.91444486559439838296, -2.04626266256804496524, 9.07861593219460255312, 7.66978306474811723629, 15.06212997447040967569, 13.01193013389089947543, 15.57849713177928141772, 13.73252412392373230414, 11.99473410834061951893, 10.90213690438300789232, 6.24605763591323461799, 6.33522302740771817513, 0.61095865558269419831, 1.65881743781303213682, -3.36215150956049020217, -1.96682908736868680764, -4.97619276615622615151, -3.84827218546136996583, -4.58636940362251338840, -4.10571564937514347610, -2.97431697666393330870, -3.09011786065446724953, -1.16852319330806194533, -1.55244262805465198397, 0.22420650020107421845, -0.17854113480586995828, 0.82955280518861893947, 0.62488955020972741915, 0.82589138216975456693, 0.79999427423156407648, 0.47982286079866283721, 0.53146482422890778291, 0.17235209529436301322, 0.17936639294337525450}
-
-// Iteration: 15 - 0.0000815638612017651576703 0.0000815638612017651576802
-var coeffs_21 = []float64{-24.81114473012165216186, -12.87883578831976003311, -43.09626888498992309732, -9.71061066984465440013, -25.08377840307117046977, -4.03691053514135148423, -0.92595521606499094599, 2.24763322120441502838, 22.29955531250129779110, 7.60365992894839911762, 38.36018868594667729142, 10.76723219068430406919, 43.12076447756261520144, 10.98446858753528511257, 36.32911306432567061223, 8.75403105001629167024, 20.79488157014039884709, 4.50681100272741515339, 1.77336874443995591077, -0.12842219982807429100, -15.19167777070413900587, -4.13985506258523981120, -25.51714463162902379910, -6.64972244665757919410, -27.59060395216720845476, -7.08466707273027528407, -22.17884693684668525771, -5.63027265049140217884, -12.14498078070145616413, -3.15565531329245592362, -1.16018341605751342046, -0.40116659476454088417, 7.50211897251241425773, 1.71465021466560130444, 12.08355813616986176732, 2.87161629866385248112, 12.49355014719971246961, 3.01125780584976959134, 9.42880237619726002310, 2.40357301944145887372, 4.82423308136295279328, 1.38068883795682044196, 0.29668671672370600826, 0.31828800368472612122, -2.80238967835263272734, -0.41287645322319374357, -4.04815546452608218337, -0.86181651427630269710, -3.65346262680931199004, -0.91458123716493356190, -2.32654825584383628054, -0.66963208008672412533, -0.86261473914039029827, -0.33235192046467349458, 0.23706316066073155274, -0.04283053979280819434, 0.68885865278410383813, 0.14864775272995485513, 0.65486435336363567948, 0.17018409726428889057, 0.36950856314498202915, 0.13163487691311982810, 0.12210271085124194956, 0.02724919537826676263}
-
-// Iteration: 19 - 0.0000581833501005978986154 0.0000581833501005979150448
-var coeffs_22 = []float64{11.34016855650448597172, -23.88099692400833111775, 19.70801807847284722257, -17.87651091662256427408, 11.28078128125368375688, -7.55776799457866421051, -0.06770349998423537033, 4.13621980736756196793, -11.00390231304846565349, 14.05421938345901156759, -18.31858107935053860718, 19.86221635398332249590, -20.14247030045881774898, 20.47248356079864617024, -16.38054895855150403499, 16.09748525906463945291, -8.45444327867136577995, 8.49808913846268111738, 0.80375582540678651965, -0.19232603929416607722, 8.47145902934141650770, -7.75106714793097651604, 12.77071937399713799859, -12.32196388721765911714, 12.91578130455144953861, -13.09386478831255681253, 9.60419958578505761821, -10.45904004187152665695, 4.57582768061121999045, -5.80590894209702925482, -0.73370488799183137202, -0.80404236416621924202, -4.51174371967670068816, 3.19023595686043760887, -6.27134898736775752233, 5.30686292253536933802, -5.98524998081337779717, 5.57955438553017694792, -4.11280743002676711240, 4.44740330041016332407, -1.68779945292255645225, 2.55365040654675229810, 0.50697883449517910098, 0.64565616216747680021, 1.90696173734683037960, -0.81312206876474825769, 2.15921171356396960065, -1.57745036713545510498, 1.73234792033350068632, -1.67639615353848622828, 0.89113635259938083407, -1.26206971560859245168, 0.13684997444493606047, -0.61371976412628958678, -0.29252668326460428404, -0.06422508292591706580, -0.40383289267531872377, 0.25473496251257523577, -0.28819572525052035704, 0.33463214958719504003, -0.11927292412211585623, 0.21402674161742893218, -0.02497199288150502229, 0.06678764229574367701}
-
-// Iteration: 16 - 0.0000813757279081883172249 0.0000813757279081883172249
-var coeffs_23 = []float64{16.06287545816919633997, 43.33154951553193651050, 27.66538808318610591158, 32.44878815112731913150, 15.90487462099181693164, 13.67821407856176896015, -0.10072826991372125630, -7.48183221303769128024, -15.45429218260222947708, -25.60840433339863653773, -25.73434047863485940235, -36.09041829316066228532, -28.39599608417954720384, -37.03444143331983006022, -23.04894726704114005741, -29.28166707378795191963, -11.96378038418932020723, -15.40305509159997329803, 1.03493605813378232913, 0.39943961797644997907, 11.98989784571471097456, 14.05113904932986306933, 17.96247840975625594695, 22.36564650804345381018, 18.17205186037869328885, 23.75230539666872853519, 13.59289528399310797279, 19.01388411939532824391, 6.38839781104108803532, 10.51903627541235696243, -0.98714430970358026837, 1.41063807675485676418, -6.34077921547788451265, -5.75021496683202886602, -8.84665903026937577647, -9.64940015612449293299, -8.38473316521322140496, -10.14663641620400168076, -5.84185950363797741500, -8.04630104923834468677, -2.34886435831092500283, -4.63525448786680085259, 0.70883546437101452549, -1.16173956011411806718, 2.64150097335520949154, 1.46641947518659796750, 3.08099338962658030967, 2.86941944321536202353, 2.42271662622839739502, 3.05127073832137046794, 1.25891154157566911635, 2.26848062853023223053, 0.20531491751321040842, 1.13350341914110839808, -0.42978948816222486802, 0.11535564890423656164, -0.56240538398832702281, -0.48410335904450351255, -0.40464416071652963491, -0.59100719326382894800, -0.17448596255853279993, -0.39237412705581013955, -0.02979705516335085431, -0.12024690805229454929}
-
-// Iteration: 42 - 0.0000543204747025865607249 0.0000543204747025865607269
-var coeffs_24 = []float64{-14.56009228071492709732, -4.65583327628723281319, -25.04457676072740068092, -3.49529423449970898945, -14.56025621155707631651, -1.49354347005881457484, -0.39851939023765756994, 0.82350534708737393173, 13.26685414107233364021, 2.82086789156730675298, 22.66220205874276099879, 3.96481115973615750567, 25.33892879137995967907, 3.96283056655533454851, 21.08915650305269593402, 3.19846728038889534157, 11.77128838032857996633, 1.65050011970280466976, 0.53087391898178486621, -0.13922561980257251373, -9.38666226123527986550, -1.54669169389142682597, -15.29450825730276952260, -2.52340623853154324324, -16.21217106554645356111, -2.59737204882164383236, -12.75919848246257668927, -1.99852153785983249114, -6.74628105451248281835, -1.10916016872752332050, -0.25849325011284751900, -0.07798555201737868728, 4.75715635690447860434, 0.68597997921568182846, 7.32105693744575026154, 1.08167684152460963929, 7.38213527360642665823, 1.09193747333382943149, 5.42514427359996415755, 0.85971550192716090346, 2.64095950438194122340, 0.47712187724931447610, -0.03963371391632881356, 0.07330591315631587806, -1.85054038443022146143, -0.16250442541220112655, -2.48229093006642005264, -0.34046310317599607578, -2.14628938767875283814, -0.34270573784015977125, -1.29999068043597359782, -0.22234343753977546617, -0.42305710826606387825, -0.12597908560754462479, 0.20534902596992506647, 0.01045890626032394684, 0.43783192332521752318, 0.06625228800170975872, 0.38231525430985521288, 0.06354874187767827968, 0.19929668224669044879, 0.04236230417929852033, 0.05613480932957063093, 0.00303896330955894377}
-
-// Iteration:  7 - 0.0000306112757792582763647 0.0000306112757792582763668
-var coeffs_25 = []float64{7.03494284035016865382, -16.77210454358475956659, 12.29302995984184655173, -12.48813882208692570766, 7.04039888587697493026, -5.25612019298133842197, 0.04665849841681324963, 2.90948487303668632057, -6.61339355831505082120, 9.92878201514160373721, -11.14671730433955192957, 13.94378026246530767389, -12.38992815811929478305, 14.24451112200156951722, -10.25226942399869529196, 11.26798049292358581409, -5.53112789957012758369, 5.98108603735881668722, 0.07835161551900721413, -0.18986397460335420548, 4.83536061444430169103, -5.45898332115207952050, 7.59282719582616816970, -8.63900139864268804514, 7.94565596442162452242, -9.16530111610100331026, 6.11050566264765361694, -7.31627801301941325184, 3.09138102777825558365, -4.04659586252483755173, -0.07138471409999919556, -0.51204029644354583994, -2.50717893513519306238, 2.21440915792586599521, -3.69271350280821457377, 3.74490857975358048544, -3.61285435191444758877, 3.91945045328200178198, -2.62646910030174640853, 3.07997870431764711348, -1.19926855133447878460, 1.78263728586032726813, 0.15202341564053563719, 0.44096055438373015431, 0.99781082730096109193, -0.56591119990406489964, 1.25212582637354637617, -1.12395360264854884160, 1.05765837695797467974, -1.16763498089997975652, 0.59957493393871498594, -0.87368160313177964681, 0.15578737127133660312, -0.44161647626544991164, -0.13070996193853530882, -0.03208950313658217568, -0.22001403335569215653, 0.19007551784103481156, -0.17813486965519681906, 0.22785912618651852811, -0.08865677189666402981, 0.14644256249376740132, -0.02736223109877447840, 0.04633927215430736281}
-
-// Iteration:  6 - 0.0000700612947151044663780 0.0000700612947151044667019
-var coeffs_26 = []float64{22.11866087599961510348, -16.89696877290419310895, 38.32802033787466134970, -12.64277213901117793653, 22.41394117041116896388, -5.41997765583189625210, 0.84561472586849728392, 2.78131041762476584830, -19.77972378828336885201, 9.92257380177671823116, -34.02373685369498318921, 14.09956560611124865755, -38.38355859153389046647, 14.54645423045432240369, -32.42000974391985227279, 11.52440592509755375123, -18.64675841383842376556, 6.10383963044636041360, -1.71615951325681048283, -0.09048804530686286929, 13.29766573247765606572, -5.47663966078562685391, 22.69043486894093378249, -8.65558720550828021220, 24.59854100839914441439, -9.29461741954699063543, 19.76207241422263734302, -7.53555971238036752204, 10.91963162558001106899, -4.12922022122160186323, 1.17166391431090125699, -0.62820298108622952987, -6.58105765274643908010, 2.17690827412800190355, -10.73947481717252176996, 3.73382400104668843323, -11.05847993978849755061, 3.99745312544854485089, -8.42618771709610199912, 3.16918999808273805981, -4.35735577640002713851, 1.83159199173642011968, -0.33296176213185274837, 0.52196477700522894533, 2.46839719035722130478, -0.57409280515250245790, 3.55057659927394027493, -1.10723083261312461912, 3.26052376410270894565, -1.18677953314262388921, 2.10608674505551815670, -0.89648880791648167538, 0.77789274226403740047, -0.44592579260193199916, -0.18979009086915468304, -0.06649043550820634651, -0.61702140402835907519, 0.17501165459925299317, -0.58246600503955561254, 0.22802300087227357411, -0.34047360699613934769, 0.15961996832863136015, -0.10316100963738978433, 0.05443280775934822332}
-
-// Iteration: 17 - 0.0000366777289357693454659 0.0000366777289357693571017
-var coeffs_27 = []float64{-6.65999396822899868875, 13.07198164692028205473, -11.46866279829937612734, 9.61980557683225970273, -6.62095641235578120697, 4.04482035295685005742, 0.18174221370196492319, -2.15780873772478894226, 6.60003619927758180466, -7.64935025192473431523, 10.73165138164951095914, -10.82226739371156910246, 11.84512072911421149794, -11.08097182550200237918, 9.52683964416208532125, -8.73793310838684112282, 4.73388861093307822097, -4.67444150956384863959, -0.67762290515484948911, 0.06613263511292832134, -5.17459027067470071217, 4.18996973827710721190, -7.63239517951884694585, 6.65594585384845310038, -7.55869410868654503263, 7.09095255373427305579, -5.55635074812909055641, 5.70734384162571081950, -2.50099674067202586924, 3.19034370982255294953, 0.60951300983571788038, 0.48224288576365481234, 2.81044993923471692839, -1.68488702405858182560, 3.76513018135831145657, -2.87080267911860739866, 3.54067847557969410249, -3.03172989381541650928, 2.36483772609763884621, -2.41462320114802372161, 0.90826367502142749558, -1.41518254608539968877, -0.40096847968183019900, -0.38525570261477054705, -1.19788552390338635503, 0.43378640383361490824, -1.32054686277363442291, 0.85142676745918063638, -1.00193124950623385740, 0.89496808619139037851, -0.51657412751557816199, 0.68789167209143333057, -0.03849098675995771260, 0.35470362609429633299, 0.21183833179616167846, 0.03998361294509490923, 0.24061368997240815146, -0.13802701560131848201, 0.17175376570304421539, -0.17088081986753989732, 0.06998683321381067768, -0.11646259791272621271, 0.00179697793840694648, -0.04535609147291379760}
-
-// Iteration: 15 - 0.0000904021099154608470734 0.0000904021099154608635487
-var coeffs_28 = []float64{18.94893670687577679823, 87.05732097318623499180, 32.74774888171078364226, 65.04546249978829195413, 18.75757740924857133334, 27.37496899953233828148, -0.00947743533974297424, -15.10411905768504899213, -17.98968079934702150292, -51.45851757402494028154, -30.18958498399887850320, -72.42755520962391644903, -33.35866454201764884056, -74.31140902892956956470, -27.22908654429228170778, -58.64560284593191306876, -14.46841007075940659585, -30.88651483180257029256, 0.72541098606854307667, 0.87449137365017922155, 13.64242076503446230097, 28.39830502335620616157, 20.81666398997815415447, 44.99934163645285190322, 21.32548187993145213862, 47.68522023939962103081, 16.15437519090668508548, 38.05062595301836149049, 7.84344164908713177495, 20.97344680282558015656, -0.67840968579312342697, 2.70214141007396168689, -7.10398574815397341745, -11.67061048649591068922, -10.20050763557486454792, -19.41558772217598623177, -9.78230674662893511086, -20.33981215059290637294, -6.95103774671318876975, -16.09705692475526795369, -2.94128949507092547026, -9.23909556813916712726, 0.62989312554502726364, -2.27012297652787450640, 2.88793482516375894175, 3.01499877140841868959, 3.52312238264655237705, 5.78294677388281081002, 2.83821671476260864429, 6.10331143387271301206, 1.52297672937159730643, 4.56327659443096465292, 0.31708285569549085826, 2.24940256163360943247, -0.43525916860049067643, 0.18638917021782801391, -0.62419417979035872573, -1.00442659008914034291, -0.46142407634648447457, -1.18976776707360980298, -0.22409360498227792240, -0.75781887039283198232, -0.05624982572690849034, -0.23600495748931783156}
-
-// Iteration: 39 - 0.0000319511734624274336728 0.0000319511734624274345510
-var coeffs_29 = []float64{-6.60546286357328213468, -28.18494182427023105869, -11.40937013946603132599, -21.05210450230214521899, -6.53502336998746677065, -8.91081129208381216865, -0.08810783937970239943, 4.81899297847482503254, 6.25166031073449322478, 16.67134448180744972989, 10.58965081905823864802, 23.50287140316067376964, 11.59962595189948983521, 24.06060729248675209212, 9.52173817842358906136, 18.96133668954545041594, 5.02732207019862226405, 10.03821517512225454677, -0.27966885781144698940, -0.24857936720609206662, -4.70490385386534117103, -9.12788776445640226565, -7.23100903315752219983, -14.48721765529495045226, -7.41679998990208570466, -15.44664505749461564324, -5.61636561926411045536, -12.37201161267024189439, -2.78964300329936718995, -6.84384696363617792439, 0.23543106122059111199, -0.92676043968996811590, 2.44317768139646228778, 3.72854442488293637227, 3.53828111059966840477, 6.26515340188653777898, 3.41907309218722120534, 6.59471251212082299548, 2.41942098885402705971, 5.22648253597115250486, 1.03771088644974671958, 3.01481601403305777706, -0.19731201618558740529, 0.77222405865054771324, -1.01460553188598304413, -0.95745744130918726005, -1.20328497668212028173, -1.86833734153410220635, -0.99557345907272411217, -1.95954639014319539719, -0.52467545138579576088, -1.48513542450656625885, -0.10588980309564517436, -0.74797661867298177662, 0.13058933398318155858, -0.07282767822465451628, 0.21146591629969810265, 0.31456906639885543164, 0.16107087935411622963, 0.38643624485857725075, 0.07173285691768223953, 0.25036529032018515145, 0.03042594661084659258, 0.08132163877524565946}
-
-// Iteration: 17 - 0.0000469745322730434624684 0.0000469745322730434632322
-var coeffs_30 = []float64{-20.10107432142279262895, 2.29513809170659187861, -34.86875856198275536070, 1.60719892310292209099, -20.66689136470901122551, 0.66707027427191444384, -1.35447593257483572150, -0.37104296327860497436, 17.32138609768184295765, -1.27459174814819955862, 30.44646954394392403418, -1.79801304642010990349, 34.65826265136505077351, -1.92726853753129433477, 29.75787554519869388199, -1.50228009920352540467, 17.85018832356435558318, -0.80069464983487014080, 2.88667620854373818136, -0.03316360595026626586, -10.77029314345281849742, 0.70134980206124775220, -19.65827821359327948131, 1.11582699972449583915, -22.15783855559458483538, 1.18829287389602914453, -18.45555627168134320082, 0.99817549727924183757, -10.86744159007284785520, 0.55964726074902681931, -2.14474774578198545553, 0.11259809618283658845, 5.04198848137287110283, -0.25461765470057471693, 9.13660386164614332770, -0.47474999021654407479, 9.85386228878490457359, -0.51741349786181683950, 7.85222475804530523126, -0.41723560480534165778, 4.42741568844757329925, -0.26031986065160048280, 0.87185269117488104557, -0.08214090838954904304, -1.72759505247868801913, 0.07126304123817760675, -2.94072354797954613808, 0.12661755371332133086, -2.93224936228868349396, 0.15566103390957019141, -2.04474353037378410341, 0.12323852514409645999, -0.93072224570066016762, 0.05731929750360543040, -0.00568787870050185403, 0.01727528372854582892, 0.46921551686932474988, -0.01696517392686905945, 0.53888342955637120606, -0.02841102465686743808, 0.35617164767999160248, -0.01870753389401799953, 0.12915813404541953169, -0.01330560974157134875}
-
-// Iteration: 17 - 0.0000340330002578349222816 0.0000340330002578349222854
-var coeffs_31 = []float64{8.09878680725936141115, 31.29479700463576553531, 13.90861955905844765545, 23.40960138384322495677, 7.96643358798266905923, 9.92576588733761270762, 0.13138350746653254997, -5.34162555375543534432, -7.46220968487787803699, -18.47609172991882725065, -12.68189284702894588877, -26.07821247609993413704, -14.04306823362618363960, -26.81277893681839505788, -11.65413872735734925630, -21.13151867122233907922, -6.34011770557454133556, -11.17834310491168885245, 0.00346625628993675044, 0.23790017817735791580, 5.39647604186885265601, 10.17096502307084954978, 8.59099290501900252944, 16.13525955996929383439, 8.97935888004600833178, 17.17305598781613477021, 6.96968357880630628046, 13.74445729919455635533, 3.56871341218539239869, 7.62540373613609010011, -0.00550631505002471041, 1.04688009144820767783, -2.77290066818786987264, -4.14200530229261701916, -4.15784025872059542805, -6.96509783534690086926, -4.08823396825876939548, -7.32770585229028721145, -2.99239072897138898996, -5.81885129859425225565, -1.38111684278752712626, -3.36594299097415224896, 0.12269210111162104355, -0.85785144336788941693, 1.09979979681965457072, 1.06328497215509389885, 1.40886123482266596537, 2.07297633903218989331, 1.18964888081194383335, 2.18601666497469417961, 0.69003851446990786060, 1.65532434705965194284, 0.18103832532406991357, 0.81748637893794345199, -0.12595149716010167130, 0.08741971892974099129, -0.24605130102137261656, -0.34468723899683247687, -0.20042596264649199129, -0.42983389068063512485, -0.09914785676060085579, -0.27749164220591855699, -0.03772461431298982939, -0.09270382524230285835}
-
-// Iteration: 46 - 0.0000184048840061938406665 0.0000184048840061938433581
-var coeffs_32 = []float64{-7.27268758443622336896, -12.12627612578810662679, -12.39745183734407101473, -9.11561870128765126395, -7.33052625273546739412, -3.88946723582712256961, -0.40001374069565258161, 2.08048292098873005170, 6.28102197580515087816, 7.17628445933483535617, 10.93506194429043381347, 10.10973871011532702004, 12.42423168061560050158, 10.42313432825574850075, 10.57966434983830285990, 8.21056158924156907081, 6.27286586321386895897, 4.36673923238720496458, 0.93268090067822573162, -0.11054521915655390008, -4.04683788205970401335, -3.95941127835372885470, -7.15576533282378084782, -6.30000580106711728786, -7.94616556696396666223, -6.66733274156314385367, -6.55076700414302807067, -5.32301172201989255123, -3.75144726829789441351, -2.95600262809091212978, -0.63642629095118365811, -0.39206328694138359682, 1.91973865989235968251, 1.61612153225013708367, 3.35014382276897992310, 2.71530575985970442945, 3.54231123424982790023, 2.84371340391403576572, 2.78430613227743776501, 2.25282474793189025279, 1.51314702269570000597, 1.30468043873415941875, 0.24642340761471078516, 0.32198017122192135006, -0.67331970869930502969, -0.41337986159164872440, -1.10411583568313130927, -0.80982529204992487193, -1.04416657917900183766, -0.85350579741554544004, -0.71503429294021026807, -0.63719933830124325226, -0.31579999456650931083, -0.31677844963169302080, 0.03244332835775175738, -0.02547267207940801928, 0.17997287448761393727, 0.13219307239476017684, 0.19793196439248185462, 0.17066453450707983055, 0.11928761516649337566, 0.10286328405506451496, 0.03748036505819624574, 0.03577148097290817604}
-
-// Iteration: 10 - 0.0000698985665690614495251 0.0000698985665690614495251
-var coeffs_33 = []float64{-27.93447965522185392532, -25.49460708263164969391, -48.65527002502476718314, -18.95028042270159139440, -28.62677409834715676313, -7.97901973039697480318, -1.65421999252778371718, 4.35838994900942320412, 24.30219073287317641880, 15.02262593964652922651, 42.51846538151543675945, 21.10298402756238258042, 48.25819026010600838268, 21.65924653800548179583, 41.32976508311535081407, 17.06969962198155973507, 24.66842032374615628727, 9.04684118567423040013, 3.61792482939575130052, -0.10931860127372429451, -15.36145432791475483466, -8.22316283328743077992, -27.61111363609913894819, -12.99617723148907503232, -30.90523219957247221560, -13.87174953236265085628, -25.56733339578768485906, -11.13498264299717032043, -14.83019129618206713057, -6.22408913424692311037, -2.70939430311999421373, -0.89596769295680683708, 7.26248679842044748043, 3.30229745713584839769, 12.91931632862209486744, 5.62977678142932204113, 13.71133377984993702302, 5.92526096871406188948, 10.93094411263076750752, 4.70011040956103723700, 6.00608057498679345960, 2.75084103387684368284, 1.05201280735386477015, 0.72237319290020926006, -2.52833617118616172791, -0.84284086967061023873, -4.17171137277138967296, -1.66327755300690435317, -4.09661152040259898390, -1.75349629130282376540, -2.80701339103169219550, -1.35582147987590860975, -1.23140571027512923134, -0.66404696426759488024, 0.03453244602642224716, -0.08948193277670682053, 0.69055516925308580224, 0.28079762791415893032, 0.73913492866009179046, 0.33998938382744769503, 0.48090179670016596540, 0.22544140946178332445, 0.17204873577566378783, 0.08201621354422639094}
-
-// Iteration: 55 - 0.0000637886569367633417696 0.0000637886569367633417696
-var coeffs_34 = []float64{-8.88446887322625967923, 0.13688217282637157448, -14.74462193580752771021, 0.13865591428609336075, -8.25687121442278685942, -0.12644208951297611263, 0.49065580787182914054, -0.26927140669229290140, 8.97502227196598044033, -0.15284502885197909390, 14.60680832465620312857, -0.09147895369887569505, 15.75975828130237574932, 0.04515215478285461805, 12.18135059553092090732, 0.11726582989391604808, 5.39330020885019563970, 0.09882230296932924197, -1.97410870345556062769, 0.10783463963229783863, -7.92844237721505137349, 0.18739461866668613541, -10.66115185330962745379, 0.14545258004745266628, -9.98553807264693286756, 0.03914174187510896784, -6.86154577936342823918, -0.11858953535315469612, -2.55934825557766565221, -0.10638176071991751012, 1.70205198984169255485, -0.15387207686288726089, 4.37198733484132349909, -0.15560274628537120477, 5.42501694019510678255, -0.08735319507835977547, 4.78904991165638015772, 0.01366612505994799268, 2.96702637193755313945, 0.05247545936633595646, 0.77758699020979412716, 0.06926368742041172256, -0.93270388784527872518, 0.08938770237400878452, -1.98774722930395160780, 0.05142663820752595086, -1.97069609208445266304, 0.03514337707479714915, -1.33308026564597985273, -0.00220066890935048000, -0.51150477780386988657, 0.00766649368289549260, 0.11076936934835084294, -0.03016939975780039028, 0.40833237706378212713, -0.04239961119553846521, 0.35607806144163314553, -0.03142658708879981498, 0.20413942590339803854, -0.00724636936001186891, 0.04863472165102923803, 0.01154246027008087423, -0.00004838290223869912, 0.01860464593120089372}
-
-// Iteration: 16 - 0.0000347156704579080751736 0.0000347156704579080751745
-var coeffs_35 = []float64{12.01880729973367625723, -21.13703172315526141218, 20.51330105177635716340, -15.83586194258335446789, 11.98430619204973500851, -6.67227478890553263253, 0.58984662558962394469, 3.75197070447817625857, -10.39917481700803121329, 12.57245926146282664513, -18.16160103723894193723, 17.62305968589861131763, -20.58490418306869616777, 18.02125687282968066833, -17.49434596784322352896, 14.14439172056033405244, -10.20282322399325971648, 7.47381912338385979223, -1.19218211934791288649, -0.18882070587789210456, 6.89262636934407342151, -6.91803296731042832844, 11.92730726074032678370, -10.94900361955959384722, 13.13158552329697462498, -11.54838746650660399936, 10.73966783681623485596, -9.21817627816200945657, 6.06786360193348608840, -5.10498668788270247946, 0.84970718010250719963, -0.63645282283280578113, -3.30217073221274926692, 2.84569656146832041390, -5.61377944377909206273, 4.74763819609547110939, -5.87895421747598480053, 4.92727107057005361386, -4.59399170966456182945, 3.87701167890225957353, -2.42767679043103748092, 2.25092821104172561900, -0.31497854679912980585, 0.53625848443772172039, 1.20615468464906808100, -0.73863151231036671674, 1.84930291556981517028, -1.40079412728163147901, 1.73795964025172285201, -1.47932158275501693280, 1.14626353193461611287, -1.11291505718169557457, 0.46749847987926754277, -0.54174998446771322962, -0.06290266298668401213, -0.04258185867798929998, -0.29739336171463363724, 0.24506777163482010422, -0.30635703618426723895, 0.29679574407052313470, -0.19269785331094843086, 0.18163816590031257332, -0.07201260127878899795, 0.05318645771590114673}
-
-// Iteration: 20 - 0.0000269909372738437092510 0.0000269909372738437094339
-var coeffs_36 = []float64{-5.76028469783136499094, -7.91709107490842376469, -9.16591358657748305180, -5.97192848923381608052, -5.22938880398579364156, -2.55490028370623281502, 0.10631302207446376488, 1.34188733009348627820, 5.24421587130666981186, 4.69378905302966239291, 8.68335927931634656726, 6.65039000033066256714, 9.54665152660782110866, 6.86078302337436659631, 7.73798733911972144167, 5.41159146982212862772, 4.02615847851431279909, 2.85633636987384008852, -0.36596040309553006558, -0.06636630511056610180, -4.02769185732745574987, -2.62289613053842318497, -6.09337967742543181914, -4.15772345077009096237, -6.13957848108140738817, -4.39510018820085538747, -4.56304589721130690784, -3.47771262611219947920, -2.11367165393175461528, -1.93876274458306160203, 0.31373691459959139411, -0.25592781420243680458, 2.16301807915603520620, 1.08765589324004369712, 3.00246660761245378857, 1.78503188585335113723, 2.80446385800640332375, 1.86245674787972395126, 1.96242966679735627462, 1.48826292296103518992, 0.79691173047616278733, 0.85287085721692328006, -0.25184573266528662549, 0.20494345737289248644, -0.89039059082584800596, -0.27784002476595099650, -1.04592635754468054828, -0.53740020325865780571, -0.81347067621611795686, -0.56007614256564095139, -0.43058407896214887206, -0.42085262836055240659, -0.06455169699762197557, -0.20180669221026112080, 0.14196346963989013855, -0.01741891217222120153, 0.20346619410124515504, 0.09130952739997481849, 0.13807851378299491230, 0.10995393756345985617, 0.05621340104770539242, 0.06880639506971266424, 0.00630055545733219161, 0.02198113461812703341}
-
-// Iteration: 34 - 0.0000399610205142353385118 0.0000399610205142353385147
-var coeffs_37 = []float64{-7.97408038426313897624, -23.68916756028729341085, -14.03714711434354848864, -17.81207437143934471102, -8.08011581946463006378, -7.50686747847224233869, 0.00675631136950114951, 4.13735074533118832002, 7.75350869783686781730, 14.04833518570435465995, 12.95402301389662670470, 19.82549490863783825185, 14.31088394297522514704, 20.31213879599229217971, 11.63659319383307700750, 16.00188917256590351056, 6.09139810233753499069, 8.41674698046896819492, -0.41576442967822904810, -0.24130755294965579351, -5.95236565095143329010, -7.74252766570215795942, -9.00012007016049323154, -12.27808298935131339669, -9.13057910538993001475, -13.03614492406603894647, -6.90722282255817131964, -10.40960627087744396770, -3.27470775497681941083, -5.72884079472898704248, 0.40151652857016399097, -0.74692286050995927623, 3.13552117017569264942, 3.20332272664867219880, 4.41738801368693985348, 5.30100400214649722263, 4.21840332492073603351, 5.55988633768189169308, 2.95863558614691731951, 4.40587220365431896665, 1.21725691055038330526, 2.51576882329656026249, -0.32051351376738985295, 0.62516891923815353797, -1.28470906968747271979, -0.82716061563357389666, -1.54463479474522831368, -1.57898320859141215368, -1.20787470733393796991, -1.66922464883454326268, -0.65121902612075790465, -1.24781380559009940567, -0.11682124445809714935, -0.61312425311913342802, 0.20288919541458542557, -0.05362017990124185366, 0.27609988341221565390, 0.26920880454942520113, 0.20360582486747881606, 0.33048513051804015093, 0.09205351953402195405, 0.21391808012240308631, 0.01815922439182416994, 0.05922327849793340854}
-
-// Iteration:  5 - 0.0000327015640289492444882 0.0000327015640289492444940
-var coeffs_38 = []float64{7.64196725507741228440, -19.13315495946128505646, 14.22140309343575136320, -14.43979488720840396741, 8.14907056832090530632, -6.16741795024634049957, 0.17386478874417817653, 3.33804849712091340703, -7.33548138322118956318, 11.46065978289297243552, -12.57071063205157556413, 16.09201626961938605809, -14.13231327139744323890, 16.43173305946398100654, -11.74532640951359629746, 12.97314615657858791949, -6.45274332398997398652, 6.84999792183236307183, -0.12861859698440204328, -0.22831725524195139700, 5.31125887876664550397, -6.39997364819523605272, 8.48748876697333833967, -10.05079396642393436646, 8.98605142653835431262, -10.57825410480009528567, 7.06768429121790276689, -8.38392432638903497545, 3.68628946068207524003, -4.58675059874766421999, 0.06570512437596258631, -0.54324202545322482898, -2.68914414909019463826, 2.66636544752201021764, -4.09182184772260189077, 4.34604284221006612310, -4.09756634614164754143, 4.50420753204309153204, -3.03228989766912465384, 3.53768207812219067623, -1.43109342531141228978, 2.00901713679817592301, 0.07035311642870485932, 0.47834184060201285144, 1.05982164365559552584, -0.70395341218372266825, 1.38588996664500638284, -1.30509061023640015310, 1.19014166584685749902, -1.35351347005575797849, 0.70613714979592848124, -1.00495150171519571149, 0.21262863815792908507, -0.48465688912616761410, -0.12509049778068644589, -0.02387997157731911588, -0.22855307534131301272, 0.24008550234051159208, -0.19453579326885391338, 0.26729077864089306660, -0.11497609906039757415, 0.16146332014827380785, -0.03924524768216400881, 0.04319830112630420249}
-
-// Iteration: 26 - 0.0000892147613792689180391 0.0000892147613792689180412
-var coeffs_39 = []float64{16.48092443824518731874, 63.76148249074590005296, 28.43495817756082797952, 47.66550229881809738903, 16.15330211854308453332, 20.12704784095001334181, -0.21006533472153661499, -10.97596219047116253783, -16.00727310745411184414, -37.67261569161556324237, -26.59512910192057827120, -53.06536406213364149446, -29.13664120904876234227, -54.49543176652411521197, -23.63823363609792389795, -42.99255874903717336342, -11.97799542872538659254, -22.67359621897192742346, 1.45769006958103055361, 0.54027948124908591552, 12.56916660161061738356, 20.68310010707643921011, 18.62374166647515551477, 32.81329513817764693707, 18.62375320883090491809, 34.96781016676155672456, 13.80555085772670800139, 27.95162899690847016185, 6.32196989157290096654, 15.47278706333477871900, -1.20242954941685746767, 2.13614074324166225060, -6.70850646608234306770, -8.48764475293095332913, -9.19970025143587243692, -14.15146414907386726088, -8.63598046778368253404, -14.91027500629774884632, -5.94479896371053823912, -11.86238960680991610019, -2.32132037702771817195, -6.79837894991225607468, 0.86937658432038974138, -1.74554606304293536232, 2.81158553221869124060, 2.16512648306353486049, 3.21540491314465421807, 4.21979703927732022976, 2.47489288046685308757, 4.45366034408532168859, 1.25995232349682099983, 3.36429187957359870557, 0.13092677381372583007, 1.66393061104199979164, -0.45532171194271880482, 0.17683307628628685295, -0.57304531927769620639, -0.71207427125277833404, -0.40358869613969265608, -0.87167174798243435732, -0.15752762541162257841, -0.56697191801235630851, -0.04628150617188983043, -0.18488750652288358377}
-
-// Iteration: 15 - 0.0000174714707836584868917 0.0000174714707836584869034
-var coeffs_40 = []float64{3.40809724823841407445, 4.79130229586003298198, 7.02448113590966523806, 3.43083987266524978425, 3.91115842328553108786, 1.29285852572330873380, 0.00014613298469372684, -0.90682880587524426345, -3.63026061331311876323, -2.71722975815205687276, -6.09147175944946405127, -3.76975153900953572253, -6.75571362756332064682, -3.89942743075859218097, -5.60175954529221089402, -3.08525229199435937554, -3.01868709935564821800, -1.62528347929128845197, 0.09202019926715752799, 0.05456125941094984468, 2.71060714101545330225, 1.48503851930459684088, 4.21870583643998220314, 2.34387451060007556436, 4.34851705898597010523, 2.50467063585305620854, 3.32556033815321601619, 1.99472533902039264248, 1.63833236975635284969, 1.11206873478210474106, -0.10045220639450363512, 0.16575292390267455077, -1.41321280340146549700, -0.59787710094872348743, -2.05181957859938184308, -1.00634390265297283876, -1.99082136552047464671, -1.07098469240359636433, -1.43093297132548712850, -0.85545649171379141367, -0.62182814728454194429, -0.48378808809699100476, 0.11155725924947328526, -0.12928757617694201165, 0.57062419114206909182, 0.14247254997092645206, 0.70025119889807782321, 0.29824300016628085838, 0.57885393937500378671, 0.32100953294434445493, 0.32841922962910573304, 0.24594130915132231486, 0.07226179700852117476, 0.11771212827792008985, -0.08218960993246482668, 0.01105863913671849777, -0.13244901607844627178, -0.04571188115531066814, -0.09515669551487663415, -0.05919687478375885421, -0.04267564323280260039, -0.04089355677894638448, -0.01383264855126308317, -0.01630112697784466721}
-
-// Iteration: 28 - 0.0000582810937833161130504 0.0000582810937833161130574
-var coeffs_41 = []float64{3.49560849240391070974, 21.69999329222454906541, 6.03285386110557910151, 16.08159544609775699037, 2.96602648445664700055, 6.47118404419506122394, -0.81740093843323788427, -4.14714513437356913640, -4.39669695176912197118, -13.02059061597750343608, -6.66417490984223081695, -18.22638722124177998577, -6.82840673495803475932, -18.41385687682413401006, -4.69919609027577728207, -14.12859916527097057217, -0.98135714332683408203, -6.92721366235514440180, 2.51026369527835913115, 1.02727999135371528372, 4.95046016864207486345, 7.59759648551752828537, 5.51296090460056283015, 11.39881706655788618372, 4.42595340262555566053, 11.62549228172792964384, 2.26426848228125841753, 8.96568368766140931352, -0.04474646593303224293, 4.52188163166656394110, -1.94068919961655832917, 0.05086509223303471480, -2.98487722699901872674, -3.31621294503862444610, -3.04189319048437561700, -4.95353334716632469223, -2.30781429577026421490, -4.94032702207752141129, -1.07283721059283424497, -3.70970012967535448477, 0.14513595566528415570, -1.91697330210423403170, 1.07604775463242600535, -0.21462050087083414931, 1.42973616994085565701, 0.98391152594122845040, 1.18565237975558081134, 1.47428999967437145734, 0.62577252471488080539, 1.44202225520328502542, 0.06643090657632058324, 0.96554460210607131930, -0.27169149832879837064, 0.40685580677336864998, -0.35702010477294036056, -0.06118766724923890248, -0.20409786884070991920, -0.25659936459862703156, -0.07852538942639743117, -0.26348318153485950746, 0.01467445814019358100, -0.15560740907909486631, 0.00643628745689362374, -0.03110416349594448312}
-
-// Iteration: 18 - 0.0000474247265570929695884 0.0000474247265570930061974
-var coeffs_42 = []float64{4.90418869647322282969, -46.99251935783279002494, 9.40436536556346917449, -35.39721371117169081219, 4.96685517400897097583, -15.00131449683589077807, -0.47672326868091459113, 8.24625434978162444600, -5.65645313184355325847, 28.03008534608384713607, -9.11204494924830941486, 39.40279250960963908971, -9.69987535961660278165, 40.27075632795469807078, -7.47492870896129045870, 31.78096843238526266591, -2.98208817944891096075, 16.70704898294559679652, 1.84955943952351004213, -0.56061987112392751963, 5.34205042363544389409, -15.61235148364943957160, 6.81097912915465929779, -24.58373249874477353337, 6.18566390420686724010, -25.90508165373325725496, 3.99321619740324622631, -20.56451015106562897671, 1.20392324594180683742, -11.25375173397855150940, -1.43224936186381878941, -1.33051011598648447777, -3.02686784209312075515, 6.49877249105079629806, -3.51784298909726148890, 10.62993876929976233664, -2.99234576276376890381, 11.03801896536057513285, -1.76004066566977419732, 8.68125802050673738770, -0.32174895902502995809, 4.93817080141587440576, 0.82545328164925900889, 1.16566942814903692875, 1.40045422931501363223, -1.70835637642979433452, 1.28476476217610878702, -3.18023347553367562512, 0.83900729330577674732, -3.32488589538975081792, 0.24475772108136358684, -2.46525201439300192209, -0.17642703172220859042, -1.18927321026176328373, -0.30152693652882612550, -0.06828344584320570541, -0.23501016643899173637, 0.57968911451381523031, -0.10178071864029067704, 0.65415278677067050226, -0.00523779434796275888, 0.40501544397341641507, -0.00735967717463992726, 0.10584027717227330506}
-
-// Iteration: 13 - 0.0001336172451329713278499 0.0001336172451329713288876
-var coeffs_43 = []float64{-34.72245132364031114901, -32.71443329068253638284, -59.81896541099000491105, -24.63309132634581012961, -34.63125164868881468475, -10.39510764862234123334, -0.73539117438324325521, 5.68901277944097362673, 31.84833958119647104753, 19.33645295648442994981, 54.10855197476912874823, 27.24742459995035358125, 60.39775213327505066604, 28.12391317292223041375, 50.29631212749833775559, 22.17664268574869680325, 27.85034371626232761013, 11.65257404663501422227, 0.84284740952705172037, -0.23689462396949921666, -22.55767018971114058759, -10.68070401271513205232, -36.67784419969875934138, -16.94393394647125202350, -38.68464458752372309376, -18.02603748282603619461, -30.31280126544561425287, -14.31258843877535930920, -15.82959300821664800815, -7.99684414847265333731, -0.45986382454522992122, -1.10658292229075911843, 11.55275246718466268941, 4.41057376572199309764, 17.62204801696120354939, 7.28964839968315796530, 17.56076043109233017546, 7.65128194494237248328, 12.94171680712890656609, 6.12402909230100628213, 6.20750112974200622611, 3.50185059593629724015, -0.24706008674887369378, 0.86987687763739374067, -4.51106376366839478022, -1.10264487558599366647, -5.95658583406660514290, -2.17984824860894402078, -5.11708087777432461983, -2.29835378513267876457, -3.09938716963034094680, -1.74191956431497652948, -0.92113268533872616713, -0.82785141373233252903, 0.50064587357949024314, -0.09726180909420957592, 1.06377382722483503148, 0.36478173682116727525, 0.90033058195603334138, 0.45174138400954059141, 0.46562965763118138035, 0.29066579488421589256, 0.13299035728687077689, 0.09336357952167091860}
-
-// Iteration: 13 - 0.0000316042583683441326704 0.0000316042583683441326704
-var coeffs_44 = []float64{4.48024213673374579596, 1.29566118184403852195, 8.80963427003960850953, 0.79960401796172826131, 4.84458593610223444566, 0.21501658824373419709, -0.19969854955825392228, -0.20037112584641632856, -4.89920496069796376017, -0.57194680437600645195, -8.05022229051102178322, -0.85660518021241758995, -8.81942491066267982967, -0.99342753558479184850, -7.04543572422344616632, -0.81513555716584377081, -3.38353675169837735315, -0.42222617511705339211, 0.82091564908992299952, -0.02652226400482814473, 4.14992071702242558444, 0.28599301407119650487, 5.82493605712700092572, 0.50476800569965953783, 5.65532766600874216481, 0.61143106228831890668, 4.02262879011333287050, 0.51321383884270958556, 1.63354314134311397361, 0.33050225256504862903, -0.67279948037931706297, 0.11052887231736358999, -2.27668302277059184206, -0.07785817478868213758, -2.92443838650385631815, -0.19879407748499360809, -2.64475898390810599286, -0.26269835057787432333, -1.74756448538448355873, -0.24501392417100303030, -0.56895728138713931194, -0.14948309505213837082, 0.42992215846177736729, -0.06200069333267805363, 0.98530302257587580478, -0.00120289965509101211, 1.04342903113303087120, 0.05805916616422328946, 0.75705380912687562496, 0.07655214823739278025, 0.33851783551297728262, 0.06694672758951316544, -0.01896019911486763385, 0.03477299949139007631, -0.19119591071824930931, 0.02043404356707213182, -0.19766944387627618495, 0.00691843433595305926, -0.11161621239022379420, -0.00770845911358224622, -0.03672093454026409007, -0.01718149689662373286, -0.00585686632391465139, -0.01340312983931819542}
-
-// Iteration:  6 - 0.0000526751081266204348220 0.0000526751081266204348283
-var coeffs_45 = []float64{19.31585413521059851446, -24.09170149720512620414, 34.03073862938932719672, -18.10609181445921303433, 19.87578425437408760682, -7.76872454174039470257, 0.98842816028183009798, 4.08886724981517616452, -17.03946695602657621714, 14.37127121014206516456, -29.69282091624089235554, 20.23259158620676304040, -33.68784904024660013647, 20.64003748465494105956, -28.73127270021745371934, 16.31974585978756422114, -16.94161462792787262601, 8.62846516463647091151, -2.22452540210004963492, -0.19736844435992552661, 10.96065091267788987503, -7.82028485610082010504, 19.46653484439149919752, -12.48831965327048614200, 21.53714130168544427700, -13.23967121355591776474, 17.70061615133086235325, -10.65164340362889822047, 10.11209506300246689320, -5.89825074178819661841, 1.66743060555749579349, -0.79508092438400172577, -5.27259194159590611824, 3.19090371815601378438, -9.12532306863420152100, 5.40472890089799643698, -9.60072880540785288944, 5.66800662946114684311, -7.55368055156045263625, 4.47363900104125540238, -4.09483395938468348590, 2.60647834045451406063, -0.60413098009533415152, 0.66050064300502801183, 1.87608730652429964786, -0.82171391539435472301, 2.97335446034320714343, -1.59987527837673183460, 2.83957017284211954271, -1.70027864107617733086, 1.94021368694597161381, -1.25765982748395974436, 0.80082632485600547551, -0.65088664896108054701, -0.06173737113974833903, -0.06681165567492615355, -0.49793235570050796104, 0.27459912320242513278, -0.50729765594800275432, 0.33122291484737365593, -0.32625970478300222221, 0.21942899010921607602, -0.11466790751209508116, 0.06645961104226041192}
-
-// Iteration: 29 - 0.0000216269792470133825069 0.0000216269792470133887311
-var coeffs_46 = []float64{5.63495110922221600586, 12.51702082630239906816, 10.80964767022478961150, 9.23846576192277179634, 6.20131804675093167921, 3.76096460288337296546, 0.18661144345458995090, -2.25590974898324508286, -5.50609200837327990465, -7.32703618622258957097, -9.38523211350987472059, -10.24184472974679021011, -10.51942920556284058169, -10.52574814808490950358, -8.86678137838784023952, -8.31409476046165571273, -4.99805232372990797139, -4.38405083156978188008, -0.32079481634588638618, 0.12765530188207433845, 3.83697394073716458505, 4.03313452513118915590, 6.35113819261976890135, 6.36251108496448908799, 6.75045004081779827841, 6.76044491750558770231, 5.36712369750148321409, 5.38939769982132250455, 2.86139949216687233165, 2.98118459986295918382, 0.17032240359620302020, 0.40687265471756993681, -1.92227773286338796992, -1.64549284230605555013, -3.02697047558133599710, -2.74445995057797758333, -3.06280141000860236111, -2.88706163973631957608, -2.29028563904008004346, -2.28767138565449864778, -1.11588106478308273740, -1.30501324625690098557, -0.01548713716916212214, -0.33373791640459594174, 0.74169904976163208819, 0.41496760686662965938, 1.01620452063223968176, 0.81629141319054267956, 0.89071613643986963979, 0.86513225732889307989, 0.56397384436673686089, 0.65362657397935970429, 0.18328422670394608617, 0.31610957967012228707, -0.07962773854175557902, 0.02854775537586097364, -0.18066353570126150522, -0.13699921600897740112, -0.16126201622820628064, -0.16630783624375668806, -0.07966246977391149142, -0.10828123514189555435, -0.02694677137507305446, -0.03633074300519423601}
-
-// Iteration: 17 - 0.0000416504779014162074762 0.0000416504779014162074788
-var coeffs_47 = []float64{10.25452685914777270402, -25.07608906903916418007, 18.52449141201403455218, -18.87904616945583693366, 10.61188688722855783452, -8.05563416590158772090, 0.26874516692037328759, 4.43660890861962400470, -9.54744134635777925078, 15.03141448846894208188, -16.44739229875399415717, 21.00289668573479298049, -18.51736459265269460671, 21.45787980422459312685, -15.43753953485745582150, 16.91037945707057653805, -8.51669022693755113772, 8.94979835123268660936, -0.26207003592833269966, -0.30701882272958021673, 6.84769147294772434738, -8.35891073076800069524, 11.05575484954170864379, -13.13373814085346624988, 11.76615382601232892714, -13.80988870172870792687, 9.29691246244116918334, -10.94490063067197012170, 4.90547539783028786125, -5.99939711825126841173, 0.18351197787076247964, -0.69800344465449744268, -3.45018005297625516829, 3.47472672181297999780, -5.31540414066101333206, 5.68814622857723855958, -5.34575002016023462360, 5.88336344884499849619, -3.99142344519511600291, 4.60961350486834583644, -1.92315028865945809232, 2.62777265484399023872, 0.04440250488897255525, 0.61724759836240720032, 1.35023186674599857464, -0.91217548164979415952, 1.79290425773567233688, -1.70372305782270401927, 1.55567732654325207809, -1.76780814323895565144, 0.93621615066582929291, -1.31450649572345014740, 0.29295024393849238510, -0.63487155615533637280, -0.14584724218617912758, -0.02820478010172474136, -0.28961333488335433967, 0.31227673553901478696, -0.25460577145076197308, 0.35389943628652868118, -0.15332111122909658815, 0.20444086444897452183, -0.05707973506156153953, 0.05875289481035133009}
-
-// Iteration: 47 - 0.0000257558811817795081893 0.0000257558811817795101775
-var coeffs_48 = []float64{-6.84251672125436849115, -2.57455193632408965776, -10.95760176505402631634, -2.13132466306964961947, -6.43130195879410979975, -1.01974036766657052282, -0.12579299390493846177, 0.45917312797121397290, 6.05833267492790143050, 1.68153208169920507177, 10.20162067717188154780, 2.31892804057731803812, 11.29483471159560937567, 2.36709803974483220586, 9.37218645878434934912, 1.84947385421690405423, 5.12946141162121343536, 0.94668609493838093861, 0.09147777749020201216, -0.00777783976865539618, -4.30499833533396005049, -0.91463309471863253857, -6.88396106999382612905, -1.46884310418057377967, -7.27289303936827900419, -1.50262054273218816946, -5.62320960867428454082, -1.21565466636605039716, -2.92089043853975381341, -0.65850075195206290643, -0.02868906144979945681, -0.05933797651695719650, 2.21128291183166019249, 0.39232461798994561274, 3.34403746516784442808, 0.64233647118532625734, 3.28475785881475875430, 0.64420796628598011753, 2.41307215940925638293, 0.49525774871709295031, 1.13271294521081513375, 0.29491631852177051616, -0.07472705689971219631, 0.05525383891035902929, -0.87322015743098694772, -0.11136833578252731111, -1.12269068590872090318, -0.17913850762011726012, -0.97064145009254330949, -0.20442558879709583438, -0.55922114452444958421, -0.14000561378026012718, -0.17002249535830061086, -0.06613462476922820540, 0.10476832693633409171, -0.00844283819095925355, 0.19904300441970511101, 0.04110483697577551386, 0.17813966839623214094, 0.04394668365228868917, 0.08132859175664629708, 0.02109970046137081740, 0.02196991492883171434, 0.00322843038785439078}
-
-// Iteration: 15 - 0.0000486040143654457831390 0.0000486040143654457854328
-var coeffs_49 = []float64{12.35560225061887199669, 4.56652115940796529818, 22.36805448432104388558, 3.28653780194314539527, 12.86973993808489186654, 1.25262618211684287101, 0.32429071387521399186, -0.79637983829289852745, -11.62900452952149144108, -2.54243783827186078140, -19.87166023879062691934, -3.62413779681586983801, -22.25235730167123259147, -3.77617765942217803032, -18.57612411338031494391, -2.98261845933144275265, -10.34863819117468741640, -1.56777641021788827606, -0.40704918528302258948, -0.00510058705303338500, 8.26119240136279116236, 1.35176104825990180447, 13.40221191028257057106, 2.18215256327853258263, 14.22413730012696505836, 2.39108419691470489205, 11.22911609625331489311, 1.97514646876372985502, 5.91014731584558880463, 1.11598612390859967115, 0.22211926552961814644, 0.21474295222621077735, -4.16927512296110082018, -0.51188191512896389543, -6.43712889398086837694, -0.93654685697814289188, -6.46174585149121164905, -1.03495380036383722294, -4.80733235970303440877, -0.84059305556009976078, -2.30233247924019946467, -0.49787632237769341484, 0.04660372854008614101, -0.15706722889270029314, 1.62086738469198999313, 0.12084894744902711477, 2.17438884329058269162, 0.26708542888233335079, 1.88473401143965388450, 0.30657599865874462191, 1.14346620557022857711, 0.24085693114121815115, 0.36230891060468679394, 0.12233963236480205470, -0.17950402586021363790, 0.02978514674821999546, -0.37629357695230017179, -0.03037469290449117490, -0.32497919894336236699, -0.05494485798141004400, -0.17617396596618158791, -0.04907006764159958746, -0.05712899953261551844, -0.01978878482986856084}
-
-// Iteration: 15 - 0.0000197464664147997168857 0.0000197464664147997169757
-var coeffs_50 = []float64{3.06428692602054359304, -4.06730897704063022001, 6.15784611774672329312, -3.10158585871943102985, 3.43885359013419419136, -1.42123171610838552532, -0.09921785223691301102, 0.63986638333023086736, -3.36448451224965025678, 2.49139512242178224500, -5.51414765627025965663, 3.50397244032276838281, -6.10077695681236106166, 3.55136356714745093312, -4.94144816219180671393, 2.82192085151385609445, -2.43473440959060104965, 1.50897353985861621605, 0.38748896648876109986, -0.04241544847983152510, 2.72310679085459254266, -1.40223877384249343414, 3.95725076151613817196, -2.17622444064267984152, 3.90687970266452910761, -2.31015267040802867344, 2.84957527532734378749, -1.80562972531140508378, 1.25270760305298907951, -1.00107743741868254684, -0.34751391707991542050, -0.11174686830679589846, -1.47103591906208726266, 0.58187657256138144022, -1.96388774340174731637, 0.94759813956268181325, -1.82042521687799532769, 0.97519387387921556361, -1.22979302459653902786, 0.76469691599719004332, -0.44833587757038865996, 0.43641767777524395552, 0.22648945113440327647, 0.10295844349990688750, 0.63300416251161663416, -0.15247667611794276831, 0.68823012780727059694, -0.29657355990074926691, 0.51895931986644426686, -0.28366678934920560776, 0.26042845357630746385, -0.21840726652578902477, 0.01291066339226584596, -0.10640667449917864989, -0.11876536053664712219, -0.00599766153630106742, -0.12431396738547916905, 0.05835656225639631737, -0.08448798144770231489, 0.05657261010196243105, -0.03024489717890876914, 0.03245737039200682598, -0.00529671407153560932, 0.01001241358684014809}
-
-// Iteration: 15 - 0.0000827681036592001031031 0.0000827681036592001031534
-var coeffs_51 = []float64{-21.46816493737910934556, -75.41614404930246143621, -36.06996731083704878928, -56.52800897715871969766, -21.02512432182934933186, -24.03603822958251372197, -0.56515326573871794757, 12.90227936581986620693, 19.35866784222361354349, 44.65929554401053312868, 33.00241167235856075285, 62.96015510828332072629, 36.75569662193864036442, 64.65698703813995017140, 30.59911538264326506234, 50.95552502817013558462, 16.86304888019980179926, 26.93078085571579790579, 0.50251861014176737322, -0.56778411406131163396, -13.72223072607978600845, -24.49765741110788293161, -22.23353712212902670132, -38.91619969215996596789, -23.47262959672701263874, -41.44228889381943036408, -18.40536004198357684609, -33.15688383224077760934, -9.67995477964680181897, -18.41903232075337266879, -0.27581056955111866541, -2.54091459509189758196, 6.94962722200469428593, 10.01748803553538043436, 10.71976747102632087811, 16.80225174592662858479, 10.64355037328977831388, 17.67171296842166784500, 7.89627013355957608588, 14.04820901209594546393, 3.74228131508391744548, 8.11264396075581398570, -0.10358614252132655839, 2.06847237983985375318, -2.75159825084602551008, -2.55613762630123569845, -3.59860060814641138583, -5.00189299915665769895, -3.11042333263100766222, -5.27403162002176904130, -1.85537865025183533196, -3.99086258466375058068, -0.55581446348497264653, -1.97887337150449030041, 0.29381006664430571742, -0.21806245015876086519, 0.61223153569996336329, 0.83691399546497731822, 0.54434672094522075677, 1.04044518835820048864, 0.28151651381551490931, 0.67151919846101896561, 0.09418830631184573705, 0.22075532208590723326}
-
-// Iteration: 33 - 0.0000894040269382447728606 0.0000894040269382447752213
-var coeffs_52 = []float64{-15.48693606871396050330, 26.74907563325361181519, -26.09723018689292942916, 19.70939861511580237787, -14.83003637410014702128, 8.22168649323830909034, 0.59795294246012557261, -4.49759988881602193520, 15.27855136674728448752, -15.60984761110704485653, 24.85993930847538210078, -22.02039907489535000820, 27.11748261634344036317, -22.67646276947766958100, 21.50268902880198302626, -17.94434622056819880380, 10.58206858527732362815, -9.54166377989034280545, -1.99511902739834233588, 0.16195580858215321290, -12.38030421639649315819, 8.53713968342710121156, -17.64921067223160324057, 13.53283327192260840366, -17.45461969797664909658, 14.55810588425206003869, -12.48374940661467689002, 11.65327955491213837264, -5.29046538439925857644, 6.51816103901967830175, 1.63625042089017357146, 1.03567652646622493391, 6.81429774375098723157, -3.45618076127224286054, 8.85118642768423159970, -5.81355107768475448945, 8.10265834405392825115, -6.20488642528964951435, 5.34561993681788385691, -4.98969549564640101686, 1.94057380915258596670, -2.85468019559924216747, -1.18112201223234776737, -0.80670315482036772188, -2.84007886203284002339, 0.85591383825334594359, -3.15424615471954978410, 1.74753753175937655945, -2.32407641778973698550, 1.83012911176157043067, -1.07027232810726227832, 1.41501292947734794369, -0.06056633525356757711, 0.71042810832590216925, 0.55007737751983934620, 0.09608093274598601131, 0.60086710028893933863, -0.27481797869783369215, 0.38667298845621351390, -0.34834273184835807381, 0.13540173990820447731, -0.24081249744142599731, -0.00573323774222342289, -0.09570101945831329262}
-
-// Iteration: 15 - 0.0000237431242303726987179 0.0000237431242303727157805
-var coeffs_53 = []float64{6.34790901464617753992, -0.84332474661257811387, 12.26647932696850072264, -0.77727965826643653250, 7.04135454400918105048, -0.50274795988217795463, 0.19453428756195864112, 0.04002184106485415761, -6.21228863644929707910, 0.60485531753319919892, -10.57871677192684841953, 0.93121773204765495663, -11.90400539947357563877, 0.92382487722922529060, -10.03009429086226376951, 0.70390806797046153505, -5.70519282752835604695, 0.38705964018660396191, -0.40212275270139244230, 0.02591399246780001644, 4.30701438434918019626, -0.30838301870012828332, 7.13931509418407374811, -0.54078868226598389773, 7.64142325988135189167, -0.58266855811012906487, 6.09423381561026344280, -0.48069396403969479335, 3.26807229804461419437, -0.28516581446921335436, 0.22673010380865633622, -0.04870766111434584152, -2.14664157732819018584, 0.14119970620853009819, -3.39997092025296145957, 0.23570566265678232754, -3.46200328933806737712, 0.24193229397887914005, -2.60182219558048458329, 0.20214435728462662004, -1.27935262004646737410, 0.12949353135069085313, -0.03064835373826954617, 0.03348810284153386275, 0.81660613529024099806, -0.03897183095867909302, 1.13964083727304943823, -0.07114213362269907362, 1.01264496549180689059, -0.06982862992486805612, 0.64135096849807477506, -0.05247688648756643626, 0.21905954480772607999, -0.03577470556528487889, -0.08819380445209164218, -0.00928643557948558795, -0.20231588420524535197, 0.01137021009149157631, -0.17963299200984264306, 0.01907392961128155022, -0.09712431071779542379, 0.01136983499530172870, -0.02888058193793733470, 0.00169998308035408793}
-
-// Iteration: 32 - 0.0000573223235459482359809 0.0000573223235459482362888
-var coeffs_54 = []float64{-18.83511071725537526932, 26.81294987846564504688, -31.89133791292514875525, 19.93149262804206858065, -18.75540442633041371802, 8.33147633255873010373, -0.81683223260977671898, -4.66385318818211905458, 16.53977757081040836114, -15.76887420377250213267, 28.54072262910375694690, -22.22632588944398925294, 32.14502877026845365370, -22.75504002612481159159, 27.16705113740255423827, -17.98185914157860116531, 15.62166978990097700689, -9.49447849688735684099, 1.53992209371081668824, 0.26426349003950862065, -10.91640279872882668683, 8.69533154400739573561, -18.88476609570155173153, 13.74859230809171663912, -20.55187749402485521181, 14.61306192552677615613, -16.58311608889482289810, 11.68802448712701789526, -9.28775430182479569914, 6.44672313982543487010, -1.12554991381801436039, 0.85359154119783114806, 5.39191284726635370027, -3.53981767073668091736, 8.93255437184274215726, -5.94608131817156513678, 9.22647895839992524282, -6.24792803121420570823, 7.06967353240346296025, -4.93193655189401234343, 3.71490786760717594840, -2.83702823963723429016, 0.36100744871415450984, -0.71961039612393308916, -2.02361224539406542244, 0.91038900721733137797, -2.92283228251382116368, 1.77362684270681782343, -2.71778143662617859735, 1.86708601599956151007, -1.77815308820801290556, 1.40128023849279129343, -0.66889364531070230523, 0.69215489981807465697, 0.11663491354729386538, 0.06278198063319195646, 0.49810510803979075204, -0.29795849834577984720, 0.49092968317121129188, -0.36234930609374955689, 0.29058797691393328827, -0.24196497615704664882, 0.09576106838689677989, -0.07128506038630583852}
-
-// Iteration: 14 - 0.0000313210477672966639035 0.0000313210477672966742638
-var coeffs_55 = []float64{3.96393498111563344642, -8.55460522612962870375, 8.13436777220212147036, -6.59877221194948894154, 4.39747693301986546334, -2.95940244152720203071, -0.25119162663439714587, 1.47820725569412815466, -4.52951030769909575571, 5.27702032189968578807, -7.40796564504645936971, 7.36833598515854652685, -8.09161223751359480850, 7.47094187121440481046, -6.39999378676010452457, 5.88354381575108031126, -2.95432215180034642452, 3.11878942712749206972, 0.92878002454154900452, -0.12630476611677018057, 3.93487560691334806723, -2.95994425013809866695, 5.39765894599471497591, -4.63593153333722581966, 5.16233375269413736022, -4.82770295974072774037, 3.61600495644034624156, -3.79455821910621720182, 1.39140152653633135843, -2.06366276989701695316, -0.73751778227838443316, -0.19583867895047405115, -2.17912216066613062388, 1.26006111098310383705, -2.72762360568009613183, 2.01560726210994075227, -2.44011491874614013348, 2.04868137907939579896, -1.57317444108113317399, 1.58953767412297804141, -0.46898011568734664733, 0.90154488398067830183, 0.45875151652429325784, 0.19092640152818542514, 0.95729999443172946733, -0.34049468468170953620, 0.98022583932652800981, -0.61072949324723768582, 0.68938652459619330196, -0.61743250507908048267, 0.29083229905136819985, -0.45066406385443827551, -0.04418929915649765306, -0.21830738090031987136, -0.19160530339577987461, 0.00020500410673236950, -0.18229721989645027036, 0.12251879511156233980, -0.09551427838781330321, 0.12809363501799859100, -0.03010431628461085166, 0.06603969658681963335, -0.00625853272154579651, 0.01446296018290338987}
-
-// Iteration: 23 - 0.0000615252599144421468930 0.0000615252599144421470208
-var coeffs_56 = []float64{-19.09750357794710026160, -3.90646900131028309747, -32.42536497322979538687, -2.97577175750406931291, -18.84605219740434664437, -1.28488239202438330165, -0.70245288921807753266, 0.59046639656004233090, 16.80961598693601695502, 2.21935538571939888011, 29.02063833584008217594, 3.28257739309505719571, 32.66682848435886813228, 3.52172458631589325344, 27.54667879199432519853, 2.74445382998189041495, 15.72239343977635488669, 1.46199130053979035397, 1.33860265658523563746, 0.04016936529391572369, -11.38188674343225142675, -1.23713913193099645203, -19.28129353162827347975, -1.96206022196098226270, -20.85614829996000508850, -2.20837308674410118361, -16.80641526258303028619, -1.81573323290647455471, -9.21571884315984237057, -1.01028760839200610554, -0.96895148416757105068, -0.23464363798998383787, 5.62153675745375472033, 0.48657727433216609108, 9.16103695721231215889, 0.82631522165950354336, 9.37000997936212449938, 0.94335259705207802707, 7.19244017485038153551, 0.79418699642084250058, 3.67140461781015531705, 0.44465807762431364426, 0.26487284460781765189, 0.16665589143618654571, -2.10758510800256887143, -0.12380704269042091955, -3.04535130631961556025, -0.24462070541512563305, -2.74967571512388996385, -0.27330921643791015609, -1.77695795235745569146, -0.22688355317365433783, -0.64559090772868530414, -0.10129476753492814073, 0.15513803380653901308, -0.03519399868827621509, 0.50924574889185241054, 0.02031769459549213058, 0.48819198013973403115, 0.05687309420917229366, 0.28499833183352450866, 0.04395925085633734918, 0.10075293106334452864, 0.01852072401151523201}
-
-// Iteration: 17 - 0.0000342476904033335648453 0.0000342476904033335808020
-var coeffs_57 = []float64{3.87059260371882149547, -21.53162144113628451207, 8.02914043905942039924, -16.29458873195525922880, 4.32174100467291365753, -7.01977772448179867168, -0.28885261820805921962, 3.79982983745495889090, -4.51701790747903869277, 13.01119088161045306773, -7.39409548982216904530, 18.16736813314299229227, -8.09435370164481097384, 18.49535855913297261747, -6.31987691268887666823, 14.57477039802204124798, -2.76923964146544693075, 7.70867998289615614100, 1.14317773109411575594, -0.27217261850493325104, 4.10256174003204948273, -7.22188777372582628739, 5.44587294597975917565, -11.34374739000939590216, 5.13016016517643871503, -11.92430401655524687167, 3.51265407445283028534, -9.44358232738390828123, 1.24440026390030290236, -5.16993603998871725957, -0.89135667597347091128, -0.58871362916176339254, -2.29413927985812463288, 3.02119616278143723203, -2.77667157328526245007, 4.92140157482071201475, -2.44553968665361011776, 5.07793729885971965612, -1.53350443384284349215, 3.97319664670749373610, -0.40301338878839609055, 2.26041701488835542747, 0.54282833381850113834, 0.52759243876984082222, 1.02310462963109226622, -0.79558835820594472385, 1.01252255946034987408, -1.47733143796971316189, 0.68457747190395630462, -1.52462217644041467219, 0.25845832007708093092, -1.13158021841794062252, -0.07787174262612820938, -0.55052801941889866526, -0.21655925923530674388, -0.02364582091178039016, -0.17961628505182297134, 0.27658165044775956989, -0.08667013603804214915, 0.30710087191223135767, -0.02733962921452735717, 0.17678695263270843554, -0.00557094379209906213, 0.04764066518799338650}
-
-// Iteration: 29 - 0.0001054883330672909348018 0.0001054883330672909348134
-var coeffs_58 = []float64{-34.24518436370994910920, 42.50554030451627666212, -58.76939819778350073453, 31.69324370261645343228, -34.39010327950098894898, 13.15291993590057001980, -1.49328195697727126650, -7.61363313423849791972, 30.23796651605740741339, -25.10038663029755263620, 52.31371491422564838353, -35.30146131695637974236, 58.91055988564834423565, -36.10740122138783004391, 49.81007252619782236103, -28.41028611518345594699, 28.77226787206569173599, -14.93361186948053047306, 2.99821989550402499306, 0.60352543881732768229, -20.20443791051611324984, 13.93290189018038465485, -34.50658424138851052982, 22.03860800377068036463, -37.54842112004283368715, 23.24545795323156890356, -30.54326912495317197469, 18.35969221503687416699, -17.07917629920291024816, 10.07310107200017076647, -2.02428959436910986452, 1.18213205490993997967, 9.75722492067700202613, -5.87249448829853943181, 16.27197842696478896801, -9.49090119700788540942, 16.99444640224784609764, -9.86236023461041021624, 13.01182921576724786753, -7.80984569813172989718, 6.74750480770956552223, -4.39428902434245328317, 0.72851001781682292461, -1.01163833524756457903, -3.62863225106224231944, 1.51178171772963197019, -5.39608284609835607529, 2.85106481737156032705, -4.97117818079192050417, 2.97106212220428795823, -3.24309566939009332513, 2.21092624077608500187, -1.25853884697589380134, 1.07315294428538135899, 0.23421676190777914421, 0.03287655489140533904, 0.87314009270995055907, -0.50753656701984909796, 0.88757681662645740948, -0.59046995123501214350, 0.53275933831172828766, -0.35872215470219749496, 0.19466337065780499876, -0.09288107009871027412}
-
-// Iteration: 17 - 0.0000184955548510312820288 0.0000184955548510312861673
-var coeffs_59 = []float64{1.09631226967570621049, -17.26728603244996142902, 3.31858490597649845997, -13.13271908934888043035, 1.60575833563623663275, -5.75681517386804349736, -0.30333307202247090080, 2.92929573214649555434, -1.89586080636300121443, 10.42077330046081083675, -2.91215242061801333318, 14.66897046014455917435, -3.10461682368927022557, 14.98736804756699556347, -2.31151848394353821859, 11.80590619375052008491, -0.77239930821503965134, 6.25598492361452294205, 0.85570717860777042400, -0.16994046299125625815, 1.95674041541421803654, -5.77702157796735620468, 2.32276544730992952977, -9.12520329370583429957, 1.98350405180646125816, -9.63276803432091319385, 1.17452592962769563171, -7.65894581611321631682, 0.17557253578119822940, -4.22046425262875379252, -0.66732796648829728527, -0.51868243388958937516, -1.14891583302328919923, 2.40190863664533399374, -1.22672012031621525108, 3.95142751535873383275, -0.98318572122352712381, 4.10078058029493356519, -0.52371925845911137592, 3.23071525056848764183, -0.00477476907409966744, 1.85522438781741316945, 0.39035493321964787340, 0.44680304592783262023, 0.53787438866121978824, -0.63092863105531639709, 0.46323533689673029485, -1.18631925471417011354, 0.26631402727086316615, -1.22774562189522184479, 0.05597202898656241332, -0.91584293338539907543, -0.10003837692792468610, -0.45139723937804437767, -0.13818873711417592680, -0.03267931746850410098, -0.09172893989625602217, 0.21293103792632050764, -0.02473493589013638387, 0.24813216535138878743, 0.00551552228081173127, 0.14762352196315588965, 0.00460708555796575446, 0.04256329064295654328}
-
-// Iteration: 47 - 0.0000748392437675226074604 0.0000748392437675226074626
-var coeffs_60 = []float64{-15.19654464176056297358, -1.22495086218692280299, -25.76471345019674506061, -1.00425467208606287368, -14.78686780729446812483, -0.55581010091764371190, 0.20492980449094968417, 0.15662954246484344358, 14.48057787384155506998, 0.73803735783527859351, 23.98515711010473223837, 1.04061604530332741214, 26.50433160031569241700, 1.28702957619914167904, 21.52438030047381433945, 0.99771107364651995389, 11.09626079177675367927, 0.46620241195454325314, -0.91412249696150881526, 0.10088954391018161435, -11.08001914385246433566, -0.39095405834614791131, -16.85386015015179973117, -0.70329081390348520746, -16.89696601222461733787, -0.75713226661381115997, -12.72798896372730098657, -0.59490131865821361713, -5.96425649877854846885, -0.40676190382456477608, 0.87020783998487850456, -0.12030448506658925038, 5.92926318929745324762, 0.17234644168536726356, 8.22591828189631912743, 0.26862516066487276811, 7.85581275233668359090, 0.29763906549112498753, 5.40724886694491635358, 0.30096108962560814092, 2.23650032069186607529, 0.18362927367349429687, -0.64892269662303009994, 0.04576710164201101907, -2.45883186711179202119, -0.02635151053547298336, -2.88711446271394445217, -0.06834069788703731514, -2.21648196983263905672, -0.10373105464769678180, -1.23037486323587580641, -0.08296535638014050649, -0.17771031450340570815, -0.02247819124365399243, 0.38913390878950525386, -0.03386686257134712692, 0.53083922696797432416, 0.00872284673612929184, 0.38625671927034571527, 0.02011690604541586699, 0.16250085789972502433, 0.00944104307329621758, 0.02424996312930585669, 0.01327092491763421543}
-
-// Iteration: 34 - 0.0000090689996979999338600 0.0000090689996979999338604
-var coeffs_61 = []float64{-2.04031631241502438201, -3.90870841229204971188, -2.03816340136244298879, -3.17248416067291542180, -1.34510774677273677724, -1.64863831458204606204, -0.09928816744990278009, 0.36976586020462577221, 1.29460775653325069981, 2.25358652865225871975, 2.26863162624600009630, 3.36857251882455675175, 2.49923174804844661542, 3.58496266194253134549, 2.08594440891561019502, 3.05744048693727923682, 1.26637687594186135278, 1.95874811161266478850, 0.23565738489056952731, 0.51544256341713907996, -0.75913483589125529763, -0.95505433484842561631, -1.45100403398844784188, -2.01892407279388084071, -1.65761538491080309332, -2.43892045064972939411, -1.41406616912625802284, -2.19039857137723925951, -0.85178423480231615084, -1.44828191445930490600, -0.18764482354633803212, -0.52316820118690486178, 0.37693301663691226815, 0.31601317252727273332, 0.71490908821149290179, 0.84895612034019470632, 0.76504118356786055389, 1.04538483347004493259, 0.62786560773966782635, 0.96379217026636480127, 0.37309284673430689286, 0.69711438887725097891, 0.11402807057335438074, 0.34539397292175102611, -0.11263980232651886603, -0.01004260695447182841, -0.23674563969128476011, -0.25442970501122834150, -0.24643485572052200606, -0.35727781303717949453, -0.18839465339457993223, -0.32988313879428248305, -0.10579295431080134508, -0.21446308406090250220, -0.02627813384779373699, -0.07049074319681388462, 0.03754163773085065327, 0.04852721456014480327, 0.05881284477113708028, 0.08780226747188260098, 0.04214742012051454336, 0.06207264163334092526, 0.01361826189488842623, 0.01978918025807140624}
-
-// Iteration: 29 - 0.0000864539781709364162021 0.0000864539781709364162137
-var coeffs_62 = []float64{8.56649835457777661637, 85.45628899034764548544, 15.15580016026071791583, 63.62003223966888996293, 8.38174973535342276175, 26.24062253660608170988, -0.50400448845441270479, -15.77265835407215252479, -8.98486249529436293042, -51.38406552442911198018, -14.61119115554749387081, -71.76599532939729089674, -15.77465333460862573880, -72.77808067847867905749, -12.38615074128860090248, -56.49487113489730027731, -5.66927321225727426262, -28.34279802188178829199, 1.65288898474487935495, 3.06431247192478562191, 7.77582342178453102290, 29.54230299996504269641, 10.72316972898102255818, 44.76376450429682283052, 10.34301613276555904751, 46.24493127076689924430, 7.32076465280603540272, 35.86636745047997766116, 2.88260222352903816667, 18.74139979249906722420, -1.29248595919527121593, 1.06354021166345254631, -4.36288521922210061207, -12.65290680987714414755, -5.54886608673830399038, -19.41802538080134223850, -5.03217738377621052434, -19.65607121667649580350, -3.28492050885265013423, -15.03127967180216686590, -1.12485256986994450628, -7.97470361904791354964, 0.77954402159974632521, -1.28970181400459166422, 1.81829100231273230406, 3.58521754360716368659, 2.02768558379369051864, 5.81956336084599190552, 1.46643592481521434883, 5.73134084243890482884, 0.72338213389090795228, 4.00840699915422768958, 0.06307266927334071858, 1.76813632500751700462, -0.31742285244960440888, -0.06491080675895099205, -0.33700945555514785610, -1.01677048535278371977, -0.26248996340081136018, -1.05942509182364644032, -0.11185340913850159315, -0.65039213320135506001, -0.04151399381746933795, -0.16997592176975658986}
-
-// Iteration: 15 - 0.0000277824913008550253810 0.0000277824913008550253812
-var coeffs_63 = []float64{4.78624213312339782367, -10.39343404141380385314, 9.78317647731205149961, -7.98497565373051072816, 5.42280244953156234549, -3.58507108651629811396, -0.08488613877982592104, 1.72832177682471866675, -5.14862567520498354654, 6.35284237156145778467, -8.57535768631785775999, 8.96842687626464675618, -9.51949166578846087688, 9.12808200192665387211, -7.78825918791992364003, 7.17432574632631719157, -4.03905705047702577474, 3.81028291376754865154, 0.37270773081531874260, -0.09642455854029860326, 4.03405254970018723705, -3.51498737894952982499, 6.03103519660985654199, -5.56697134836808153146, 6.09913459045856353483, -5.86961684283463522019, 4.56893544276311097071, -4.66232775560009408686, 2.13187798783171570670, -2.57303489131993474223, -0.33800943444526598480, -0.31342578104399895551, -2.13631078663663970173, 1.47871919078254894275, -2.95937547184821955298, 2.41167940006798203980, -2.82398455588157912538, 2.49227663803555536274, -1.96647974135767054100, 1.96622435349672539771, -0.79137922725978875406, 1.12978084554224690430, 0.25686994776664342914, 0.26936302811065528412, 0.88646565849212757550, -0.39107736361231280493, 1.02967026472151858521, -0.72604053815980013182, 0.81196245614593423539, -0.74501823203609799962, 0.43004853469767106466, -0.55503957629530866906, 0.06352005924380410142, -0.27755855539399027923, -0.14966980357478100122, -0.01920012116857334810, -0.18899029329047419715, 0.13250056000100853014, -0.12730241144233562021, 0.15394124418211095612, -0.05649068713015838229, 0.08952870291165086952, -0.01514687934977386803, 0.02363211018144168022}
-
-// Iteration: 25 - 0.0001410279434956348927441 0.0001410279434956349881745
-var coeffs_64 = []float64{-26.10842970599681467708, -44.37373555431994869534, -44.87130173050031215573, -33.45865758778020588018, -25.61957596476879660841, -14.07500041832987070468, 0.53967221815928351654, 7.81704945108689987657, 25.60367870123632203708, 26.39358833536044649358, 42.18502377210035603740, 37.19813189169635973953, 46.15731493844583442305, 38.00378953155712773565, 37.04508271154491885986, 29.92186231960794233945, 18.90073520662725957700, 15.83394700464124806496, -2.50564260563112887511, -0.49771377855614332966, -20.23151507912724327445, -14.71678857530357621444, -29.76558054804635868689, -23.06803382031606351610, -29.57317678512738018439, -24.40272372201461244373, -21.79601418975753325007, -19.50641065497256722783, -9.77743380649783764386, -10.64700734765942513618, 2.27471216802103295462, -1.25132858105152632365, 10.91096605918931208163, 5.98008446330649438196, 14.69836156523319635035, 10.03866688401650075591, 13.80236814172652551499, 10.45673707085951138924, 9.29626410853583510519, 8.14706804280798519271, 3.53447979687357709633, 4.70574721661536159013, -1.52179784231948356012, 1.14123954180122716255, -4.57738788095500503520, -1.59650258597741787665, -5.20108287896111017032, -2.98947474678982759605, -3.94008569871055799096, -3.11885310336263795542, -1.97162082757220909065, -2.34408999133204783837, -0.20199202342503549773, -1.12837592556356198755, 0.78382903986151708226, -0.07239041274987263397, 1.01333570721847040406, 0.51988475330031760200, 0.65639716916910616289, 0.62220370943041228318, 0.25534603853043658793, 0.39166315926431535670, 0.01330778286731854402, 0.10253114072715539407}
-
-// Iteration: 15 - 0.0000182275445255015624137 0.0000182275445255015624145
-var coeffs_65 = []float64{0.40426926534625624666, -12.82888327007228761942, 2.20183040035041216425, -9.79088002525816466353, 0.98455177620156942483, -4.31052091858240803727, -0.30798549711269925549, 2.18737895305997725524, -1.36218081730184654476, 7.77245865163580692223, -2.00930379183541028964, 10.91794155251932312110, -2.06237172480657402776, 11.13554807301412063330, -1.38437422713058249443, 8.76946785915033355112, -0.16473068307821730956, 4.64845292273003007793, 1.02628203313508332588, -0.13981813638933551148, 1.69907947020706635246, -4.32447793746320120987, 1.75520200049470911723, -6.80552177467544604986, 1.31632366120774081969, -7.16607955545262384723, 0.57559845490515284345, -5.68759126562976216853, -0.20702693046277436387, -3.11971478286831397581, -0.79248188999341696284, -0.36065983656375965126, -1.05033180113890700466, 1.80241832500108800462, -0.97842927665668745373, 2.95385699848993296232, -0.69340775794580816382, 3.05161004422824411718, -0.26970495643528012251, 2.39066090733361610211, 0.15148045574969426766, 1.37037198408812816718, 0.45151472959234281726, 0.32198626626300646660, 0.51465012512217607247, -0.47864495642594823766, 0.38960713751867421306, -0.88799143556660984361, 0.18052152222838400002, -0.91377310710881609058, -0.02081903398946679622, -0.67992970455768502315, -0.14038322107674831572, -0.33219390062664440081, -0.14629805669553860074, -0.01894987227247289514, -0.07837583713236538032, 0.16385643326067972715, -0.00779166273366838358, 0.18609070202833056845, 0.01845592961456339484, 0.10723026151859807414, 0.00890151130764091058, 0.02922754019226347539}
-
-// Iteration: 26 - 0.0002334505154816160965481 0.0002334505154816162519462
-var coeffs_66 = []float64{28.63476715835744292420, -242.99423981542373037012, 49.46732967215968722843, -181.54779838310168528566, 27.43336359084234455406, -76.38078701003398527905, -2.44851107784028714326, 42.12951014158623518735, -30.75506284190711258942, 143.66571441155184543841, -48.94077016236215056732, 202.34153618743764178198, -52.41024074076909991460, 207.34850758212458934823, -39.98378125702181187071, 163.56812586251348584779, -17.04919639841253627364, 86.12180838963901407398, 8.28218508927829561086, -2.46202631612769015968, 27.89034257062630242828, -79.13435880155571767671, 36.28551145097202901426, -125.59692845632714671267, 33.38942392308771435083, -133.11151062839726130380, 22.11112638491478423861, -106.15813437344055220352, 7.17884906525375241592, -58.65282252351926223583, -6.69929429938085948543, -7.54364288665939435570, -15.66075049755644059779, 32.60028106003349165929, -18.69150566285985501949, 54.21436799291111831530, -16.02691434436663136498, 56.74740044847342262806, -9.62313367028656423554, 44.95376979818224720147, -2.02329208610001122492, 25.82714206787731268376, 3.94365803760047051019, 6.30379768937101205032, 7.04448818987504741555, -8.37657482140840143310, 6.85998745702777663429, -16.16208126198884621825, 4.48812654931927660174, -17.02663969466105925153, 1.49310668358073581504, -12.73469102767671543541, -0.67104458297198861759, -6.31311061434402297814, -1.57065454137301693166, -0.52390528689564464629, -1.27544234423822610584, 2.79485051901055406153, -0.61900352344086462470, 3.32546374420704633705, -0.13285625497155514564, 2.13760488391772299293, 0.05077065744787035297, 0.65158359772437593843}
-
-// Iteration: 14 - 0.0000149839310596581643233 0.0000149839310596581693800
-var coeffs_67 = []float64{2.86520113198632647585, 0.68928737370005922780, 6.56241245769540746301, 0.35817959223803340608, 3.70227714369037535743, -0.01951879539403521604, 0.06227722178773300063, -0.22670591889777531192, -3.27855641857086189800, -0.30370845179818682911, -5.51228219004881650667, -0.33009351230673244484, -6.14834550831426915765, -0.36549207129673004943, -5.12292225074399030288, -0.30697566269089311172, -2.81472556235046316482, -0.14907364022824999175, -0.00206566741799822671, 0.03281458074537721433, 2.41341232839442600719, 0.16906277463519305040, 3.80970568252638458044, 0.23695652391293862901, 3.96349961058392365040, 0.24317431326705726359, 3.06226313566261377177, 0.18075115672326930070, 1.54078095945287311691, 0.09409466456244865105, -0.04842267702421314794, 0.00856959891329988830, -1.24866927784955703800, -0.05784974699017011005, -1.84628421695330931105, -0.09976086363426383773, -1.81579406708866443829, -0.10866728069262999631, -1.31217472537892473262, -0.08039283964642638921, -0.58321325318338308665, -0.03640393324441573935, 0.07753290090162500804, -0.00799562099830439001, 0.49810664921857538003, 0.00769986456591099812, 0.62700353879407816569, 0.02706054527660233200, 0.52844745896141505037, 0.03571536260656271516, 0.30865030826096885677, 0.02719837767402698053, 0.07854966467101724233, 0.00660115423275145424, -0.07198238767161283935, -0.00376077033557997492, -0.11956537772915372027, -0.00476058694089720250, -0.08967011621112139704, -0.00219779739709061782, -0.04186474495606351894, -0.00173141068185059141, -0.01070712002640029588, -0.00352047487929930649}
-
-// Iteration: 14 - 0.0001131686102673317356892 0.0001131686102673317357100
-var coeffs_68 = []float64{43.65656567375401927008, -40.53461850667233410010, 75.97095012500136772185, -30.41366622323430689541, 44.49315313607959700550, -13.01824963558278944602, 2.42513700565833409711, 6.90602534248896205342, -38.05450682369966385658, 24.01392410747180101149, -66.55407082031709112896, 33.82133960060417115144, -75.40913265082013209540, 34.80579401610378172599, -64.30938580751029653870, 27.49949022588530367419, -38.13518127420191776986, 14.58081211548194226561, -5.45565496322414009784, -0.36841925718545513306, 24.32785148073478255396, -12.99913681758163093459, 43.41493699056051035490, -20.93075349882735628961, 48.18665043558826239944, -22.35033617789830370150, 39.77180411242117862210, -17.79597622407141356424, 22.91108758063926599486, -9.99533178697659398045, 3.93735711016832550868, -1.49043445780131190070, -11.56757938683805978524, 5.41800427347831087926, -20.30852088856275637837, 8.96637753909830599666, -21.50323999423215574444, 9.48445006918797609929, -16.93696472186098691171, 7.64875919216928843975, -9.24384297328370284587, 4.34868127126202400685, -1.54054896535321391341, 1.13493092168920344276, 4.09293340286571692992, -1.30621615261653370901, 6.62298278017961950307, -2.70378798800090909826, 6.34616358054866919831, -2.84804601939605118091, 4.35799464241829380687, -2.12156792870741918553, 1.88415881050142742265, -1.09590024654518020623, -0.10946295365952328214, -0.11661370420616797127, -1.10304175977684852421, 0.42250980443893906244, -1.16202091918842785247, 0.56712098414827046989, -0.72679830906307544201, 0.36180574244994782294, -0.26108028913764555449, 0.12612626434552919420}
-
-// Iteration: 17 - 0.0000272175723872777333140 0.0000272175723872777386590
-var coeffs_69 = []float64{4.33856871688508697257, -10.70284082391044685726, 9.07419854617802864058, -8.18771446915543959977, 5.04088732101169108535, -3.63071712317185391399, -0.07208854346732876421, 1.81486724723158657810, -4.78795710028522600224, 6.51037745731813490120, -7.99439467289845947279, 9.15817630625354618845, -8.87063852956828598285, 9.32717090747305915304, -7.21412975324815845735, 7.33622468362652721349, -3.67703819401584905070, 3.89441104070147015249, 0.44690480848365837171, -0.11535449164461916184, 3.82371630611268552704, -3.61553958178006697645, 5.63307055921548873605, -5.70447310939513420625, 5.66737897230115646711, -6.00002371367696604480, 4.21157485383753866812, -4.76311985300342065314, 1.92214406597929557856, -2.61465031113642318528, -0.37602175673773427595, -0.30410538421361353981, -2.04031248648531502639, 1.51509906836643795517, -2.78505005531644392081, 2.47393974630993718752, -2.62663114433672808124, 2.55246189077190127454, -1.81973642946077319767, 2.00234653844495189172, -0.70739809098228885549, 1.14815740889580257732, 0.27569218330017074539, 0.26935868131474459862, 0.85421721947376059244, -0.40310025276836443809, 0.97296023840073271853, -0.74344634701880631078, 0.75446658948627387283, -0.76475717696533098351, 0.38552641974264284349, -0.56742668603667060298, 0.04455173935540271623, -0.28035692609154554251, -0.14734407873783274536, -0.01487977319833209378, -0.17494143066085518042, 0.13716661419961260438, -0.11477563429988698472, 0.15711825289983611388, -0.05199933136413004497, 0.08972945461573530945, -0.01421323547070477038, 0.02363116474375041230}
-
-// Iteration: 29 - 0.0001791776636599213380695 0.0001791776636599214750147
-var coeffs_70 = []float64{-47.13342002953401321932, -89.99704261958671012556, -81.27701645283166722102, -67.25294267599200730423, -47.13743533471034878722, -28.35633184371735772411, -1.08094490245297967517, 15.51080596738161991563, 43.18960978270982394618, 53.05643512338076033830, 73.45690434677303463900, 74.83605087524831681202, 82.12962662452496321282, 76.97765847932441329543, 68.23632097765334643988, 60.54340331283002779084, 37.92996226244821521110, 32.16050621194897677077, 1.46001970183075050722, -0.72464170177250158174, -30.67637953622201679396, -29.22048434305120735410, -49.67312897498060444203, -46.33512924580108290598, -52.58878455651867898292, -49.33774849977730551136, -41.22374966305492189747, -39.30011475432183866467, -21.60155866355542790239, -21.98096270970092577924, -0.70464803830170472989, -2.97377830490939903772, 15.63927636666734029260, 11.89193953386272317215, 23.83818966528246325381, 20.02859124009526710547, 23.91657884709150684354, 21.00285771628093449319, 17.53091325874684237159, 16.68993002018954974371, 8.48443602186756707629, 9.67991141763933297095, -0.29680524509559214534, 2.41359972030770406824, -6.05784256271878408735, -2.99470465600232272560, -8.10353283945076097547, -5.99935250411613639719, -6.95160362919661627768, -6.24143382266589899427, -4.18267535322900740749, -4.77381846970514549707, -1.33412087780304687723, -2.33861420195458506872, 0.71765359028314835489, -0.22957841702396076019, 1.42953075545208429794, 0.96633290842108890118, 1.23686228909322006904, 1.24758135623295127440, 0.64176479985717423655, 0.78915340111548394706, 0.16777355203835059160, 0.26714543083923483217}
-
-// Iteration: 16 - 0.0000235306461333167406560 0.0000235306461333167406575
-var coeffs_71 = []float64{2.62043409181811129715, -11.56471746746418642580, 6.11255277262558211968, -8.81386188056480061450, 3.31943710201745323953, -3.87163111283222540639, -0.15439474475700471196, 1.97275819733264218431, -3.32021896788872532465, 7.00001182671267534389, -5.43081309356385946456, 9.84893376010015335777, -5.93391340975474449670, 10.04067424561003782656, -4.67852731110433890847, 7.91641254155680722421, -2.13745067651238705541, 4.19094338357321043688, 0.70924776082689222367, -0.14024842353978435894, 2.90473083991847322763, -3.92297124153336946502, 3.95642841822250936048, -6.16044071777176105210, 3.77872361889180115231, -6.46643491296656895327, 2.63753731111135380643, -5.11399483333008720245, 1.00488269462970538022, -2.79542543622582730005, -0.56410841863099050587, -0.31210022733538680454, -1.61602559347703473595, 1.64492820048499743320, -2.00822556272393335248, 2.66848393877575297118, -1.79635962499834192494, 2.74933145133547878867, -1.14557664907427032626, 2.15339388516996035995, -0.33176370157224561378, 1.22629497018280463231, 0.35217722744858395431, 0.28239170096245049163, 0.71253231203721536636, -0.43971898309620234585, 0.72107695016310105318, -0.80535288714084064043, 0.50470390740485202740, -0.82551467970201070127, 0.20835757319494131071, -0.61057000854514748567, -0.03634378042082546690, -0.29572199557133618438, -0.14478844626144926617, -0.01139428287053623964, -0.13282277084147091708, 0.15114743367460677245, -0.06922361665392975311, 0.16680346536377957830, -0.02218079297432217689, 0.09592345246231973441, -0.00408541974683483624, 0.02426814719239404626}
-
-// Iteration: 13 - 0.0001524924645531604722073 0.0001524924645531604722790
-var coeffs_72 = []float64{-50.84099675371805404297, 22.09068256522406812181, -87.84712049598089781477, 16.45841678495530403692, -51.38985732011022471330, 6.97536365621654250913, -2.31304207960770004473, -3.86666748821238220380, 44.97023419878404257637, -13.15384416600259490769, 77.83320765599645253429, -18.44847642138914831988, 87.89415139627582544881, -18.73910219706605837110, 74.47669989692349734534, -14.81794129573131516445, 42.97888896202659722655, -7.89179894458180179346, 4.75501718177196524756, 0.34366209396181524351, -29.75012535510283784498, 7.30809153084981648321, -51.32291847971681418161, 11.43049424062094089516, -56.22452616570128198058, 12.13477483482093139229, -45.54678659384316893192, 9.60856425690995665840, -25.58806781405146110116, 5.21597904325031704593, -3.37695994384716600833, 0.64650081296033951328, 14.49136637071422823137, -3.05602569728521957376, 24.31323749849965423911, -4.94540775426699631987, 25.13468536384978777284, -5.17209450738134031595, 19.48160137967714482357, -4.06077520012289333039, 10.23761036563460722123, -2.25624094086137621468, 1.13435249767218101839, -0.58100111578303363513, -5.36347866024202996407, 0.79738553835669368663, -7.96609048368448995928, 1.51012903530094396033, -7.42226501036853659439, 1.53435703156692195362, -4.87725133162322106955, 1.14709561009948837614, -1.94564059398666447262, 0.56958862740633767880, 0.34112533831945229772, 0.01644611579873039562, 1.30413264267098839850, -0.26644873383678019636, 1.35833032523713594857, -0.30667493117281782014, 0.78993523184457473294, -0.18739893065534249558, 0.28167810315096682186, -0.04873684895004728882}
-
-// Iteration: 15 - 0.0000260397650508520012248 0.0000260397650508520013897
-var coeffs_73 = []float64{2.79652838615724708600, -8.71342585022317835910, 6.40114691396842867666, -6.72201083565619886459, 3.41437388675092625975, -3.03187402982970258443, -0.24815599718746745657, 1.45921191155118470807, -3.54665480427552982866, 5.34852014417661202078, -5.72165314340828841765, 7.52714473293558254267, -6.22095075574355314810, 7.64918082589478918238, -4.87326398926373472654, 6.01908935015175974669, -2.17164388400037168823, 3.19195408079912586481, 0.86612158536425622207, -0.09588508467939276007, 3.17474309942568072154, -2.98889977810035737935, 4.23544698366683065758, -4.69743557588143170832, 3.98535387689128443401, -4.92558453073353823110, 2.71462652247576864414, -3.90401889172942549323, 0.95561677441710111890, -2.13132369245434371148, -0.69065154585539204207, -0.23232666972326500854, -1.78300277289154197885, 1.25825880191582666444, -2.16098968309044666593, 2.04103509768975506560, -1.89761396805852963483, 2.09466822390211658302, -1.18376840928025583400, 1.63523963617516680501, -0.30389217066532994828, 0.93674697271851475971, 0.42644212966947193825, 0.21420310324116385262, 0.79026122672272834972, -0.34127685948095550592, 0.78263696556336663005, -0.61463258271771566976, 0.53514037204237224999, -0.62794365223539077052, 0.20583377519602685382, -0.46379777035920579502, -0.05789730759945063130, -0.22697764806299652351, -0.17051803864278194203, -0.01006854613132188342, -0.15040361686988881297, 0.11834783771377751214, -0.07132195153820831335, 0.12966142490739819936, -0.01785224440986978822, 0.07221970921507611131, -0.00052489029589983754, 0.01717643001057063028}
-
-// Iteration: 29 - 0.0002336377677334664250892 0.0002336377677334664250926
-var coeffs_74 = []float64{3.11112756819033176857, 269.22621570050610531036, 5.25378473090675060803, 200.54739443340562774384, 1.77215074732676835032, 82.53396234917339037428, -2.99381916712141254629, -50.60455376703248262124, -6.93775114017744520240, -163.45755108197917769539, -8.49799929836823974794, -227.38515258889500663028, -7.30576537923299422247, -230.21396340095998929754, -3.29984346659670795585, -176.80979663102008701814, 2.22253808027797436201, -86.74726631448639012459, 7.07102397491527888053, 12.90248422649131010443, 9.53862959372634355861, 95.65675368012335430926, 9.39943387795593233140, 141.94703984508056288685, 6.16917361279880276550, 144.82057231701352313255, 2.01326385047703653320, 111.10010235140329200334, -2.36706029777981791573, 56.78014259875518125291, -5.41532609836988636949, 0.99316760813618500332, -6.64771618001998864210, -40.96567758920041730150, -6.15677968497540384845, -61.86572883992280585825, -4.11006161035980114008, -61.64102605149829147803, -1.59833655509505232531, -46.14638947286899196459, 0.93788670458328481134, -23.88007038011052549025, 2.49093856487860254132, -2.61999592904338038534, 3.06213120961650716468, 12.09882047226490216153, 2.54174919433730446454, 18.46802505303867781010, 1.40077794211140796874, 17.54155799189130475787, 0.40305576383938554251, 11.89053335436097808543, -0.46530361166777985517, 4.94965730458842561087, -0.64592190953105947695, -0.43995591874028493446, -0.55209971128971103875, -3.08039301043231999108, -0.29195202200395890255, -3.17913446384245072216, -0.10483522744221270714, -1.87214107190989761453, -0.00235669367797322089, -0.55522868429032141937}
-
-// Iteration: 14 - 0.0000369363132050006145007 0.0000369363132050006248414
-var coeffs_75 = []float64{6.49520550694455361001, -1.87923545119630286042, 12.80079478292958099766, -1.61132869223462156237, 7.18327070248712603371, -0.84651532002559542825, -0.01746673157309604877, 0.33643977207875965458, -6.78692322065946390010, 1.31771765049326054383, -11.42478414831324755965, 1.78855110260699660572, -12.67283153757295716423, 1.76991613551489569221, -10.34835383315073310713, 1.37162438062783037756, -5.35533909107383370788, 0.72595384295211732473, 0.47708236593407083319, -0.05157755091159002157, 5.33657272783413254354, -0.76876685460322972006, 7.99190154960842580043, -1.17363320909064946201, 8.08634372573550358916, -1.15853425109240956037, 6.08435645728135548388, -0.87196676886674922885, 2.85758951908607770464, -0.45367989805674840182, -0.43509854338609626574, 0.01480785010320573633, -2.81785217860298873623, 0.35857014288947151297, -3.92481708047401948618, 0.52100751228505901802, -3.74668521784527279780, 0.48313646100156421125, -2.61865014195113926184, 0.35259321823616995942, -1.05842327262599116924, 0.19486774676245992578, 0.31973766218475935690, 0.01212235720972645079, 1.17463912107818302671, -0.10752429958460934678, 1.36980612496419878848, -0.16059024301502895048, 1.07392989678040869674, -0.14996501636227049988, 0.56734850948757082133, -0.10180208791865821172, 0.08763034868994875690, -0.04298276162313343441, -0.18925522888837516996, 0.01237294701474054328, -0.24469928277782194778, 0.04501720343253250281, -0.17027140845263133321, 0.03744026348800337822, -0.07606687543755249748, 0.00745647465276011935, -0.02332239455388089004, -0.00356797676795686705}
-
-// Iteration:  5 - 0.0001207496436988097107847 0.0001207496436988097443176
-var coeffs_76 = []float64{56.60544614205586122817, -40.33427735471032974311, 98.44563125984326441394, -30.08561092900485453978, 58.12321302783357246896, -12.87387705795595772148, 3.68302353624550456823, 6.83453986622929515556, -48.45700489502905761053, 23.86042655457951006840, -85.24312625592649805589, 33.35596645898525580450, -97.36717131071374184068, 34.48416188521982778550, -83.75333487067484234424, 27.35026810317764573551, -50.69211343237593554205, 14.43187113199785512069, -8.98080452855349201104, -0.16619669399487228226, 29.49712596859762392592, -13.00981406367518070164, 55.06453757067031557740, -20.61421371308431546974, 62.16117803674445955824, -22.11735420849470771502, 52.13544352152316976482, -17.69055622469512644953, 31.06416120543755892403, -9.95171344885263411283, 6.57471859260383961216, -1.45857067496670868497, -13.67371493101494016321, 5.18799756844659614018, -25.38273134290762519706, 8.90388573160518576455, -27.65473900671437580431, 9.42930080741770551929, -22.09797950264269549160, 7.51988126512693921653, -12.69049882371693466084, 4.38260775810341488395, -2.80877977058818675155, 1.15155859538273276723, 4.63693016329763718532, -1.27439917464129260722, 8.12619546217463267399, -2.66106639967980937861, 8.18085340242029860666, -2.79651501138572600359, 5.89677007404748108176, -2.14847443761355270095, 2.72735121575539294962, -1.04475180615453382421, 0.09172031065505788575, -0.17196702142457186216, -1.32181651520134414097, 0.44564052502043589567, -1.51735518172490642965, 0.53595408631080194827, -1.02786223295210701010, 0.35076098173165705551, -0.35951364381268617974, 0.14310322599931493629}
-
-// Iteration: 15 - 0.0000276256216352635146875 0.0000276256216352635147563
-var coeffs_77 = []float64{2.48393516940885894565, -11.82777890924369501059, 5.87690597489143941150, -9.01999158568320475211, 3.10518894767567459322, -3.96362184702134972288, -0.28105457532469324905, 2.04043707853689614352, -3.34500834607364973879, 7.19103311032488557174, -5.36803291662166913133, 10.07299520648039709465, -5.79516252596545909414, 10.24199194000165830849, -4.44343686083051504770, 8.07150510615405819118, -1.81364914543859382085, 4.27615356833417280749, 1.06221724414147608471, -0.15316230040150036658, 3.17592620280206221611, -4.01724828742017472685, 4.05372102392994495473, -6.30473243303469475872, 3.69649092373637785725, -6.60648571302434693270, 2.41154226613734038664, -5.21824576181984059573, 0.70920790896780577459, -2.84935769236313004120, -0.83919052056718252384, -0.31031071134279979044, -1.81581902225831898449, 1.69365860013767697666, -2.09755576657471073778, 2.73446241810297733022, -1.78671168470307186384, 2.80834299755269366113, -1.05576479818674239929, 2.19456780778331264275, -0.19598840686858509274, 1.24620598876105255649, 0.50040658959184234004, 0.28398960804708659835, 0.82341708387648760070, -0.45226358117413409926, 0.77551512622511349032, -0.82503179968550488873, 0.49653965683657499411, -0.84369642487933933262, 0.15740293124673828801, -0.62272875407472920796, -0.09547111406100617768, -0.30317485084377043600, -0.18801497439958553765, -0.00856576695546944303, -0.14730875409398286680, 0.15812563616237203887, -0.06106610655162124824, 0.17141433937674148488, -0.00895562639522935661, 0.09585603885433874932, 0.00225132982524467066, 0.02402686435736395038}
-
-// Iteration: 17 - 0.0001767897677272409979312 0.0001767897677272409984419
-var coeffs_78 = []float64{-64.63208747322594351734, 79.66748717305566923448, -111.75171297344444144254, 59.66448989559356955961, -65.63901958467432843410, 25.11700477735703166735, -3.48694711560567787934, -13.83321471827937293769, 56.44763231052206582993, -47.09554667725283279644, 98.40135098145219878847, -66.36267473519528951502, 111.45253421717938030616, -68.12876013560891843465, 95.01597234067799626074, -53.58287288136722821986, 55.90480041555035463743, -28.39502831943188513053, 7.23138402904697355592, 0.62970386538402370244, -36.51356053259987656894, 26.10755902753673222784, -64.29658983673672985392, 41.21602701200691553038, -71.19879244419697475917, 43.62887420722122580042, -58.57086897007725172149, 34.81994894857834996462, -33.45123243828889251843, 19.26274052863278249460, -5.31184143574293367884, 2.58716425409895595990, 17.48381596670348531512, -10.71409013590615491221, 30.12811351564981553199, -17.79090060536950374002, 31.87266739960391273190, -18.59786499717555743445, 24.95509264380383965480, -14.72747328651713230165, 13.49109125963792842216, -8.48364933249612423669, 1.96766083462713243193, -2.13388222695186043603, -6.22773616664292305707, 2.78956632032118568900, -9.86833185052892350064, 5.27924509063862418334, -9.41605866912438984439, 5.56105369503184995766, -6.35208272376217531345, 4.21578805675993538552, -2.64824074695111692463, 2.03986246694908888064, 0.21747936930233185278, 0.17623840881815528910, 1.60859515156179067303, -0.90197755169145831600, 1.69517850544938028524, -1.09840001333485978413, 1.07201668718038442681, -0.68895865242540688856, 0.38678724842133555175, -0.21991584398051044466}
-
-// Iteration: 17 - 0.0000176799228331089140946 0.0000176799228331089274697
-var coeffs_79 = []float64{1.66827355123076253123, -12.22332107627320556728, 4.47413176281184123429, -9.34352755942957553079, 2.34685349815617243296, -4.13331934997679129159, -0.16291032535975601086, 2.07245393285448120840, -2.36543443340866297384, 7.43204519998922110791, -3.83863266444741524068, 10.44901397463609753307, -4.21616327896728185533, 10.64893862487257720863, -3.33577949111534379399, 8.38570297801684486650, -1.49612928660529688191, 4.44281911257174518229, 0.57119533348800765187, -0.11912980231442357016, 2.15330014253612825333, -4.10747101107180089868, 2.86664597634147271397, -6.48601801637110278262, 2.70497531034942407401, -6.85160113174788350783, 1.84296507607518588520, -5.45082358726981366283, 0.65275359033925740448, -3.00029761120502287256, -0.46729995784862925887, -0.36522083173831250282, -1.20076298453742653554, 1.71225931661509977470, -1.45510600629304791842, 2.81382014802390096153, -1.28787838716663511079, 2.91698464028035358874, -0.80504687868132687628, 2.29420257798027897794, -0.20934690260497871716, 1.31707399726783976468, 0.28826671293433637106, 0.31766576452345340515, 0.53404075659987183145, -0.45098475626441549950, 0.52719470260103654922, -0.84500778302445089055, 0.36374912595805120988, -0.87199877316427258189, 0.14055284414804771479, -0.65043544158339009600, -0.03878197052135433278, -0.32198879240864211741, -0.11983018262653313372, -0.02441095050518636202, -0.09949782171956582225, 0.15400823622976226573, -0.04607391322029156200, 0.17779463682321693394, -0.01113963305571625178, 0.10502351577335408365, -0.00196840031316669128, 0.02892413289006287256}
-
-// Iteration: 35 - 0.0001348979055324008985273 0.0001348979055324009359930
-var coeffs_80 = []float64{49.44605139351548578276, 24.39356638627759849410, 85.86823222352391458504, 18.31771644627010043799, 50.31453114014477883336, 7.59303656433434270405, 2.61235427219567853675, -4.31562039239281080683, -43.31792219805593137258, -14.30094561289969402526, -75.48885095561479235825, -20.21054327628974458411, -85.53768765115069835897, -20.84185561068130312659, -72.79966285863750943093, -16.37916820023540478906, -42.77652302173421148203, -8.81498829899148664956, -5.71470250831927707042, 0.31510483189355091877, 28.09084004600506339805, 7.96815711582680208589, 49.49277540770202832825, 12.40195281131686748855, 54.60923724440393835663, 13.42276114036886002287, 44.92180595112105875345, 10.70108982755132958985, 25.56793150404290904326, 5.82297779961157842162, 4.07437135347463860313, 0.84514852797665356487, -13.47682478372043041911, -3.23795739774254800658, -23.19107528570117987389, -5.36311514841511085329, -24.45574508549336377708, -5.73929340983937642790, -19.10353458411000526924, -4.54307739038638836548, -10.31870340137027303601, -2.51694926878915917716, -1.51137914930198988909, -0.72878887312049462498, 4.80158046903553951104, 0.83052087808939235813, 7.63980449789796321646, 1.64132706635562470902, 7.17394672287554113987, 1.67212937135009938998, 4.89799800516720915647, 1.30604869574289385598, 2.04825982051151755852, 0.62299545795359737569, -0.20461634557699349028, 0.04435119027841989915, -1.25024277042634656512, -0.23807332721539646371, -1.32108531431266602298, -0.34817114739507326490, -0.80818385490886444932, -0.21397540649738598897, -0.28597290108826433972, -0.06976388030633416212}
-
-// Iteration: 16 - 0.0000267303204569938587380 0.0000267303204569938587380
-var coeffs_81 = []float64{2.63460168030950441252, -11.52527296890671991861, 6.15136196956290766984, -8.78373054447623735590, 3.31231051048967389787, -3.84612974972632084183, -0.21227857082867234254, 2.00882401880138534640, -3.42413834082315997998, 7.01021061212766362560, -5.57122910005787027069, 9.80783830957026162275, -6.06313201370123029522, 9.99096597107542021653, -4.69435752753391404313, 7.87399183303421126089, -2.00951179376222401750, 4.17105610278157498477, 0.93206213541197551753, -0.15810397607828292129, 3.14741873015309270101, -3.93084083263446651944, 4.12810576703431350321, -6.15315507798764696109, 3.84672678676610003600, -6.44479600579146610849, 2.59690539404657729291, -5.08088137963617843483, 0.87552043815653284386, -2.76920614775764594887, -0.73517725973787635830, -0.29638835148730594077, -1.77785725902473262632, 1.65571306926413146670, -2.12076253559120796365, 2.66701150687592220568, -1.84792829072649070720, 2.73879054561508992064, -1.13217176060133540230, 2.13882947451164750983, -0.26790478794583009054, 1.21029794498749726051, 0.44413207492046263042, 0.27412145452178061256, 0.79841679639708777588, -0.44245746460002696838, 0.77534081934561836222, -0.80645217826067742666, 0.51360312616766621674, -0.82310067013092781137, 0.18485051915595682853, -0.60773687792643449636, -0.06994324240159480445, -0.29204615281132375774, -0.17301958659628881584, -0.00631679038531847547, -0.14134538467710660481, 0.15433036363002671655, -0.06594045714414566576, 0.16740094045433076199, -0.01729061037212688292, 0.09185901078409670199, -0.00141757171211981016, 0.02351822556193967460}
-
-// Iteration: 13 - 0.0000837712435803170157729 0.0000837712435803170162842
-var coeffs_82 = []float64{-8.06083625882665217179, 34.13512165582237358777, -13.68505164484626314882, 25.55362609271444858306, -7.28290202089792119637, 10.54997651866940403437, 1.29282629385153477448, -6.11133097399780007971, 9.28667534628821471712, -20.10749990642025192274, 14.25749472734616688217, -28.49755099008466607109, 14.94514194070513617285, -29.12751975318734610527, 10.71762698968538298229, -22.81228448794618999349, 3.73418729554880740981, -12.08795164375994489586, -3.82409806656362008448, 0.53515384262921816194, -9.25978524460593277759, 11.23789993102364535215, -11.20807836462823634794, 17.88295557918778201015, -9.62183241165982457336, 18.63147574193753539833, -5.54151646512939099468, 14.83557455848363181969, -0.94590797770093775134, 8.05362501034577561894, 3.04752645255896007778, 0.93741798549901285656, 5.48555139314532355739, -4.75826234491039581275, 5.93491349463819955681, -7.68288311999431292991, 4.72619858844547907488, -7.95204797996098435260, 2.41547359939190345700, -6.26066461297015141390, 0.08912334482163298520, -3.54728887150485365436, -1.76863246744833588675, -0.81712131710896685482, -2.55057502236581668368, 1.27473905776638544356, -2.23977637194301020866, 2.27281469515026220609, -1.31094519324361674043, 2.42885458448164531373, -0.24598387954944462840, 1.75433263604528867149, 0.41779528379044847273, 0.88019771495386644030, 0.63836574162415950282, -0.00874896121881269777, 0.47058131345890369803, -0.38459935186880306148, 0.15705274688986340603, -0.48930695317178572704, -0.00431981091782947125, -0.29292942491687156168, -0.05932791561970374276, -0.06796660668813836494}
-
-// Iteration: 16 - 0.0000228025590847416551239 0.0000228025590847416556583
-var coeffs_83 = []float64{4.04774983832144345206, -7.39590200974243038064, 8.64639017965720917954, -5.68180073755217900577, 4.86658402749153169744, -2.54492946131524066491, 0.05007736475805865820, 1.24991034056668419529, -4.42331400275831346888, 4.52749999663861757465, -7.49892907508378503641, 6.35617476806691053468, -8.37712156237063970142, 6.46712534468255612163, -6.89507154470829251952, 5.09349137449921914283, -3.65204368559257978250, 2.69996292558326627669, 0.18124053402538213368, -0.08199797846396167238, 3.39641777578344383264, -2.51995289463603055310, 5.19990266215841357933, -3.96947792670867048045, 5.34890648662313539548, -4.16259001236928019731, 4.07801218506573324233, -3.30285638315365273381, 1.98629792514474016750, -1.80525757038810617316, -0.17320531115727331002, -0.20273899612561779399, -1.77890578892124316322, 1.05939096494806068745, -2.54072295577925137432, 1.72191451580506618433, -2.46349221689463920418, 1.77117116474928561289, -1.75351311245546976000, 1.38508651291581517787, -0.75193973959854746773, 0.79281806554035976009, 0.16251939825269086835, 0.18342342528664137850, 0.72674172685586642241, -0.28493333241664491137, 0.87596372023487915174, -0.51723166049414484783, 0.71029118609499134352, -0.53229640438921874914, 0.39140970416485337402, -0.39164221514989850158, 0.08030671821452641705, -0.19307601215689536355, -0.11024578889108403089, -0.00917674333349716174, -0.15452105977777949888, 0.09881705117190995735, -0.11222709793701189215, 0.10908509392257730709, -0.05649111993097405413, 0.06089250933145702478, -0.01774849854909939285, 0.01554777968704225465}
-
-// Iteration: 16 - 0.0001258808856498387104326 0.0001258808856498387104650
-var coeffs_84 = []float64{18.18740233422022836792, 20.45008680742272131653, 31.40908129147357021916, 15.49131019708155595183, 17.65801744247176157135, 6.47057352013956405981, -1.09522873196592320367, -3.65191934764554229371, -18.86836923634325701915, -12.01901673577636718472, -30.32467328804816851594, -16.93968680792752380262, -32.83032331653550162701, -17.63682190325471850771, -25.71930596586159849208, -13.89062274640946302153, -11.92891644474787524664, -7.30601028094766018880, 3.87332554023297037349, 0.21809324898497760977, 16.20424471778415848559, 6.43363578265561098599, 22.11089493100900206666, 10.54785068359396109511, 21.09475369430564081950, 11.24170543321299795182, 14.64436088698809342383, 9.00445240350902159434, 5.43902600156678791246, 5.08622725980352977185, -3.12118514687319215098, 0.73738509677077918647, -9.04735933790452915200, -2.65332066906035275277, -11.26079708736244478665, -4.51157412110718770856, -9.88238634314648941215, -4.79231179906031317846, -6.37334438532834580090, -3.86973861965860860880, -1.79597568625174174240, -2.23112615253918669796, 1.95494187958929941458, -0.54504358991369782571, 3.92752672130065165589, 0.61322975375739338780, 4.07051874990810305074, 1.33866036903985722721, 2.83198483973320140324, 1.46961801883780156855, 1.15802014430652084708, 1.06344034852713740319, -0.19166903017183674189, 0.55216011794005436279, -0.80663745792738242772, 0.06382892426460706958, -0.79686214964017545245, -0.18049222899376987460, -0.43276383734192910580, -0.29856107482381618555, -0.12408106038813880262, -0.19006899249322140366, 0.02587496006289022991, -0.06497953593014130670}
-
-// Iteration: 18 - 0.0000427773212225889000559 0.0000427773212225889000559
-var coeffs_85 = []float64{5.71010724571904406018, -19.10035887582302078807, 11.41812662037771712773, -14.49182185162225040056, 6.25541744677519448606, -6.27721811426673317501, -0.27008038180103741156, 3.36627777681746043371, -6.32657175426540525732, 11.57516696167639725811, -10.44439731885874121974, 16.15687304590317502084, -11.47112990348005467966, 16.42651471276966079560, -9.10533972161891407798, 12.94594752114956600988, -4.27146334951245361590, 6.84700762103942560739, 1.17629816206258930607, -0.25662659001464659968, 5.44569850650274766880, -6.44730768943620680411, 7.53420977969871652175, -10.11268964234764138818, 7.29562178535449414944, -10.59825184277872413685, 5.17865300189464763964, -8.37650936118166542113, 2.07495681668249205153, -4.57253521186384673556, -0.93317641054354978792, -0.49614862326729683292, -2.98974826797139080210, 2.70976824744897253737, -3.79422485713623668203, 4.38922243580140767275, -3.43825011077322982821, 4.51045199712977504642, -2.24895635439768452630, 3.51934605093973517235, -0.71787615399209608760, 1.99820707421046955432, 0.58950232974040947338, 0.45486811137777479741, 1.30269081115874081641, -0.71937083003905608908, 1.36129703859875605062, -1.31963854351199878284, 0.97334642046190408477, -1.35659869558371555444, 0.42089376029961862782, -1.00169094573876191370, -0.03742352903508844122, -0.48571943637229873201, -0.25473741903158210709, -0.01369923468988657974, -0.24284702596226584585, 0.25254587895511986926, -0.13585091177867651603, 0.27414816689346188851, -0.05029605269096056200, 0.15430642010911342645, -0.01239221479892720473, 0.03910728131623484345}
-
-// Iteration: 17 - 0.0003595411760202999782155 0.0003595411760203001920229
-var coeffs_86 = []float64{-106.46808531510329750892, -213.62563756264310623015, -184.46488165951042018804, -159.79695712232072779386, -107.39967406383053126630, -67.49608653200526935298, -3.70984074017839307926, 36.58298181271235789172, 96.05363202690210754370, 125.88759080153803492976, 164.91296772526454361351, 177.69241232294532156354, 184.98470007051295511464, 182.82457150533049729050, 155.22406630737414642788, 144.31184356864652591419, 88.59079784207844952951, 76.32362383623223956375, 6.71167563317596227308, -1.68154389353774655751, -65.75387250726184810240, -69.02491418733160347152, -109.99928525529693041284, -109.96216500520171600962, -118.41013766982999924348, -117.08013478132626694530, -94.66201881518615914430, -93.76390940725812535300, -51.34778273115151804541, -52.15689299411746724132, -4.46898269313777811965, -7.34603748115230823800, 32.74839605358860448770, 28.12499503132825154158, 52.39718635611031205339, 47.38501587179208796506, 53.41150197189966940011, 49.97287946603385297941, 40.37465987408554619629, 39.78357402942042433191, 20.30726449842709937100, 22.97980538033307752007, 0.91726328274027469660, 5.91049903217681797678, -12.33257926901105758225, -7.11161951838943192636, -17.53146401837571037818, -14.10804065996022072727, -15.66304473907750302017, -14.96174225102225106958, -9.87133209900594360512, -11.24159238518644878905, -3.54684108401629895739, -5.61736364560382383075, 1.08696667159868561094, -0.61700711618482481636, 3.06979355814536755918, 2.28349089754545951572, 2.78635613274728293215, 2.92684814231740332165, 1.56999496330990581758, 1.92455316869696498824, 0.47629739318849632597, 0.64077921619202105941}
-
-// Iteration: 15 - 0.0000258929881717641518994 0.0000258929881717641519069
-var coeffs_87 = []float64{3.00368347883554425633, -11.69104107782409934877, 6.79382340160613730872, -8.92742146581918058260, 3.67309093669569620293, -3.95915377389793493999, -0.19304518171374006855, 1.97350468136935584093, -3.70105395474166162828, 7.10427696500918213819, -6.04129715551377845218, 9.97945041956840141083, -6.62044089398976875831, 10.16496882253637176894, -5.24090895971189106632, 8.01159441911383429856, -2.41083386905123309502, 4.25062111323632029773, 0.76797104576859276401, -0.12754567929129546674, 3.23697204041196139937, -3.95070262211094912334, 4.42843207834992805134, -6.22656001714083234221, 4.22816488846833564622, -6.54616918248302865924, 2.95110210962793847453, -5.18665964539165116803, 1.12203117820737971693, -2.84967380330013323316, -0.61786045349410052710, -0.32996615482590348965, -1.79989229312673910528, 1.65563676588320540197, -2.23930544275954683323, 2.69884425462207367337, -2.00219718545796426644, 2.78286028993847685438, -1.28159411319572563160, 2.18381257476278260985, -0.37700886828759678040, 1.25016437231905390691, 0.38909163355728419013, 0.29206067695462709494, 0.79039888913626774324, -0.43894486360790302370, 0.80588772798121665418, -0.81343600936091254112, 0.56385721853967556365, -0.83382354350294813130, 0.23791888127224299709, -0.61831173454940200420, -0.03940682834256423499, -0.30508367700598816361, -0.16334898630079546528, -0.01579247168535680928, -0.15005388811933923772, 0.15227264080060555980, -0.07823074655044814238, 0.16941384729287641946, -0.02399721380004911036, 0.09671226499115773823, -0.00369814728943032930, 0.02650748339919401275}
-
-// Iteration: 32 - 0.0001018290090751419561875 0.0001018290090751419562226
-var coeffs_88 = []float64{7.65329830566274886170, 89.77428175968120730669, 13.80807010281616275846, 66.96533226845644493754, 8.92914244110510537231, 27.58969056362845092756, 2.67573361043127439127, -16.96474763357148000574, -3.70004427727861727617, -54.69192530922342785724, -9.07018545206247532345, -76.01561745274741720906, -11.90391368532259260722, -76.86928480422772931593, -12.61004348787989861500, -58.80286438182920172464, -10.77243489135740191031, -28.79586084289239738217, -6.86583227342522061293, 4.50523673582150524107, -1.72267955735728691808, 31.98291044977589259831, 3.29614678814125707884, 47.49933140089409000094, 7.04104784661503248125, 48.29062991930846824501, 8.51327828839347955480, 36.94779391624393981484, 7.74855340437667650574, 18.84535607513977720308, 5.24045083297995418198, 0.19991754016825661871, 2.31224046684099118552, -13.82702248939518546514, -0.39340100422230852856, -20.69608137840315939594, -2.43862158027086083020, -20.52267118904470996881, -3.29518764623095920476, -15.35291736568798485416, -3.31464049313770970133, -7.90221468271941835908, -2.60330285950167635033, -0.77493367655892298456, -1.46945777274214537651, 4.09212926652800765232, -0.27476019668901249680, 6.17508169065237562522, 0.71246419227613589205, 5.83955322447364849652, 1.12297600400988768502, 3.92513639077390916111, 1.05415011630289586529, 1.64389907868604418391, 0.63035295417921372945, -0.18846250965501810356, 0.15211319378906252440, -1.02411677833101529067, -0.15991310959296302980, -1.06930787271086243272, -0.20537890517928944387, -0.60463024799786477065, -0.12949271666038469969, -0.18312199523622289055}
-
-// Iteration: 18 - 0.0000171079062681792377276 0.0000171079062681792377276
-var coeffs_89 = []float64{2.51722011403839497295, -7.70920091466006302038, 5.99279051427504391391, -5.93192489052429519472, 3.31090506233771645760, -2.68618513936726409901, -0.02624294328095032866, 1.24662517918052716849, -3.05553778349529704728, 4.69397371114782996257, -5.09021045870943210722, 6.65069023921200972963, -5.64952680733135308239, 6.78614520107460748490, -4.61768905173903557877, 5.34537621071406177285, -2.37383461186937613215, 2.83828370988165917677, 0.25058943549928601260, -0.06080333129569187885, 2.42741481532904253372, -2.59969816317814368800, 3.59155936590966706391, -4.12615349983826730754, 3.62329088245570630659, -4.35747608213883749603, 2.69623334913562924403, -3.47138786929297761568, 1.24294381811817283653, -1.91668746932514255762, -0.22902011879735685094, -0.24125659994682165054, -1.29347907243929349062, 1.08705694203860665789, -1.77125225841958340966, 1.78520891001172439143, -1.68545996015704336972, 1.85218244929402069916, -1.16006409557278764001, 1.46386063892879967893, -0.45583418165043312196, 0.84436794675666035813, 0.17240389272696226485, 0.20516961987080178721, 0.53960691309376674713, -0.28824557489542729669, 0.61632548056374025108, -0.53712558180660799993, 0.48293258798684787937, -0.55393310908806734369, 0.25025043438992916921, -0.41147351695956715644, 0.03326769380147926343, -0.20699992299511449844, -0.09559966107191842755, -0.01741294494016245626, -0.11306396462327455673, 0.09700000399421302009, -0.07524204074995897835, 0.11327845470495862473, -0.03164810758372044731, 0.06745478134476391824, -0.00883849007683852627, 0.01862535209720682800}
-
-// Iteration: 15 - 0.0001582519350798328590143 0.0001582519350798328590173
-var coeffs_90 = []float64{45.99892245901445766770, 61.97833434169888783384, 79.65208900242210681044, 46.39421961905494300184, 46.52603511787346631421, 19.56626611147528855635, 1.65203673155573513933, -10.63114512049135112351, -41.60535240704050830771, -36.44420147991462264971, -71.36882353148385472390, -51.50136433495446028936, -80.07735790512818487791, -53.09546057078789557853, -67.22497190000071340854, -41.83617401781901012004, -38.11222296728207591183, -22.27807590887518782562, -2.67441448896397971130, 0.55903819833554848415, 28.50669733535428963420, 19.95952368167276639273, 47.57299287802644581728, 31.91201338583497044723, 51.28354381275307438171, 33.92791210331637234547, 40.82962612353146682650, 27.25987323501986793049, 22.22770122601620677620, 15.11455693250725020367, 1.80238275703425687077, 2.16464182081274987164, -14.22923811692547886667, -8.11938587780734420573, -22.71765953965669533996, -13.72760190626790456377, -23.12044321792538657923, -14.51546019534172333434, -17.45242084785395168261, -11.54597160160231270147, -8.77696088595430592223, -6.66946461638830524070, -0.35486048108453748060, -1.74558955877107501981, 5.43004539045755423933, 2.06297397995274028427, 7.54168739787198919018, 4.07810304709881007801, 6.81441219965353354759, 4.35430318486701305245, 4.23854510983889247958, 3.24425130504462696841, 1.51126453606308342688, 1.65670221694032573669, -0.47886044488702148945, 0.15281563211696779759, -1.29703859450792890035, -0.62653089498052021977, -1.20893158500960175888, -0.86418378313867654083, -0.68005221294731535112, -0.55029770943253080434, -0.21378277275009864304, -0.19329293917620876881}
-
-// Iteration: 12 - 0.0000152111881037604351221 0.0000152111881037604351221
-var coeffs_91 = []float64{-2.53769253740715972938, 6.52830901100460857839, -2.67806853296117615849, 4.72855411475198356140, -1.53416514840376020919, 1.79012691699174742258, 0.15167489104325402233, -1.34703843009792055839, 1.92974619503626782304, -3.84329155339115104327, 3.19636275118231955390, -5.20425672374470378520, 3.42463383833070615434, -5.28109881086494622113, 2.55876921521092185017, -4.19389910422238433641, 1.01734306184388436145, -2.18810692081831929203, -0.57538165608253097201, 0.17575416314379485132, -1.72862112062002958306, 2.21366533298960450300, -2.24051237307594855525, 3.37010071532554348083, -2.10279149081192226014, 3.43852302834842563465, -1.43889621754895004529, 2.65488062226139232850, -0.50504188319959230169, 1.39640580037422252392, 0.38766438080822336585, 0.08772540625663550881, 0.97114552614936158723, -0.94169459258823074598, 1.16358874377256638451, -1.45904460717042249462, 1.00596693407455219277, -1.46632455635772650727, 0.63037228260667318047, -1.11279441771824387728, 0.16580983076915147557, -0.59997190140177575746, -0.22651655428218451314, -0.10997372898667352915, -0.43783544694193734315, 0.25491838337446887722, -0.42886399513240623050, 0.43847116581168582270, -0.27647266842235409487, 0.45260576050102002158, -0.08881189887303539233, 0.32293374072730645901, 0.03757531946032020803, 0.13894535141412043570, 0.07731249585530498805, -0.02264223749771199312, 0.06209049935889544086, -0.09654043251405650849, 0.03447607624547382851, -0.08379634416723196461, 0.01653628665488248989, -0.04134575204580179802, 0.00592959654833224126, -0.00839610968925959772}
-
-// Iteration: 14 - 0.0001063734299255015058989 0.0001063734299255015059008
-var coeffs_92 = []float64{-30.38432922335052086251, 36.80686613651531091144, -52.61285482119333013052, 27.38069890390194794665, -30.66724298247860714914, 11.70029137932505075156, -1.09020766769531445267, -6.07103376774812067521, 27.51993407378516854220, -21.65348172486424688920, 47.25375372402763014078, -30.51517649483929007207, 52.83826500507702937189, -31.49849129518198980530, 44.34905082286484703168, -24.89215043689701440774, 25.18993192330603962390, -13.21805062994372491049, 1.52864867598207452541, 0.06706024931435295270, -18.90220748365357287138, 11.64040791128889805856, -31.41247673369608766796, 18.73040064208669177436, -33.87231711937640919753, 20.09698475951289770509, -26.95348171362660611344, 16.25439240775883989852, -14.58729941833071314482, 9.21238061291883486053, -1.13723782089532673117, 1.46352517883089693947, 9.46385305482322266021, -4.61568212030357535905, 15.03745703322720643197, -8.07443894392419820716, 15.25588978211306761994, -8.59625099044907268034, 11.55189517392222573573, -6.89761882953096540272, 5.75897028197515081682, -4.09440136952784551746, 0.16530814782705475735, -1.11409409167768716395, -3.58831201838559876633, 1.13029273166672839524, -4.99505335968319389809, 2.37804156012137073792, -4.51993405571821988077, 2.55312450189194448860, -2.77263163797898965338, 1.95239229308091848271, -0.96727907059746133731, 1.01233594261263009362, 0.29488364079903414498, 0.17386490844422290652, 0.87614323800185745226, -0.36594125269606943955, 0.78044104024906583733, -0.48991191227070989487, 0.44895679480998423874, -0.33741280357986704720, 0.14638142729921842802, -0.14318899583715943152}
-
-// Iteration: 16 - 0.0000128304369308450778441 0.0000128304369308450778441
-var coeffs_93 = []float64{0.16872175448366816763, -7.74607874483476223348, 1.90490069820345773123, -5.97022950472787083570, 0.87936556160563281464, -2.70670647381340251659, -0.21910735089032038968, 1.24946301478418944412, -1.08819625119016770850, 4.71439060802974501173, -1.58363011987337536731, 6.69703120536292639661, -1.61858738476596000151, 6.85440511835493297418, -1.12007659389894882184, 5.39722930889785112843, -0.21890806538330094655, 2.87273647233244237810, 0.68704645346785413341, -0.06998274931202173560, 1.24246631665763652757, -2.63786918309746813761, 1.33975141094419915031, -4.16718925581610618415, 1.04196620838517828281, -4.40045223926770048841, 0.50907293597099210972, -3.49445455198733983174, -0.07655511957250158579, -1.92510653438663606951, -0.54346910046464396303, -0.23299045382548425515, -0.75899632436776273393, 1.09770110867388570503, -0.73953379215654404382, 1.80323815122707213623, -0.54613900902292163755, 1.86969436735796926567, -0.23176061618305343509, 1.47461171130218820197, 0.08840865871023240372, 0.84972742333712010335, 0.30915049438907786874, 0.20330304426226069067, 0.36893343469480680529, -0.29313505011877868139, 0.28544758591378837913, -0.54662976621415397680, 0.14258656766612382705, -0.55753281591235295878, 0.00108174496192979203, -0.41581767393262061849, -0.09002245663127686933, -0.20551701157438891120, -0.10309435862652093771, -0.01470298859890286635, -0.06101760254343692435, 0.09715617033301205821, -0.01088683492277970440, 0.11481404159852567530, 0.01184442530410768722, 0.06677018780631330477, 0.00702481369789854445, 0.01877953875955582660}
-
-// Iteration: 13 - 0.0001053208985874338392746 0.0001053208985874338400033
-var coeffs_94 = []float64{23.71263926344336384337, 29.41089039385143124374, 41.10001665862706784514, 22.03637858564716349104, 23.70605015785078215392, 9.32423474967063774257, 0.22011644306033627911, -5.03379735800298514572, -22.33738131187769572796, -17.46049217835033481975, -37.63080395510460500980, -24.57592332858165741528, -41.74688386983948642640, -25.06808782866910629517, -34.35411418826211948496, -19.91722943769422606950, -18.51197172378037348052, -10.54705692827016683105, 0.40125643498615823035, 0.37824110995737000465, 16.59219134671914484605, 9.58915506954864082067, 25.73418838093198649256, 15.22776191795179353927, 26.64719394863988218099, 16.20726980177925172450, 20.61870549408304932851, 12.83506388610719424013, 10.24848297695949501172, 7.11954776874884087008, -0.54761409483198493559, 0.94353441732601178644, -8.52802481837211614707, -3.98561829850680455124, -12.55318611301728020083, -6.56148162482216950983, -12.19313543666837293262, -6.88929349365420299095, -8.87798137494374844908, -5.47746395871423688491, -3.83386110450103242521, -3.09332114885989997651, 0.53324550548233275144, -0.77985375424404631276, 3.44627035645234943197, 0.98923359736764687229, 4.31634551577491404468, 2.00345707864889768194, 3.54157069347588163166, 2.05583533171216150341, 2.00072827337386928814, 1.52822344539841289059, 0.49134958068076228376, 0.78154210815116910933, -0.47171360899056227440, 0.05270267652205985011, -0.76353599382230170349, -0.34077397176284634910, -0.60444114187213274618, -0.39694083946376352491, -0.29628524621449491804, -0.26210031787311289564, -0.07668990434165621520, -0.07797685591912379821}
-
-// Iteration: 18 - 0.0000241208809447344542895 0.0000241208809447344542986
-var coeffs_95 = []float64{2.30891046456567295062, -19.04519187003418717938, 5.59603733825362159744, -14.40987450559576212865, 2.99669014399383613054, -6.22624170543259193174, -0.17671504807446509355, 3.30744376613602595113, -3.05358712321617691890, 11.45707616461182373884, -5.00088618519898060677, 16.05499255883183039350, -5.46734602125823968522, 16.38986053560194558912, -4.25989392121611048655, 12.93176697974321911352, -1.82158405269831558625, 6.84186191216101657392, 0.82818293267494361018, -0.23045223258184160739, 2.81232460832108301506, -6.38001506743396579673, 3.69184231799108228721, -10.03215555193123841892, 3.45765580423609366136, -10.55474500451899697453, 2.35031536936447275004, -8.36316615168516182610, 0.80477439446525002809, -4.58222940545539677647, -0.63925290177663049770, -0.53321428520798228598, -1.58309633536301767226, 2.65968883867159681853, -1.89330046278288413657, 4.34424696778704885872, -1.65842779177516626564, 4.49446383808647588384, -1.02681811545981264080, 3.52496952483359537715, -0.25315906387732042063, 2.00762888406262080056, 0.39361421086463564343, 0.47272612321858635335, 0.70901690648190904741, -0.70048890756159129445, 0.69113939864335690156, -1.30639830201441121819, 0.45984736593495836579, -1.34987110456971990019, 0.16791375249815011552, -1.00076139945913648007, -0.06230013847879516862, -0.48682466862276231727, -0.15256750203418170736, -0.02344810762627307785, -0.12039526619952523231, 0.24071688559058417845, -0.05678240075283309466, 0.27003831920138773977, -0.01612622491349327248, 0.15773877798579220582, -0.00482810409406235135, 0.04397619320746963631}
-
-// Iteration: 35 - 0.0000691961753235526359916 0.0000691961753235526435805
-var coeffs_96 = []float64{15.00611287094529019005, -61.29495920951846846860, 25.85520327049921315749, -45.84207238216283407763, 15.00000251645553841596, -19.35573746764523127923, 0.02351263038368455188, 10.63452413214235027566, -14.30152790656846473198, 36.24190612854030867649, -23.86019481796958814378, 50.98720701634499064251, -26.41591931456124513734, 52.41969826114785819007, -21.53785626910608467135, 41.23539856930308333254, -11.51794215546081204943, 21.93779310899163298966, 0.52280306596662571582, -0.55193489316713873168, 10.68562842790809044998, -19.98541229874977202244, 16.50047038103413689981, -31.66702185141507218028, 16.94080336205846210058, -33.53618999389512258521, 12.81724041353444968854, -26.86564572478080839978, 6.27266504481131267456, -14.85494374195126637727, -0.55029563744170579067, -1.97272097100681466224, -5.63489414982575083733, 8.11260941718056710517, -8.06590970528566837761, 13.68962332875785608233, -7.76071386921524202033, 14.34563698667581003854, -5.48033848924915492323, 11.31639953021425930041, -2.35844186275567437503, 6.57104373663031251296, 0.48800414057922422905, 1.65341852872232509263, 2.30426102083524085002, -2.10499562981645926225, 2.76801862215784811509, -4.05998560757253307943, 2.25956235161401398613, -4.28963203859505203308, 1.22773597642361069400, -3.22612305975294059897, 0.26450724663705856193, -1.60176215964134469487, -0.33955391316724906901, -0.13274925920389010434, -0.52732396381892623596, 0.66847724882386512364, -0.38277838176554109931, 0.85437583323063871646, -0.18395405532525502544, 0.52442816665494570521, -0.02137645150276134796, 0.18137089051394477420}
-
-// Iteration: 17 - 0.0000385759847740447671171 0.0000385759847740447671183
-var coeffs_97 = []float64{4.77119807472164330311, -22.22171301575090356006, 9.82298893658094604840, -16.79717523500105730405, 5.37240617173762299106, -7.24100937906041324629, -0.25269463370021660470, 3.88044986278213951562, -5.46720890588791153986, 13.36604456773233982035, -8.99693977306520095271, 18.72004305683002437742, -9.84204111386786898811, 19.10870804461848332723, -7.74640669846986061026, 15.07548895605681887892, -3.53001121532669411667, 7.97841741756215238140, 1.14798767263088073856, -0.29145171216566717178, 4.75900971512726138174, -7.48440069359983879999, 6.48861695239319226297, -11.73706229782223325615, 6.23454390825825166501, -12.30860788395264655217, 4.38258571864450928871, -9.73034064319898082348, 1.69930748566458403382, -5.31374577290119750363, -0.88381279933723760447, -0.59219538044070095364, -2.63580955016465236379, 3.12710729953483079644, -3.29255656285831954886, 5.08125305750946788041, -2.95539191497092917309, 5.23924544882931395510, -1.90499863377392104219, 4.09824711761655173943, -0.57516187113540830720, 2.32832462043713231861, 0.55719264335038087786, 0.53639487498113807839, 1.15670819237762479040, -0.83059653836118493153, 1.18403002922874968851, -1.52979884346165769672, 0.82949236875948357023, -1.57616073866466664279, 0.34338936533467674834, -1.16481093981390965619, -0.05172072466211999274, -0.56135723344204915024, -0.22833006857611207790, -0.01707580030866801970, -0.20716445267519063846, 0.28614755650932157349, -0.11306434996813194948, 0.31514231911451575752, -0.04119523703602620453, 0.18002875514188378321, -0.01085501531877971799, 0.04889749268966108489}
-
-// Iteration: 14 - 0.0001544302979359708124481 0.0001544302979359708961054
-var coeffs_98 = []float64{31.58852792311470653824, 93.03048485519791841309, 54.48686664115901940413, 69.54672339900943886983, 31.28846210482838912582, 29.23487322057037430059, -0.06677053704545790182, -16.15782222167610259878, -30.15809129672055141119, -54.86203205665331985728, -50.46740326153474679448, -77.23205532287590993358, -55.78191830062843012985, -79.44883520178286106937, -45.44880001467256819127, -62.63105422532731694593, -23.89989441848363230863, -33.16222789160839150995, 1.62334632939666261561, 0.88094516405035025495, 23.12561871145053861594, 30.13827689428764250723, 35.10925955095817335147, 47.91323178661400521581, 35.66151383914917849070, 50.88185410522556875422, 26.92279032880680583561, 40.74195464647545519299, 12.85225450790232775417, 22.54148053825406958024, -1.57429789392532683934, 3.04475326522381495827, -12.19913957089522966553, -12.28407854587872917100, -17.15195244529419954250, -20.67267279106679756973, -16.48929611446139626391, -21.74767099613631477869, -11.50259200211601817125, -17.22922702484698326077, -4.81114079732792784826, -9.94040617140864789957, 1.22916567902277187838, -2.51392107503505862805, 5.01430748598912580021, 3.13978535936412888703, 5.98582089605780340997, 6.14508039624116763854, 4.74076088984606846236, 6.51924758836200461854, 2.54048653453268899246, 4.87985025185681818683, 0.45630683172825570880, 2.43497547546070249094, -0.77334413818201701964, 0.22169121621413202306, -1.09818488937715495053, -1.00520699147939453448, -0.78767734588551350995, -1.28545510820193534503, -0.35813567916689609700, -0.81500393911137863550, -0.06975867352338530774, -0.27328316431845015544}
-
-// Iteration: 15 - 0.0000208775831525724096189 0.0000208775831525724096192
-var coeffs_99 = []float64{2.47168890582751902764, -3.83102877153046348798, 5.87744210615428593405, -3.07172108744535685119, 3.15575718791591858038, -1.50948014824073215049, -0.17720934768378965328, 0.57434064460218961394, -3.16039499939522190426, 2.44087762031182197556, -5.11320512697110607904, 3.47681469904850723666, -5.59452048563784476072, 3.52170696341734806712, -4.46948250057076301691, 2.75796046306482348716, -2.11922433330543772428, 1.47561058195227824600, 0.57667414121169410365, -0.02416455797187373248, 2.70454613649134651738, -1.35759257915368845361, 3.75280893039753528217, -2.15191140190234769314, 3.60380432825525440867, -2.26493090110778842890, 2.53259505753801641970, -1.79779314982778972556, 0.99011875916475542118, -0.99042847859233113109, -0.48505728583504202217, -0.11217939426486818826, -1.49429697386313719036, 0.58026573659595081704, -1.88761555316762726027, 0.93679833528232788271, -1.69920735137762827310, 0.95787495857866843079, -1.09878375016620717033, 0.75287733608446666667, -0.33372950877686610928, 0.43668555547366769265, 0.30782910620781401404, 0.10089931047356302814, 0.65079611599084390709, -0.15974451722160544916, 0.67370155489068054282, -0.28516647780045629418, 0.48293180555756915509, -0.28419454595661498170, 0.21384172381399702935, -0.21117091656182413905, -0.02254165407509363489, -0.10741311166640272116, -0.13534688470211990005, -0.00817778300821462400, -0.13248624525967724042, 0.05510497748601385534, -0.07033008744344065827, 0.06262285652984955161, -0.01946231941159355544, 0.03286656944884233058, -0.00155690935778676277, 0.00725970390203916942}
-
-// Iteration: 21 - 0.0001383989688969145589169 0.0001383989688969145589169
-var coeffs_100 = []float64{-28.92229851370664249369, 117.06714231045453382569, -49.70764687757133087777, 87.66828901502066945924, -28.50927818483231568139, 36.94168683185337975126, -0.04202782264506237984, -20.40297962875504501715, 27.34548061668052256706, -69.36508515979268620135, 45.98029730849790960849, -97.59020240217573943162, 50.77611366029036840929, -100.04618871069002172278, 41.43640878262526412986, -78.89608450158103352975, 22.02763880829012747277, -41.52613311915140190377, -1.17190295275971926310, 1.23906082685448139459, -20.82028643583277857424, 38.30166142042314312618, -31.84789199259854961790, 60.56619844900211621552, -32.39544152418110883801, 64.28414191358582790365, -24.61188883174435188414, 51.19945827430982283085, -11.88766281059975102991, 28.19359811752766241044, 1.13167776457552360377, 3.54874178521147010611, 10.86657100855868188421, -15.77422719887484386453, 15.52067814436506117890, -26.16994519926150783592, 14.97552918413985876365, -27.39454981772650148740, 10.53184281279717826732, -21.66047703502837291445, 4.45383110069778728927, -12.39465780707690323613, -0.97255964377191929356, -3.01829486640565153077, -4.42639185398874256709, 4.07198112403876350039, -5.40819693048195679737, 7.82195969080304359078, -4.30305777524099965368, 8.19640185672973365691, -2.33755868610988807516, 6.15914771400144176248, -0.44318544250475634076, 3.01194414012935332612, 0.65361119587604574335, 0.24142505077381408560, 0.96970321092796102122, -1.36846101670068071014, 0.69621517311027724133, -1.61249835888425979777, 0.33925533059761803607, -1.01698741097880543021, 0.08429894050858506647, -0.30585280263580233074}
-
-// Iteration: 18 - 0.0000144164250285671770478 0.0000144164250285671770625
-var coeffs_101 = []float64{1.68315326570248487850, -8.07454809469581072692, 4.52809582395870253059, -6.22827423745036172848, 2.42717901241715818957, -2.83169749162284398656, -0.09432245463176286684, 1.29797855547842884084, -2.30813377146957875091, 4.92681796713989607653, -3.76840968024720839674, 6.99133346263825733363, -4.15837715746110299253, 7.13772791130287526759, -3.36273405991120967329, 5.62172006215980780588, -1.65750931849662363428, 2.98251733257426809984, 0.33027054712204449830, -0.05701337622528148754, 1.92613800667381977679, -2.72435853071066901631, 2.73355453420280380040, -4.32988613509162260459, 2.67852145800979721549, -4.57863610842058328091, 1.92732729148788169611, -3.65616203216467149948, 0.80439702115778250489, -2.02086516617074906977, -0.28417506149629961654, -0.25686522507011097521, -1.04924646797987617770, 1.13379586354285664097, -1.36569004027673764479, 1.87460637466551221060, -1.25514222412610324302, 1.94800267246793217016, -0.83539937995396762676, 1.53978124773975793559, -0.28037431361874195772, 0.89282423483680578072, 0.19265349519650559497, 0.21802507508402630694, 0.44835171608010792411, -0.30101029473506470258, 0.48168955829261311697, -0.56290217972733019205, 0.35815514498580469000, -0.58228024318651094290, 0.17048095302809704186, -0.43276430167571495659, -0.00047095679126301428, -0.21834901351090032866, -0.09101864747531073754, -0.02104002461640671433, -0.09354435142790506415, 0.10068484653537040725, -0.05203179671423282690, 0.11913806915509634729, -0.01781890634210708730, 0.07200553068176965595, -0.00332179632060071499, 0.01993746907197133297}
-
-// Iteration: 28 - 0.0000754091458279231845054 0.0000754091458279231939441
-var coeffs_102 = []float64{22.78100305647888784135, -54.27402761389578034860, 39.54074324427417315932, -40.45588815384907565122, 23.11463461779140816888, -17.03239349576708480193, 0.93326820249227136706, 9.35235009752922476024, -20.58042911124161128908, 31.98428452257693374320, -35.28264869358749745146, 45.10514429894524259398, -39.52938292659421837695, 46.28431798366095863780, -33.46040804954238554582, 36.51048055553696023043, -18.98415161839110694729, 19.37026513164279165446, -1.63561338558217668702, -0.53092717511203416518, 13.86134632945631469874, -17.58309687296326585507, 23.33746629911357019016, -28.05223989718525462202, 25.31198554667136360368, -29.64374442871755259441, 20.34777934546987060264, -23.69587692110748113724, 11.22322202409630190813, -13.12784389789626311598, 1.12100600528894558892, -1.80955915386985544202, -6.83200400944455738113, 7.25773823268229035074, -11.07476465952273601654, 12.05564287778539417779, -11.44210177882584045579, 12.64539667511451756583, -8.66929149718727894652, 10.05562991714240685215, -4.45128862141225715166, 5.77575367501550993759, -0.29493556781882558697, 1.46198923588723764520, 2.56656878505111766231, -1.85645696412298997664, 3.66761343717858968315, -3.58497506239065914893, 3.35202081073823543745, -3.80962483608590784598, 2.13729254614146235461, -2.82235898107241558822, 0.78802050193742132067, -1.43389185685215046980, -0.20115460435632096590, -0.10709211673122814140, -0.59654695761779616054, 0.59265634989733419293, -0.61923382825569397953, 0.74428412756411387564, -0.32528475905425215692, 0.47399848431590358636, -0.12681998789120641148, 0.15547430732217909981}
-
-// Iteration: 17 - 0.0000409406244436226555516 0.0000409406244436226555949
-var coeffs_103 = []float64{5.10952593525211417598, -19.80877976340808266215, 10.38369636104296198981, -15.02778527412895543302, 5.63367000352920468435, -6.51429680967160586809, -0.31596710156599973572, 3.47968083301677772035, -5.79859434650972005372, 11.99871596198369881293, -9.50830347137477097657, 16.76930492951829901899, -10.41448848689883158387, 17.07247340035173266654, -8.21244364202521783031, 13.45168676942489864646, -3.73653895623518064980, 7.12288821740344610675, 1.23083897868652010103, -0.26424173756435840401, 5.07459194586038969650, -6.70435002324619172659, 6.91489662220698975233, -10.50113554373723391750, 6.61551009503304971201, -11.01067234035307717028, 4.62743246591622182111, -8.69588780190234469434, 1.77068168900747521281, -4.74774011477153560963, -0.96301939262950042113, -0.51685113073095164470, -2.81223461697587890409, 2.81217240737155450673, -3.50251213812363685849, 4.55558416744177209557, -3.13135075853840204679, 4.68405790724060756223, -2.01392778032467186244, 3.65697906482953031096, -0.59876590217717478787, 2.07641895802870293195, 0.60101763135718566687, 0.47417143727254384929, 1.23803109153653628041, -0.74799791326613549325, 1.26166378649777846731, -1.37255094814390096206, 0.88056987870498785142, -1.40639874624228590956, 0.36550253590822407819, -1.04217668767128016305, -0.06078206817237613454, -0.50230816440590694117, -0.24989586727240448425, -0.01386649117768240302, -0.22453411815587454611, 0.26077154795182385291, -0.12020699941386033526, 0.28453125843920408533, -0.04050881596948526584, 0.15937526678651991499, -0.00990228380109663650, 0.04158831836041692139}
-
-// Iteration: 18 - 0.0001608948923567449148366 0.0001608948923567449570073
-var coeffs_104 = []float64{-38.59671956500463753595, 67.63066619059659098271, -66.78083058310804896810, 50.57413400640639433631, -38.50794708567921057725, 21.16261061758045002088, -0.40784105443830845726, -11.73747177924357358480, 35.97369617728647470248, -39.83793311638554306451, 60.59552145072137290087, -56.23799612672685694924, 67.50786635767594742909, -57.74517667974129988354, 55.93585553353368257136, -45.67889144878765888511, 30.43891934593670883787, -23.98456120847457775529, 0.10458187829777056762, 0.68138060193395418153, -26.12335796253305751209, 21.99267390536304908886, -41.37123455494791195152, 34.82622932282415444945, -43.30964871816176399976, 37.10448437800601763398, -33.46425070973616561242, 29.56889412062535237366, -17.00301597239043841151, 16.38629906613701329482, 0.12173957456498087488, 2.07469659387796539932, 13.47259593340120863679, -8.92823607360865529028, 20.08149924647168185126, -15.06861003266961292389, 19.63914299712323825920, -15.82717794767528588462, 14.37657305552579893390, -12.51750492587762218090, 6.59922642329089246461, -7.22311052434137281610, -0.66940407931977018164, -1.75182024942759617359, -5.32035814941153436624, 2.26178549107908357280, -6.82280772458860024066, 4.52104674342907799198, -5.74473537505094967193, 4.72784699841106609627, -3.33803169972678282829, 3.55867416590621321762, -0.90454482569704027055, 1.73491392953994932363, 0.70652729410657735896, 0.17202957309084869132, 1.19596255169619002889, -0.76027075754881436164, 0.99675724892887633233, -0.91660417763077375094, 0.49335524763820802807, -0.61554577626960275812, 0.13412735848696755120, -0.17970847039833439123}
-
-// Iteration: 15 - 0.0000412803943756149460102 0.0000412803943756149484897
-var coeffs_105 = []float64{4.03446369276900811109, -21.42517791152501703917, 8.50599204383888408670, -16.24393267426961895891, 4.49373402316903268478, -7.02593829857065317166, -0.46049893100710817984, 3.77515679340774159218, -4.96839891950101234210, 12.97269943929659181398, -7.96918360846855135082, 18.12146892462032198509, -8.60503434970928843399, 18.45290459097539126310, -6.55741464023823017133, 14.55033700804536586392, -2.62437792183264679799, 7.69459229277129599480, 1.60327162272905069948, -0.29399358190705599195, 4.70369235615346266928, -7.25248429725514931353, 5.97361341992141913276, -11.36059654693672070609, 5.44806366992130627680, -11.90321071064615245944, 3.56540972487228269741, -9.39653075377365093531, 1.05222794417073991712, -5.12547087280144405282, -1.24346632040782874964, -0.55512268243627721457, -2.68077551224683722757, 3.04583787293951732726, -3.10045371682268958345, 4.92526719942060566902, -2.63449477705544376042, 5.06393696219090844277, -1.56577919998076094051, 3.95407103166553785135, -0.28916302351341003988, 2.24060507812749486382, 0.73529763663854456448, 0.50975343025591882974, 1.22019479512632559314, -0.80960989239430866326, 1.14578122533762223147, -1.48405126095488025086, 0.72805910668954708863, -1.52348965129632004043, 0.22810700720917683943, -1.12491543500424109255, -0.14137440923934145318, -0.54173045520397737709, -0.27548975605052681105, -0.01382184267844351230, -0.20752637424432388089, 0.28306805199509634282, -0.08787300268061307869, 0.30671876455367311138, -0.01679720336941731554, 0.17229019927748996131, 0.00065507242725190893, 0.04463119311095986663}
-
-// Iteration: 15 - 0.0000778507184163131190093 0.0000778507184163131317166
-var coeffs_106 = []float64{-23.51241165132094787343, 3.51547168722320137087, -40.73305611555987054193, 2.62239164888068892816, -23.79459434942631939786, 1.10057832223387859973, -0.97637886471776268825, -0.52144059498414316363, 21.13991414561487774420, -2.00301369152183541320, 36.39516599395650868120, -2.93767754318888878960, 40.82034938280572546114, -3.00170135653617741671, 34.44228995034695384899, -2.36326054101071004969, 19.61828900842819107645, -1.27268432274449244198, 1.66775124835704964228, -0.03419522279247321596, -14.34335312638869908037, 1.02825762870624130119, -24.14362920803232955940, 1.67120555047619966941, -26.04836643057319008681, 1.91321688714331720710, -21.04627868647059663423, 1.61143893862081715025, -11.53493935433119890700, 0.92399948173126833078, -1.11394120018999694806, 0.19773080969419403937, 7.09820340531075140623, -0.37141916030993298738, 11.37620568479554821188, -0.73349532763580765100, 11.89210961942680285182, -0.83145129885298070946, 8.89087567262198119245, -0.67607124168907228023, 4.60101508331654445171, -0.41299674847545940691, 0.28384245145163899029, -0.14860609455358135242, -2.63631231377808800389, 0.08581060614164082600, -3.83691175278806311786, 0.21522961863273195076, -3.43493276566179861277, 0.23025660406323904905, -2.21704370522304664279, 0.19566297803405456145, -0.80294272324883387137, 0.10919948629298351440, 0.20307950541931900410, 0.03467191168896841592, 0.63433741729525285131, -0.01874400674426484337, 0.62639643851674707943, -0.04493332225834030277, 0.34482865865485818309, -0.04564742072863495480, 0.12621911211040760119, -0.01617395553662993381}
-
-// Iteration: 15 - 0.0000156031940395871569415 0.0000156031940395871569416
-var coeffs_107 = []float64{-2.37857455414390197938, 11.08460185541104302451, -2.38030325074992512658, 8.16625701768640447705, -1.32677716659859415073, 3.25564313842961073149, 0.18775579567469776938, -2.14386192592871111998, 1.77154682103462331868, -6.55737400053445109653, 2.92739137647248618312, -9.01024714039764592423, 3.14038162210013462755, -9.16811472255648962840, 2.30224817946257908084, -7.25237093093346149411, 0.83439982976350899887, -3.80821058163999337093, -0.66058816226051218788, 0.22291911942436564990, -1.68549191926480379849, 3.71544430898590400827, -2.09569524514346351067, 5.72937345655643061237, -1.91549470281081580412, 5.93877481178524638089, -1.25888602330984158123, 4.64854132745857742871, -0.37309636134785333514, 2.48967328179657066138, 0.44619402844603143050, 0.22207811248565228619, 0.96086458098063189029, -1.56025632533549057665, 1.09879573131380437360, -2.48486864008363740829, 0.92551343673382884329, -2.53465425156365486431, 0.55603789578904550990, -1.94977317182511349349, 0.11410899913786894584, -1.07929395962104160593, -0.25820671669309494197, -0.22813074100871877984, -0.44066775135443228987, 0.41721931740959057807, -0.41275335702248364402, 0.74461468156705793883, -0.25220781079483330998, 0.77121197131114304010, -0.06404390389925634918, 0.56337990863688775967, 0.05581886432305717408, 0.25517322051745518740, 0.08459669982233416287, -0.01416449057118183916, 0.05862020695313643126, -0.15063838195072767789, 0.02537675522375677629, -0.14817933410594737871, 0.01315049672702829804, -0.08042194444404265939, 0.00493371362929778222, -0.01936174196163084736}
-
-// Iteration: 40 - 0.0000949481451367334771838 0.0000949481451367334771924
-var coeffs_108 = []float64{-25.17537203631096993976, 47.14008845739400444342, -43.54798531879781682712, 35.32030159805697847496, -25.18221378002549481172, 14.83020886155538149187, -0.62701058473852911618, -8.21963143865206926766, 22.91885151046394582478, -27.83954607940928836978, 39.18235470282332199335, -39.30129961083307837090, 43.86675694747168668027, -40.32678470782191547347, 36.62362496330539788982, -31.76451648133271521259, 20.40456802649895165604, -16.73766568362802619108, 0.90224972191162510122, 0.55395485816500633438, -16.09676469807939436143, 15.38416689169124439426, -26.45811393574752707124, 24.34312989130608854762, -27.98123324480810105736, 25.89462813017364697290, -22.10771957318906739544, 20.67658616082180290073, -11.69034779503870815664, 11.30065595925151921007, -0.65087969361873177467, 1.43621312145094904866, 8.17758012288086731757, -6.34579207222797366005, 12.60864548290082813976, -10.50311124831878437004, 12.76810280051788352841, -11.04927529351161836267, 9.39377342436105921590, -8.73901728618905884331, 4.65387029182902589105, -4.96567811491010738979, -0.06228889749252427607, -1.23682584375347664821, -3.12740436855881194437, 1.65380696722469526338, -4.27776390617586584019, 3.13915128803134318693, -3.68515700198437594350, 3.30319755706545830045, -2.28942651218539021395, 2.47583214881938356071, -0.69436197494849944824, 1.21991295512374972004, 0.31798771919405326603, 0.08611165750046583314, 0.72117288628611303831, -0.53598121125487617179, 0.63086576798942206944, -0.65570644494867142936, 0.35822487663104837953, -0.41294226556577064264, 0.12053763977971492028, -0.12037193627428728050}
-
-// Iteration: 16 - 0.0000211416297580800685834 0.0000211416297580800686547
-var coeffs_109 = []float64{3.50654000113971283802, -11.47537430579704509846, 7.69005849523841352047, -8.76439410929555893484, 4.27510749487945976694, -3.88644047774627357314, -0.01465849269916534104, 1.91737325785284376041, -3.93871703175694568050, 6.94523675097549817580, -6.61873995552804852730, 9.79433001550888707658, -7.38629299327107153033, 9.99655082690825530923, -6.05930365515483588846, 7.87635044266609603851, -3.16048629049833787433, 4.17311674485303934028, 0.23828825867707475162, -0.10378882814987502070, 3.07292908888848272842, -3.85030618691696654657, 4.61996918903619035856, -6.08527198176184925277, 4.72004443028616371148, -6.42461437172853389062, 3.56139638961386559939, -5.11314892207744606927, 1.69434789208735780257, -2.81477355002404492139, -0.21451387165089659761, -0.34775490473409155403, -1.61665618705853618286, 1.60491319639376375386, -2.27009778882846157741, 2.63529361342027479753, -2.17735905553056679148, 2.73410046056316388432, -1.53701966199460850303, 2.15431682404537458972, -0.63307460825931770016, 1.23732888571152669761, 0.17816025871594647674, 0.29954122736257884929, 0.66727058488706863257, -0.42434077936038038401, 0.78429834448099435517, -0.79094032778022671508, 0.62753950577406628352, -0.81838672359042316740, 0.33591865255670541866, -0.60951815871859511401, 0.05942551219048528427, -0.30125986694470476309, -0.11027310459274365471, -0.02325306067415254803, -0.13812227005508108995, 0.14371725792818317390, -0.09705590659574672060, 0.16591243238570092226, -0.04707077946932337973, 0.09893031802441890679, -0.01465882713805857029, 0.02731778070056227536}
-
-// Iteration: 27 - 0.0000808185450306053671402 0.0000808185450306053671431
-var coeffs_110 = []float64{20.96090963513891179023, -4.89520633035809140161, 36.17517132273272129092, -3.81693126314822929314, 20.87282528496855844941, -1.62147227554287656792, 0.42716387263945925108, 0.80017886896311418343, -19.25557221817916066250, 2.97073400437437643474, -32.73631728479619213094, 4.36503253474802729575, -36.46762725218873823412, 4.23693194011334993696, -30.31457408405811790672, 3.37658219943550156308, -16.83295259075545123168, 1.77998015364519112446, -0.53941944100282515708, -0.14002205135334062386, 13.73754980129407889835, -1.64954727245618694152, 22.16190745039982627784, -2.61584296595039159153, 23.34537978330486743467, -2.82884113867053859587, 18.35247052719002948055, -2.15776593713457202741, 9.49709687458067875756, -1.20692050652421905057, 0.25202404073170673735, -0.09394943517855265909, -6.99793970963301235878, 0.73219461388449083345, -10.66654414091536209758, 1.15116490481032199206, -10.60414720079995151277, 1.17863014767866189357, -7.83861098562223329449, 0.92996959156984899250, -3.70191620267400610036, 0.50608910850346961408, 0.16046277146198871755, 0.09766019047643788747, 2.70684344398123948275, -0.17228481391841109821, 3.64244010930358387690, -0.38434890443056702379, 3.07854895265472856197, -0.33747753395947863782, 1.86300663175943758952, -0.25516203691901334528, 0.56719820033514535212, -0.13846788545012599961, -0.31571659163828025012, 0.00774136544035959130, -0.65025320690077271126, 0.06516986028724254795, -0.54760226140052884534, 0.06985081361411695594, -0.27464930104017162913, 0.05922632332996876474, -0.07892361941718843090, -0.00378249987988475492}
-
-// Iteration: 18 - 0.0000115554731269858962358 0.0000115554731269859046839
-var coeffs_111 = []float64{1.39634617927487144790, -8.75270416439550340863, 4.04179573203213849705, -6.74180933578976109839, 2.16123103291808961037, -3.05768311702883411342, -0.05929726607931922635, 1.40394569001569165404, -1.97011442054046563544, 5.32697460279312555229, -3.24575017456084385149, 7.54767374040391915391, -3.64818004420831247146, 7.68485164438769223223, -3.01583481155689286319, 6.04241358737201069627, -1.55112961192808967413, 3.20879156248308915357, 0.19784060146974627685, -0.04115327650364352602, 1.63837174207045305357, -2.89182620319935443399, 2.38682893545159907330, -4.62809739710329662770, 2.37103864415459785661, -4.93291092269770735281, 1.72462807887292622369, -3.95703649190405467299, 0.75250880891533543495, -2.20801557959851276396, -0.20066681444682473981, -0.30727541207741827651, -0.87918479471103335110, 1.20438231905975494140, -1.17003798908132071840, 2.00725739587715638238, -1.10003170400028667088, 2.09887422317333751897, -0.74523234591746087333, 1.66987411991189284143, -0.27438852274929490567, 0.97113391674585007149, 0.14207324020600547863, 0.24889511245836635220, 0.37119823529767737385, -0.31082958859729189375, 0.41142028196222628743, -0.59992075356826100204, 0.31871379182114981838, -0.62376462189790860733, 0.16158364123135930428, -0.47005019432776158019, 0.00958930620684229897, -0.24293631762352544422, -0.07838498008005965346, -0.03098216846951716366, -0.08160281967838266866, 0.10385262902732717731, -0.04649422672333212673, 0.12875673864451786241, -0.01641232766125213383, 0.08105051099584355542, -0.00280008843497345833, 0.02332615643277681946}
-
-// Iteration: 33 - 0.0001373740973228642175606 0.0001373740973228642175632
-var coeffs_112 = []float64{-26.16253060861840354310, 24.86813985287088160365, -45.11407564541517046407, 18.72152592223325921540, -25.69453578045526997772, 7.87832927825900622646, 0.30590292699405558571, -4.48750367940419830971, 25.34998782500863200028, -14.83124410045325112159, 42.18404665444617205904, -20.58666388955368374592, 46.26448995737737797125, -21.28761021143022942917, 37.29452603164948635867, -16.78553886090557175961, 19.14527941083527797025, -8.65961505286111609743, -2.04914268703014188110, 0.18900458531725320597, -19.79248596922587835667, 8.08241240939895397307, -29.46716826171021390984, 12.82606069222903180855, -29.59255133547975656086, 13.59919570964816322474, -21.94121740941424499653, 10.98276080029914167381, -10.13847041294762704765, 5.89910481884640564091, 1.77182655128406718069, 0.87066948065692607491, 10.56072067775633655056, -3.41580815287367701103, 14.60099212303018993387, -5.47983157909277628121, 13.66453367066673453493, -5.82201458619025844273, 9.44827429175557874782, -4.65370640127484676511, 3.71795821794980937572, -2.57483043455855768705, -1.29425561257730947398, -0.70216839699081447714, -4.40889662576139970451, 0.90896744483561414210, -5.09224464583810275274, 1.57552043034429266943, -3.93410710374309577446, 1.78866006088387085921, -2.00689803010797512395, 1.30615112369328386952, -0.28319612776744472431, 0.62438324404178507309, 0.71722092580960812499, 0.08565178747612633757, 0.93876682952722033203, -0.30164791114665237314, 0.64783636686486738667, -0.34449341620224616463, 0.27187368499416537054, -0.20998574392896257238, 0.05159732923977767349, -0.07054457916715335888}
-
-// Iteration: 16 - 0.0000237164332775716662269 0.0000237164332775716670340
-var coeffs_113 = []float64{3.25922235980973567609, -5.62539805952302632435, 7.27833421847651466713, -4.35752592032117134111, 4.03292077794208751566, -1.99432017196781566884, -0.07345097008859615610, 0.92694085972122990127, -3.86136154304197774239, 3.46277009581214519044, -6.40653605296383627480, 4.87234589906590962190, -7.05294645531098398519, 4.96339891587152796304, -5.66579490815476275313, 3.91855831390016059220, -2.78533098493712369318, 2.08344504611686080114, 0.50569578350361564137, -0.07845824683818463470, 3.16823933893027247194, -1.96338641679607658654, 4.54507802397547039222, -3.07380956995771467178, 4.49602945265749398837, -3.20336762650859997596, 3.27968504946241409442, -2.51355709387808587710, 1.42722257032304991235, -1.36586467982589301752, -0.41653313376878629128, -0.13467614170629092804, -1.71872805266084123711, 0.83559919786457717295, -2.27104562118452581624, 1.33056285342352158795, -2.10904582845949169537, 1.35672945058140142124, -1.41483650251525421148, 1.05781799820476414588, -0.50785015181037600555, 0.59785248419200156427, 0.28217353728717026171, 0.12931545373162807503, 0.73342007014459565460, -0.22774007213401512705, 0.80050796823994451852, -0.40689642226452413237, 0.59787532314069866751, -0.40837343504557364999, 0.28703327967246255874, -0.29743408453841301596, 0.01270075032838741967, -0.14369801497647269381, -0.13370753005022124290, -0.00003434873332902920, -0.14444450805464248643, 0.08070427298653739624, -0.09047372948491294773, 0.08429339096290865828, -0.03615281582760418648, 0.04321468968470401127, -0.00932763201325428595, 0.01023748289530856856}
-
-// Iteration: 13 - 0.0001231310474361248779208 0.0001231310474361248891019
-var coeffs_114 = []float64{-29.90319476611493305246, 91.28891935361477946132, -51.64628980764973465211, 68.15746986960249668160, -29.93491415764156614940, 28.91618625924995050238, -0.46404325616729125854, -15.51872739242532563371, 27.88180212510555381413, -53.79412088854185611052, 47.01300065453692295851, -75.78269870936754801930, 52.27631516946208024322, -78.17463763403202920726, 43.30823843097331526412, -61.78105131096970101819, 23.69786321967170499910, -32.68913540335982517313, 0.20889300184783930599, 0.63190618703817163998, -20.21999735111882271804, 29.49468685597534048583, -32.04234418588924796788, 47.02037452439025616624, -33.51657919703359091805, 50.00508005023664227393, -26.01615156800025038269, 40.06786041624487840865, -13.25649576566162040190, 22.34291417573728557101, 0.15247956225635643419, 3.20281936665297241465, 10.37107841167325396244, -11.96757259051221147019, 15.51545160879563203362, -20.23224993208319587822, 15.24988367297965571205, -21.34757147422704573542, 11.14044301030658825986, -17.01781321566394697884, 5.07767390067517451529, -9.86631188635592486456, -0.45833961082463126352, -2.54838707333725993496, -4.11616941668607134722, 3.02047113578802996778, -5.28926564059365030746, 6.01288548516232860334, -4.44630832727133677990, 6.40269748461548106759, -2.58557026666198089596, 4.80304649820536592981, -0.71935358412376287370, 2.40232411834005910406, 0.53910711517481043946, 0.28377484469219742157, 0.94856860535232603862, -0.97842680235843092405, 0.78751007142306306963, -1.24035921481954676534, 0.37498072929013331111, -0.80629021334480940818, 0.09373454796151673159, -0.29528418914757857823}
-
-// Iteration: 16 - 0.0000252846508345283044288 0.0000252846508345283070833
-var coeffs_115 = []float64{1.82591798209264326644, -15.38249982287544473811, 4.73844778800823518093, -11.68959444252449156685, 2.43919985622914151109, -5.09571389437228938195, -0.31502247475525877979, 2.65510662991381626526, -2.75253598352759706552, 9.30190595193308600750, -4.34043079270269142983, 13.05879493188349035401, -4.64520570247817259368, 13.31633891967265008537, -3.46845443951727688542, 10.50435211947421136298, -1.24413692971165314081, 5.55775105155572803364, 1.09270788936947859665, -0.19594367921493523659, 2.73172067234433049929, -5.21326227535674024564, 3.33577234891293431831, -8.17467755715808288614, 2.93984597639690638654, -8.58127742395045323849, 1.82505475739140990566, -6.78050578858763813073, 0.41164206631884956588, -3.70548889579173123048, -0.84445453834508001583, -0.40990565821961860098, -1.58987104210908864439, 2.18161876854371707359, -1.75713013773116996238, 3.54194705169083587233, -1.44697692508839539023, 3.64963536081700509846, -0.80549857037530869822, 2.85597835995291834578, -0.07763950725801939702, 1.62441081074447675782, 0.49409474709284001870, 0.37298299731718699302, 0.73844429629634645980, -0.58105719723604805832, 0.65586767706139137684, -1.06964065376309651826, 0.39358998320278156196, -1.09569574772881550011, 0.09810380962004164965, -0.81118669010665739465, -0.11377154658954475896, -0.39118372174974247991, -0.17627912949729021607, -0.01403319536631529385, -0.12178840847474150005, 0.20087235371147502870, -0.04275784498734718815, 0.22036062212935206049, -0.00167958194446187738, 0.12659116491970420078, 0.00347726010493271798, 0.03284875974244696100}
-
-// Iteration:  7 - 0.0000948419970826128135867 0.0000948419970826128136138
-var coeffs_116 = []float64{-42.89828571392369845574, -12.10978590077217287872, -74.25997130100750723928, -8.89098540135819462081, -43.87293646809822921368, -3.67485914912209168530, -2.82341308429657194096, 2.11177432596500453181, 36.72727819997320319687, 7.08438427256084135529, 64.58576180753341400473, 9.95668970798552308425, 73.59346627097478452382, 10.22021563161382432728, 63.34767403410867499963, 7.95894060008178197916, 38.18367270840181848412, 4.20298635650334457356, 6.53092287168817581218, 0.01782982419065919265, -22.58290627798285703976, -3.92466235180117081282, -41.77660768681970872218, -6.19258848703445734090, -47.03420006290069898837, -6.45079426347918707467, -39.37557553786248285036, -5.25863919785300236926, -23.26193229070210069445, -2.89797566718088258704, -4.80868259494089892388, -0.42595588336702503915, 10.51903950070702535674, 1.58628736908269095323, 19.31822057595366429257, 2.67243390481650044349, 20.90634265141218973085, 2.77265445981405268289, 16.72279455703332001969, 2.18540235601799735138, 9.50035001325560294745, 1.30878192754810682464, 2.00162666767251093767, 0.34030521733992873825, -3.56806080420201958359, -0.44765615173752785013, -6.22011458750620217315, -0.74206523191624333007, -6.18970328642008139465, -0.83999809005444177017, -4.42896700051371602570, -0.64148856686397589649, -2.01176016238408519772, -0.30528970277764780963, -0.04666019956102964513, -0.03888843159138304082, 1.00764971668398827559, 0.13880572585810077525, 1.15397211811113194721, 0.15992942712853787258, 0.75424454871889965477, 0.10178626350357221205, 0.27624711389056651574, 0.03803531189893720934}
-
-// Iteration: 16 - 0.0000430107162631210275242 0.0000430107162631210275529
-var coeffs_117 = []float64{4.20606385149382991429, -20.67626836020204335010, 8.79781333392376214560, -15.68102843599600045644, 4.65228898343125683101, -6.77818935819745656359, -0.46716650862203131020, 3.67122740097685454097, -5.14843971979796073583, 12.54735287166018574069, -8.28228722824505486732, 17.48297524453294178861, -8.94811699844587997342, 17.78425314412634806410, -6.82325846697745302702, 14.02545892722116483932, -2.72897036039148597802, 7.41186046550667881026, 1.67554300504202941412, -0.29370910215213451818, 4.89453386585557893223, -7.01602430988067374558, 6.21586885259461643926, -10.97341825907743551698, 5.66761757596933019492, -11.47942469659221649192, 3.70377463974058785791, -9.05699678045934933806, 1.08466856651194482540, -4.92970531699699650512, -1.29231640189200368462, -0.51881313042839313211, -2.79501350346716554542, 2.94987339744498806204, -3.22398642725165576725, 4.76181918563919581032, -2.73832214028552370324, 4.88537290138135588515, -1.62585216673148601567, 3.80449496943962125642, -0.30393180458510057968, 2.15369414969643477365, 0.76963663191148781938, 0.48435838498670470870, 1.26915230962077855552, -0.78682963557110973771, 1.19474261413822647099, -1.43336225992121493173, 0.75714204583190912767, -1.47139250011126134247, 0.23597324901807730302, -1.08392148400013282475, -0.14945140632994891167, -0.52019991918417524511, -0.28612747271961225782, -0.01010342618619365720, -0.21614982608525813638, 0.27816505551569177351, -0.09068674994744988098, 0.29615800574755287431, -0.01698982190858520275, 0.16393352620641952739, 0.00020970288397662569, 0.04172649987464890014}
-
-// Iteration: 45 - 0.0000997869174056690056640 0.0000997869174056690124777
-var coeffs_118 = []float64{-6.31079928993177338652, -85.22925286036621748175, -11.21508858368055520074, -63.40961975404281037387, -7.14252053555855555974, -25.61996924854388924241, -1.72579322701084730278, 17.12239278219554024874, 3.78183321529488523229, 53.10567392768573051272, 8.06221143336861455092, 73.07853032797041407801, 10.14751818517870312287, 72.99764176376468774082, 9.99032064548161281830, 54.65860506143468095436, 7.53487177968779735388, 24.86776095663073336141, 3.73978057519151774476, -7.03145705216494417643, -0.25046760342116975538, -32.42440542527559572563, -3.60872110392704195034, -45.59639681208771207475, -5.60510505516897685040, -45.16307129262038052283, -5.72043854993507854754, -33.31587982563708460540, -4.77956305749811828362, -15.72164702470300761310, -2.84610623189284160599, 1.73694678780548977094, -0.82135364041033110449, 14.35561732997845746709, 0.78643948792393460386, 20.06318371077719944431, 1.83147121999478749172, 19.16690545517791630678, 2.01368756451403809107, 13.62504531996067740668, 1.82649715419509233867, 6.19941613219574725999, 1.16266279291624419586, -0.41207594201095116650, 0.49357790988752121702, -4.57928764279811287375, 0.01634462380279583013, -6.01231767752143869560, -0.38923740282827040639, -5.13660231182958089104, -0.42803559139797124480, -3.13748041451181691735, -0.36055169604661479403, -1.01763566249593494471, -0.20423720679884919772, 0.39135466260325454893, -0.06061541607805825981, 0.96095246144273703191, 0.02375255784741048518, 0.86517684887936067440, 0.02767226106095183954, 0.47354632003235212054, 0.02513820332174086475, 0.12861555307449837736}
-
-// Iteration: 16 - 0.0000351944459296159760742 0.0000351944459296159760749
-var coeffs_119 = []float64{4.57229297521523426949, -18.24348106625395249577, 9.47545031400202632358, -13.84723142051139946470, 5.15806185532934710494, -6.02190070693296562579, -0.23995830207102270984, 3.16689704496015257723, -5.20772734719243747037, 11.02642967203790758460, -8.57159370827712494970, 15.44819165989750773833, -9.41401172729772838649, 15.73784401705480003189, -7.48251703452372173583, 12.39399211037233103794, -3.50457447042376272993, 6.56582827550027934359, 0.96534890364009676934, -0.21889884425033096098, 4.47871098568978044813, -6.14011790624858959675, 6.19255920294958622529, -9.64804723118734365067, 5.99485347950701386087, -10.13719455141248492729, 4.24915230067129039507, -8.02807803931030845326, 1.69805793454788396896, -4.39626732008472841839, -0.76773957002173936421, -0.50167092131168374893, -2.45922665785785736912, 2.57095344713910687396, -3.12074549377949547446, 4.18337742450011652245, -2.82300436113156404760, 4.31380612805780751883, -1.84713978591803522579, 3.37818582910166036687, -0.58641757355026651123, 1.92541464841741924810, 0.48869677124544545161, 0.44883937367384612920, 1.07137803661757937871, -0.68164152432542329762, 1.11737446923696740773, -1.25714500722687151334, 0.79894946688881309010, -1.29421084694963834631, 0.34695987990502531677, -0.96136770554524944397, -0.03092319365319298723, -0.46755752785413858839, -0.21241347655137607573, -0.01982897821196226401, -0.19974027016721953582, 0.23479524738182144858, -0.11202977768209851766, 0.26204191829368137780, -0.03969462040581178214, 0.14995882965260219141, -0.01034762438756475488, 0.04009461657603080383}
-
-// Iteration: 15 - 0.0002233724195891352417894 0.0002233724195891352417894
-var coeffs_120 = []float64{-51.60897096443960733286, -100.69971810208044475043, -89.39654493852882597305, -75.38703744870683451649, -51.44083282783233597587, -31.88754117374930341792, -0.60214532105037850801, 17.26248347909055310123, 48.22042407814487636989, 59.48146927688622713936, 81.59132428629484887414, 83.78177332621976111348, 90.67450065689863382712, 86.13090584007553058481, 74.68183365167751742615, 68.16587122925311117915, 40.43575188603016573357, 36.03178600009771438061, -0.43488572137227571080, -0.88693570535178386431, -35.52545671930869280779, -32.58806771798298402450, -55.64677169851973018924, -51.92534895076033460619, -57.98480765641842566369, -55.16666807211266966317, -44.72061881847658116004, -44.31409765913485126784, -22.57099825224920880835, -24.50878733676116323020, 0.71807463082533464381, -3.39968601853910368350, 18.35853280795970777582, 13.22107999872982790277, 27.00938034882121363897, 22.38906551393046637929, 26.52067483042948669191, 23.62349053696368790095, 19.13183454855997343038, 18.71569044644195867787, 8.66412034932524699238, 10.82189229612435526666, -1.06365680110726270289, 2.79279417168513755165, -7.33997783890677781867, -3.37590137140997034221, -9.25575630946159264067, -6.64824815701965375478, -7.69987901637162066905, -7.09951221074146773977, -4.36224750983234775678, -5.26185838495718296342, -1.15905339008267648750, -2.66540999121238953442, 0.97840583979467750960, -0.27956486288616226625, 1.64489761791008525783, 1.09149704340873891356, 1.31147315538221582818, 1.37298907420722802638, 0.65220960283748939799, 0.90469294757910929619, 0.17680453548645594267, 0.30230599271289221369}
-
-// Iteration: 15 - 0.0000179492558910827068078 0.0000179492558910827068304
-var coeffs_121 = []float64{0.74815948175075233390, -7.78407448202550263437, 2.90150654606344119977, -5.99242214873035302890, 1.43148495404234181311, -2.68759303792897336332, -0.25413200475270378999, 1.31703482330726106793, -1.68867362000813107681, 4.77525300059825810591, -2.58649281257093463261, 6.70553384134191272871, -2.72217688362936656353, 6.82455773287393640360, -1.95786583747502743943, 5.37489816635860911460, -0.56656451634213074094, 2.85094000115400470494, 0.88100955063981928473, -0.09223672641923845491, 1.83847778308119729365, -2.66742394623122654549, 2.10117911502843795916, -4.19050355312504905066, 1.73821630035513772948, -4.39770211495339480890, 0.96605975640872315439, -3.47930515817788825423, 0.05787162932099315632, -1.90154521605996523492, -0.69776445640374419770, -0.20807584312485069984, -1.09972376572573590012, 1.12181998319684933515, -1.12942496569365268532, 1.81919693637924545583, -0.88172071616725544480, 1.86949190193670640455, -0.42961849548931525839, 1.46108273118136150370, 0.04583855048006575685, 0.83418227099905832486, 0.40012453486835935131, 0.19100147433422209287, 0.52209315533300187388, -0.30135061174743551869, 0.43479919014105631897, -0.54968385910791981794, 0.23567533008066821731, -0.56040152754629061551, 0.02942201424391033763, -0.41400990649893321215, -0.10726541116563880879, -0.20230419037305762604, -0.13780099490503692112, -0.00849210908377198272, -0.08792546149798711019, 0.10486584838744447487, -0.02154805108436797778, 0.11538926878158138297, 0.00856753381087716045, 0.06371687118753805728, 0.00774116300635452328, 0.01618957411586169068}
-
-// Iteration: 15 - 0.0000887128508276215457782 0.0000887128508276215457833
-var coeffs_122 = []float64{-36.74725262212906251060, 9.95567654236014051065, -63.77382066898780394842, 7.41745036221491665144, -37.39894633384127618492, 3.32720230084262393105, -2.21366457814997866948, -1.47080464809968394451, 31.62538838271619668301, -5.82691464600479310599, 55.64794655341021707964, -8.23061266865428353652, 63.35463882720299671669, -8.65490659682202611201, 54.16015810106794584767, -6.86614794286556707054, 32.40738842399254179283, -3.81008572049572791582, 5.10185751215342214367, -0.01305077743606029576, -19.96221392121986733679, 2.98151880759625103449, -36.10935799137313844462, 4.95003924758897176798, -40.41931331971436905412, 5.47620687794542521416, -33.59256698758629649937, 4.56678884643029821545, -19.65681870150869601282, 2.62202516458800995179, -3.65895102099339511917, 0.53926228941113211470, 9.31750236323384198293, -1.08134787297204113894, 16.82850592464079036248, -2.12175446565953533924, 17.98500680882464187394, -2.37855121577464879859, 14.32638253275271897773, -1.92959715947642491110, 7.92773262738602828817, -1.17695982299294130651, 1.55272474583458682679, -0.39947818961457707325, -3.27463915027862250326, 0.24025593844775629062, -5.44088274964811838420, 0.63089692951866771846, -5.33232816277952523740, 0.68613381593480454988, -3.70265341509654737207, 0.52698309534807767847, -1.67816834867110980124, 0.32409120135632400465, 0.03746791251617882269, 0.06898406186855498075, 0.88458628885910161666, -0.05571045540098006060, 0.96820493732803383562, -0.13788444057418561695, 0.63943805559503500353, -0.08904709783428689995, 0.23185330731435684542, -0.06429058077419148939}
-
-// Iteration: 15 - 0.0000525775437779161919680 0.0000525775437779161929611
-var coeffs_123 = []float64{6.02098818011226088034, -23.32534515307405079440, 11.93436824856822033939, -17.64805664705176022315, 6.45582676463390985937, -7.58312970480216505894, -0.45749463338958128153, 4.16724053275379994763, -6.88285381482912315868, 14.11264018424612437333, -11.22183031216804028737, 19.65134639851877161870, -12.19927069189760529703, 19.99255750321463496812, -9.45754117207794403743, 15.76573600770074527323, -4.07308941181354149893, 8.33714920177955267418, 1.81030856310048363392, -0.35045057874684456538, 6.24746430100760279576, -7.91718989415164760079, 8.23592837419889119361, -12.35641732887017476234, 7.72704105957082312186, -12.91122524500539366823, 5.25520714062509917798, -10.16807344714073650566, 1.82477018616255314311, -5.52349491823877508999, -1.39714691948573487913, -0.56770590105090382122, -3.50907688218729130330, 3.33256633872035468576, -4.22320333648629207958, 5.36114871294651012741, -3.69014707564237398793, 5.49326525783224314560, -2.29583063254034497259, 4.27205845892714562050, -0.57905805632064506169, 2.40998898265208233880, 0.84663053151126221600, 0.53699531036011447740, 1.56851616692210378635, -0.89061520063947853720, 1.54102369499709957520, -1.61612044236832358319, 1.03105207087346790107, -1.65473581677662952926, 0.37637523341295260095, -1.21930259807555266293, -0.12597563782280039922, -0.58063024882019666727, -0.32984595653446994017, -0.00368921898438130786, -0.27337298061141727147, 0.31460845310901988782, -0.13393282110244787578, 0.33292875463321608436, -0.03860053431205049630, 0.18203898182267203141, -0.00703978901880431249, 0.04572960958002712381}
-
-// Iteration: 36 - 0.0000693266639781231589396 0.0000693266639781231589697
-var coeffs_124 = []float64{3.79884278214322917768, 86.37086981529221089067, 6.43150537195299689239, 64.21608815949609904917, 3.64808016070977236859, 26.32310891445311958108, -0.33168992294408667886, -16.43104688212896892169, -4.15628085666272783233, -52.61871203160726995977, -6.38775947897730070652, -73.12426813984704759864, -6.65239572820512165428, -73.83060834057415928820, -5.29520085637105120585, -56.36471281062992398338, -2.59974993956605700806, -27.41384428117065454108, 0.41527747536876050591, 4.68296606952842066439, 3.37606461767377896898, 31.08161866576693370068, 4.86371343733197280504, 45.68910247714372294869, 4.95470537933002265342, 46.28336113845767290149, 3.67159775889372546254, 35.23680271999343422816, 1.60710618224676408689, 17.76909907816173521225, -0.41456615999308591936, -0.02095747351068343409, -2.02160762233183024917, -13.44939252119161290470, -2.68076818053518925246, -19.91519441546146848845, -2.58519637563811564648, -19.67271514918392925611, -1.75659850206220584752, -14.61581056559974024239, -0.79416124936129398741, -7.38746901064747166429, 0.14702829554236118251, -0.62339034503673359208, 0.70112026578390135581, 4.04082254621917264164, 0.99612765477848800744, 5.94773838570982690816, 0.87280274595099605762, 5.54074136144857728094, 0.58034064161964378794, 3.69513301947397384909, 0.25736693147210073857, 1.49664968268656551353, -0.06899637431283223804, -0.21295244829801222547, -0.18512548681329900706, -0.97761649712451685814, -0.23254592410991931921, -1.00167547640303983303, -0.11338336692233036526, -0.57012860728808253002, -0.03978618265604525770, -0.16772521812815004786}
-
-// Iteration: 17 - 0.0000246898004064077090444 0.0000246898004064077091118
-var coeffs_125 = []float64{2.89508130569203715522, -15.11250063302783562985, 6.60270304273867488984, -11.50059592656339302129, 3.56007766195735339073, -5.03439060411774191787, -0.17070494525655582480, 2.59594349916878797560, -3.54209499330027770476, 9.15127467924593395683, -5.81995890055597428307, 12.83906266155914195584, -6.40975653709468431121, 13.07778044188506532222, -5.09606956895773159421, 10.30333360758972687645, -2.36477017260852807588, 5.45698322507626726740, 0.70365390985779800831, -0.16874188030564178575, 3.09914327871458304176, -5.06836965634743713672, 4.25468374267758397015, -7.99572234151544105254, 4.08511956400544075529, -8.42237272998641016655, 2.87266630204181244505, -6.68153011847427133273, 1.11779866280383944953, -3.67302616813324355037, -0.57091905539124989390, -0.43370422519869143277, -1.70948447503607953031, 2.12118195832334242210, -2.14398305422321839937, 3.46631377476172585743, -1.93322086716751700291, 3.58355602486657646145, -1.24834327143580816092, 2.81484752805811276963, -0.37890671606724343040, 1.60892243885052673794, 0.35767453709737036155, 0.38007895645889170407, 0.75115249856113705533, -0.55733520301273181478, 0.77029823753016957247, -1.04199006433399825842, 0.54483120375958852121, -1.07427339516846743101, 0.23308597876792490974, -0.79791010626745514062, -0.03178801479554129217, -0.39358751094747659702, -0.15446842631508316453, -0.02253014538607096209, -0.13993468950238413110, 0.19190263124093602894, -0.07398771587489379088, 0.21782506881822798969, -0.02481924121727733998, 0.12714701146316254590, -0.00591799120567200410, 0.03442128141988135918}
-
-// Iteration: 16 - 0.0000792360687316077982972 0.0000792360687316077986149
-var coeffs_126 = []float64{-26.38372577290693386660, 34.23082171188594225238, -45.75389430808025814836, 25.59157730004074480967, -26.67282665544685045034, 10.83686837993148849021, -1.16240640918070362911, -5.79793874323259835472, 23.27979491558949341658, -20.16660287678829901874, 40.45469781272771419877, -28.53835325091744861051, 45.77016248424805009348, -29.29643085168950340224, 38.66968567692402566412, -23.09603097969523470829, 22.50661060722118406466, -12.20156544736080957037, 2.42281002783757915558, 0.17453914029102304019, -15.47754471420807204186, 11.00083860696938908589, -26.75119083419215348486, 17.55170445292943948036, -29.14070671334534637626, 18.74151707906129157102, -23.82138997690130470363, 15.06647032320116072564, -13.23381160922981570629, 8.43742154134010320915, -1.76414369450834082082, 1.21296248343049503040, 7.57078442656603507542, -4.44084114847216989095, 12.57297614748190966932, -7.58838217320535410036, 13.13145911693508060041, -8.00427747289054504257, 10.11545818147633743256, -6.37418051420969280146, 5.33044477485940954344, -3.73473658396916434735, 0.56006025207972560143, -0.96525977815083993992, -2.73482603097783055454, 1.12097844758026526590, -4.20784704979650464911, 2.24507054591086896979, -3.82128887670317942338, 2.38429269696248758049, -2.56323569425542597450, 1.81444375499116520480, -0.98552122966533838817, 0.90862640719765330798, 0.15664716735593211940, 0.12344536988652984970, 0.71031806451439892195, -0.36181332914555830458, 0.67485686569299441058, -0.46521936788209006819, 0.42992169109380156495, -0.31982362599896549203, 0.13950838786311829202, -0.10456191300177422039}
-
-// Iteration: 15 - 0.0000187701773253654225922 0.0000187701773253654318739
-var coeffs_127 = []float64{1.21200379300265221610, -2.84332465335946037501, 3.72897899915819230676, -2.26896015454546387825, 1.95846927574364425826, -1.09663579989301214072, -0.18470754336082460182, 0.45507459063678139852, -2.09371832867924133247, 1.81946761935368585031, -3.31202771355874454937, 2.56109817103093864954, -3.53200616198208308939, 2.58958818809396883525, -2.64833197167608958241, 2.04822633846480052794, -0.98966578672146811162, 1.08836410761791104941, 0.78530115050900778651, -0.05154598045319980602, 2.06746438485965426967, -1.06150217144447273149, 2.54063365810696069993, -1.63192947116718608765, 2.25798951099398411441, -1.68263964070753576132, 1.40488497527287113107, -1.30064555217189796514, 0.33164689323605556409, -0.69182275273542563708, -0.62603177252533607899, -0.04594751407741777913, -1.20374690386493156747, 0.46201861972068612933, -1.34177434391979182107, 0.70933450364661731032, -1.10736482913944168194, 0.70872637240819553438, -0.61798950806191343040, 0.54402849028153880269, -0.06340782094198920903, 0.30141929582230083348, 0.36925759531618235980, 0.05715968505266826559, 0.55500249272545194399, -0.13255228966712194496, 0.49919138649729373187, -0.22177864075931065751, 0.30459287826843060701, -0.21293895627906959552, 0.07733484529893235764, -0.15308992744644432720, -0.07858146479215731894, -0.07026821028493501356, -0.13419935999796271212, 0.00491799708673222146, -0.09729655882189637116, 0.04963991377513778362, -0.03704359866571498575, 0.04514640098219094213, -0.00016212395026606794, 0.01992476483492094077, 0.00461424649321379899, 0.00238468118287862613}
@@ -0,0 +1,88 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+
+	"app/matrix/softmax"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+)
+
+// Attention is the shape of the multi-head attention for a number of heads
+// and the slots of the parameters, see NewAttention.
+type Attention struct {
+	Heads    int // number of heads, one of 1, 2, 4, 8 or 16
+	HeadCols int // width of a head
+	// Tile of the attention products (see matrix.NewRectMulParameters): each head
+	// is padded to a single square HeadDims x HeadDims block, so that the softmax
+	// sees whole rows, instead of the tile chosen by matrix.TileDims.
+	HeadDims int
+	// Heads per ciphertext after the split (see NewAttention).
+	HeadMatPerCt int
+	KTScaling    float64
+}
+
+// NewAttention returns the shape of the attention with the given number of
+// heads, or an error if it is not supported or if the padded heads of
+// HeadMatPerCt matrices do not fit in the slots of params.
+// Heads narrower than Rows are padded with zero columns to Rows x Rows matrices,
+// and heads wider than Rows (i.e. 1 or 2 heads with Cols=128, Rows=50) with zero
+// rows to HeadCols x HeadCols matrices, whose padded columns of Q x K^T are
+// masked by the encrypted softmax (see softmax.Parameters.Valid).
+// A ciphertext stores the heads of up to NbMatPerCtIn samples if those of a
+// sample fit in the slots and, otherwise, the largest power of two of heads
+// that fits, the heads of a sample then spanning several ciphertexts.
+func NewAttention(params hefloat.Parameters, heads int) (a Attention, err error) {
+
+	if heads < 1 || heads > 16 || heads&(heads-1) != 0 || Cols%heads != 0 {
+		return a, fmt.Errorf("invalid heads: must be one of 1, 2, 4, 8 or 16 and divide Cols=%d but is %d", Cols, heads)
+	}
+
+	a.Heads = heads
+	a.HeadCols = Cols / heads
+	a.HeadDims = max(Rows, a.HeadCols)
+	a.KTScaling = 1 / math.Sqrt(float64(a.HeadCols))
+
+	slots := params.MaxSlots()
+
+	if n := slots / (heads * a.HeadDims * a.HeadDims); n > 0 {
+		a.HeadMatPerCt = min(n, NbMatPerCtIn) * heads
+	} else {
+		h := heads
+		for h > 1 && h*a.HeadDims*a.HeadDims > slots {
+			h >>= 1
+		}
+		a.HeadMatPerCt = h
+	}
+
+	if tot := a.HeadMatPerCt * a.HeadDims * a.HeadDims; tot > slots {
+		return a, fmt.Errorf("invalid heads: HeadMatPerCt * HeadDims^2 = %d > slots = %d", tot, slots)
+	}
+
+	return
+}
+
+// CheckHeads returns an error if the attention with the given
+// number of heads is not supported by params, see NewAttention.
+func CheckHeads(params hefloat.Parameters, heads int) (err error) {
+	_, err = NewAttention(params, heads)
+	return
+}
+
+// NumCts returns the number of ciphertexts of the heads of n samples.
+func (a Attention) NumCts(n int) int {
+	return (n*a.Heads + a.HeadMatPerCt - 1) / a.HeadMatPerCt
+}
+
+// SoftMaxParameters returns SoftMaxParameters where the size of the rows K,
+// the number of valid values of a row and the number of slots are those of
+// the heads, resolved at the time of the call so that the overrides of
+// SoftMaxParameters apply.
+func (a Attention) SoftMaxParameters() softmax.Parameters {
+	p := SoftMaxParameters
+	p.K = a.HeadDims
+	p.Valid = Rows
+	p.ToTVecSize = a.HeadMatPerCt * a.HeadDims * a.HeadDims
+	return p
+}
@@ -0,0 +1,177 @@
+package lib
+
+import (
+	"fmt"
+
+	"app/matrix/minimax"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
+	"github.com/Pro7ech/lattigo/ring"
+)
+
+// Configuration is a parameter set of the scheme: the residual parameters,
+// whose ciphertexts are encrypted at LevelEncryption and bootstrapped to
+// LevelBootstrapping, and the bootstrapping parameters of degree 2^(LogN+1).
+// Unlike NewParametersCustom, its methods return an error instead of
+// panicking and, except the constructors of bootstrappers, do not check the
+// security, so that candidate parameter sets can be enumerated (see
+// server/search).
+type Configuration struct {
+	LogN               int
+	LogScale           int
+	LogQ0              int
+	LogP               []int
+	H                  int // Hamming weight of the residual secret
+	LevelEncryption    int
+	LevelBootstrapping int
+	LogMessageRatio    int // of the bootstrapping, for a bootstrapping ring of degree 2^16
+	RingType           ring.Type
+	Bootstrapping      string // name of the bootstrapping profile, see BootstrappingProfiles
+	Heads              int    // number of attention heads of the model, see Attention
+	SoftMaxSign        string // name of the composite polynomial of sign(x) of the max of the softmax, see SetSignPolynomials
+	ReLUSign           string // name of the composite polynomial of sign(x) of the ReLU, see SetSignPolynomials
+	PolynomialsDir     string // directory searched for the composite polynomials before matrix/minimax/polynomials
+}
+
+// DefaultConfiguration returns the parameter set of NewParameters and NewBootstrappingParameters.
+func DefaultConfiguration() Configuration {
+	return Configuration{
+		LogN:               LogN,
+		LogScale:           LogScale,
+		LogQ0:              LogQ0,
+		LogP:               LogP,
+		H:                  Xs.H,
+		LevelEncryption:    LevelEncryption,
+		LevelBootstrapping: LevelBootstrapping,
+		LogMessageRatio:    LogMessageRatio,
+		RingType:           ring.ConjugateInvariant,
+		Bootstrapping:      BootstrappingProfileName,
+		Heads:              DefaultHeads,
+		SoftMaxSign:        MaxSign.Name(),
+		ReLUSign:           ReLUSign.Name(),
+	}
+}
+
+// ParametersLiteral returns the literal of the residual parameters at the given level.
+func (c Configuration) ParametersLiteral(level int) hefloat.ParametersLiteral {
+
+	LogQ := make([]int, level+1)
+	LogQ[0] = c.LogQ0
+	for i := 1; i < level+1; i++ {
+		LogQ[i] = c.LogScale
+	}
+
+	return hefloat.ParametersLiteral{
+		LogN:            c.LogN,
+		LogQ:            LogQ,
+		LogP:            c.LogP,
+		LogDefaultScale: c.LogScale,
+		RingType:        c.RingType,
+		LogNthRoot:      c.LogN + 2, // primes are NTT-friendly in the bootstrapping ring of degree 2^(LogN+1)
+		Xs:              &ring.Ternary{H: c.H},
+	}
+}
+
+// Parameters returns the residual parameters at the given level.
+func (c Configuration) Parameters(level int) (params hefloat.Parameters, err error) {
+	if params, err = hefloat.NewParametersFromLiteral(c.ParametersLiteral(level)); err != nil {
+		return params, fmt.Errorf("[hefloat].NewParametersFromLiteral: %w", err)
+	}
+	return
+}
+
+// Attention returns the shape of the attention with c.Heads heads for params,
+// which must be residual parameters of c.
+func (c Configuration) Attention(params hefloat.Parameters) (a Attention, err error) {
+	return NewAttention(params, c.Heads)
+}
+
+// BootstrappingParametersLiteral returns the literal of the bootstrapping
+// parameters. The bootstrapping ring is of degree 2^(LogN+1), reached by
+// ring-swap from the ConjugateInvariant ring and by key-switching from the
+// standard ring, whose 2^(LogN-1) complex slots are all bootstrapped.
+// The DFTs, the modular reduction and the iterations are those of the
+// profile c.Bootstrapping.
+func (c Configuration) BootstrappingParametersLiteral() (literal bootstrapping.ParametersLiteral, err error) {
+
+	var profile BootstrappingProfile
+	if profile, err = GetBootstrappingProfile(c.Bootstrapping); err != nil {
+		return
+	}
+
+	literal = bootstrapping.NewParametersLiteral()
+	literal.LogN = c.LogN + 1
+	literal.LogSlots = c.LogN
+
+	if c.RingType == ring.Standard {
+		literal.LogSlots = c.LogN - 1
+	}
+
+	literal.LogMessageRatio = c.LogMessageRatio + 16 - literal.LogN
+	literal.Xs = XsN16
+
+	profile.Apply(&literal)
+
+	return
+}
+
+// BootstrappingParameters returns the bootstrapping parameters, whose
+// residual parameters are at LevelBootstrapping.
+func (c Configuration) BootstrappingParameters() (btpParams bootstrapping.Parameters, err error) {
+
+	var params hefloat.Parameters
+	if params, err = c.Parameters(c.LevelBootstrapping); err != nil {
+		return
+	}
+
+	var literal bootstrapping.ParametersLiteral
+	if literal, err = c.BootstrappingParametersLiteral(); err != nil {
+		return
+	}
+
+	if btpParams, err = bootstrapping.NewParametersFromLiteral(params, literal); err != nil {
+		return btpParams, fmt.Errorf("[bootstrapping].NewParametersFromLiteral: %w", err)
+	}
+
+	return
+}
+
+// SignPolynomials loads the composite polynomials c.SoftMaxSign and
+// c.ReLUSign, see minimax.Load.
+func (c Configuration) SignPolynomials() (softMaxSign, reluSign minimax.Polynomial, err error) {
+
+	if softMaxSign, err = minimax.Load(c.PolynomialsDir, c.SoftMaxSign); err != nil {
+		return softMaxSign, reluSign, fmt.Errorf("[minimax].Load: %w", err)
+	}
+
+	if reluSign, err = minimax.Load(c.PolynomialsDir, c.ReLUSign); err != nil {
+		return softMaxSign, reluSign, fmt.Errorf("[minimax].Load: %w", err)
+	}
+
+	return
+}
+
+// SetSignPolynomials sets the coefficients of SoftMaxParameters and of
+// ReLUParameters to the composite polynomials c.SoftMaxSign and c.ReLUSign,
+// so that they apply to the circuit as the other overrides of the parameters.
+func (c Configuration) SetSignPolynomials() (err error) {
+
+	var softMaxSign, reluSign minimax.Polynomial
+	if softMaxSign, reluSign, err = c.SignPolynomials(); err != nil {
+		return
+	}
+
+	SoftMaxParameters.MaxParameters.CoeffsString = softMaxSign.CoeffsString()
+	SoftMaxParameters.MaxParameters.CoeffsFloat = softMaxSign.CoeffsFloat()
+
+	ReLUParameters.CoeffsString = reluSign.CoeffsString()
+	ReLUParameters.CoeffsFloat = reluSign.CoeffsFloat()
+
+	return
+}
+
+func (c Configuration) String() string {
+	return fmt.Sprintf("{LogN=%d, LogScale=%d, LogQ0=%d, LogP=%v, H=%d, Levels=%d/%d, LogMessageRatio=%d, Bootstrapping=%s, Heads=%d, Sign=%s/%s}",
+		c.LogN, c.LogScale, c.LogQ0, c.LogP, c.H, c.LevelEncryption, c.LevelBootstrapping, c.LogMessageRatio, c.Bootstrapping, c.Heads, c.SoftMaxSign, c.ReLUSign)
+}
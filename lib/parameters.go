@@ -1,14 +1,25 @@
 package lib
 
 import (
-	"math"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
 	"runtime"
 
 	btp "app/bootstrapping"
+	"app/keys"
+	"app/matrix/activation"
+	"app/matrix/argmax"
+	"app/matrix/minimax"
 	"app/matrix/normalization"
 	"app/matrix/relu"
 	"app/matrix/softmax"
 	"app/matrix/softmax/innermax"
+	"app/matrix/softmax/output"
+	"app/refresh"
+	"app/sanitize"
+	"app/security"
 
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
@@ -22,10 +33,12 @@ const (
 	Rows          = 50
 	Cols          = 128
 	Classes       = 25
-	Split         = 4
 	NbMatPerCtIn  = 3
 	NbMatPerCtOut = 3
 
+	// Attention
+	DefaultHeads = 4 // number of heads of the model in weights/, see Configuration.Heads and Attention
+
 	// Embedding
 	E = 1 / (2048.0 - 512.0)
 	K = 23.0
@@ -48,6 +61,12 @@ const (
 	MaxConcurrentGaloisKeys = 212
 )
 
+// Minimax composite polynomials of sign(x), see matrix/minimax.
+var (
+	MaxSign  = minimax.MustLoad("sign_p512_a6_e15_d31-31")
+	ReLUSign = minimax.MustLoad("sign_p512_a10_e15_d255-63")
+)
+
 /*
 ======== Samples
 SoftMaxApproximate: -14.846192 14.617589
@@ -55,6 +74,9 @@ SoftMaxExact: -14.846256 14.616425
 ======== Fuzzing
 SoftMaxApproximate: -25.143211 22.050325
 */
+// SoftMaxParameters are the parameters of the softmax of the attention, whose
+// K, Valid and ToTVecSize depend on the number of heads and are set by
+// Attention.SoftMaxParameters.
 var SoftMaxParameters = softmax.Parameters{
 	ExpMin:      -50.0,
 	ExpMax:      2.0,
@@ -63,18 +85,10 @@ var SoftMaxParameters = softmax.Parameters{
 	InvMax:      50,
 	InvDeg:      31,
 	InvSqrtIter: 2,
-	K:           Rows,
-	ToTVecSize:  NbMatPerCtIn * Rows * Rows * Split,
 	MaxParameters: innermax.Parameters{
-		AbsMax: 60,
-		CoeffsString: [][]string{
-			{"0", "0.864988119247", "0", "-0.288377920222", "0", "0.173071422437", "0", "-0.123816194144", "0", "0.096394642789", "0", "-0.078902169290", "0", "0.066945006659", "0", "-0.058020100531", "0", "0.051332266735", "0", "-0.046482123811", "0", "0.042138711193", "0", "-0.038528692000", "0", "0.035978793808", "0", "-0.033437153812", "0", "0.031680300044", "0", "-0.335319740231"},
-			{"0", "1.266504742898", "0", "-0.404596922995", "0", "0.222869371220", "0", "-0.139872558754", "0", "0.091346906097", "0", "-0.059846068329", "0", "0.038558545509", "0", "-0.024103625427", "0", "0.014456545301", "0", "-0.008227671984", "0", "0.004387825299", "0", "-0.002157400000", "0", "0.000955384598", "0", "-0.000366901589", "0", "0.000113649172", "0", "-0.000023655795"},
-		},
-		CoeffsFloat: [][]float64{
-			{0, 0.864988119247, 0, -0.288377920222, 0, 0.173071422437, 0, -0.123816194144, 0, 0.096394642789, 0, -0.078902169290, 0, 0.066945006659, 0, -0.058020100531, 0, 0.051332266735, 0, -0.046482123811, 0, 0.042138711193, 0, -0.038528692000, 0, 0.035978793808, 0, -0.033437153812, 0, 0.031680300044, 0, -0.335319740231},
-			{0, 1.266504742898, 0, -0.404596922995, 0, 0.222869371220, 0, -0.139872558754, 0, 0.091346906097, 0, -0.059846068329, 0, 0.038558545509, 0, -0.024103625427, 0, 0.014456545301, 0, -0.008227671984, 0, 0.004387825299, 0, -0.002157400000, 0, 0.000955384598, 0, -0.000366901589, 0, 0.000113649172, 0, -0.000023655795},
-		},
+		AbsMax:       60,
+		CoeffsString: MaxSign.CoeffsString(),
+		CoeffsFloat:  MaxSign.CoeffsFloat(),
 	},
 }
 
@@ -92,6 +106,7 @@ var Norm1Parameters = normalization.Parameters{
 	InvSqrtIter:    1,
 	BootstrapAfter: true,
 	ToTVecSize:     NbMatPerCtIn * Rows * Cols,
+	Epsilon:        1e-6,
 }
 
 /*
@@ -109,19 +124,102 @@ var Norm2Parameters = normalization.Parameters{
 	BootstrapBefore: true,
 	BootstrapAfter:  true,
 	ToTVecSize:      NbMatPerCtIn * Rows * Cols,
+	Epsilon:         1e-6,
 }
 
-// hefloat.GenMinimaxCompositePolynomial(512, 10, 15, []int{255, 63}, bignum.Sign)
 var ReLUParameters = relu.Parameters{
-	CoeffsFloat: [][]float64{
-		{0, 0.762624277144258, 0, -0.254211515271906, 0, 0.152531081324984, 0, -0.108955197152342, 0, 0.084747470549085, 0, -0.069343539898539, 0, 0.058680122421113, 0, -0.050860920656460, 0, 0.044882102944092, 0, -0.040162591936501, 0, 0.036342567537934, 0, -0.033187287163105, 0, 0.030537242627738, 0, -0.028280254591926, 0, 0.026334964806040, 0, -0.024640953695875, 0, 0.023152581142191, 0, -0.021834685992973, 0, 0.020659571285113, 0, -0.019605185886683, 0, 0.018653924354774, 0, -0.017791479861489, 0, 0.017005944397341, 0, -0.016287427012307, 0, 0.015627793892036, 0, -0.015020185138093, 0, 0.014458641481989, 0, -0.013938080246983, 0, 0.013454256086254, 0, -0.013003489160657, 0, 0.012582469889852, 0, -0.012188310772315, 0, 0.011818584714956, 0, -0.011471169358299, 0, 0.011144082208602, 0, -0.010835528004918, 0, 0.010544027490451, 0, -0.010268316272171, 0, 0.010007117380252, 0, -0.009759211324878, 0, 0.009523676838757, 0, -0.009299758927712, 0, 0.009086557598268, 0, -0.008883182776046, 0, 0.008689079447000, 0, -0.008503801958784, 0, 0.008326650096089, 0, -0.008156939797580, 0, 0.007994374502078, 0, -0.007838702698588, 0, 0.007689320685736, 0, -0.007545679774350, 0, 0.007407690391034, 0, -0.007275219515252, 0, 0.007147680309141, 0, -0.007024640549262, 0, 0.006906201634427, 0, -0.006792260548964, 0, 0.006682197924732, 0, -0.006575738409800, 0, 0.006473137378112, 0, -0.006374219070479, 0, 0.006278355690561, 0, -0.006185476549591, 0, 0.006095892798779, 0, -0.006009302100837, 0, 0.005925155423643, 0, -0.005843571689380, 0, 0.005764804336032, 0, -0.005688454663122, 0, 0.005614123364796, 0, -0.005542042188997, 0, 0.005472350447218, 0, -0.005404619815629, 0, 0.005338612481341, 0, -0.005274605927879, 0, 0.005212614485580, 0, -0.005152213176345, 0, 0.005093332218960, 0, -0.005036266451860, 0, 0.004980871936824, 0, -0.004926756919342, 0, 0.004874054720733, 0, -0.004823003247109, 0, 0.004773279625942, 0, -0.004724636835620, 0, 0.004677371100699, 0, -0.004631532976605, 0, 0.004586718771423, 0, -0.004542924408592, 0, 0.004500464831644, 0, -0.004459148885465, 0, 0.004418656087280, 0, -0.004379222425400, 0, 0.004340998783801, 0, -0.004303621081644, 0, 0.004267029785679, 0, -0.004231542119279, 0, 0.004197003873487, 0, -0.004163122004199, 0, 0.004130141881589, 0, -0.004098179933047, 0, 0.004066875633355, 0, -0.004036214250760, 0, 0.004006525553240, 0, -0.003977678630022, 0, 0.003949330875258, 0, -0.003921649808488, 0, 0.003894931300166, 0, -0.003868917177509, 0, 0.003843296648700, 0, -0.003818374760630, 0, 0.003794371286562, 0, -0.003770887286012, 0, 0.003747838810980, 0, -0.003725605666276, 0, 0.003703991432474, 0, -0.003682735746915, 0, 0.003662325133773, 0, -0.003642658276935, 0, 0.003622986831546, 0, -0.003603900452643, 0, 0.003586173102685, 0, -0.003568815850615, 0, 0.003551081703711, 0, -0.003533777979210, 0, 0.003518491264333, 0, -0.402770163388345},
-		{0, 1.271394952644717, 0, -0.418908924174358, 0, 0.245571575138300, 0, -0.169385755345298, 0, 0.125729582605323, 0, -0.097009562066101, 0, 0.076478923882815, 0, -0.061000626530583, 0, 0.048918551516010, 0, -0.039273241583373, 0, 0.031466063228896, 0, -0.025099035277211, 0, 0.019892095009181, 0, -0.015637742316596, 0, 0.012175062649524, 0, -0.009374380172474, 0, 0.007127999698664, 0, -0.005344544030635, 0, 0.003945451102087, 0, -0.002862771540468, 0, 0.002037737358615, 0, -0.001419770737502, 0, 0.000965726103163, 0, -0.000639239547602, 0, 0.000410113532089, 0, -0.000253701067931, 0, 0.000150277688521, 0, -0.000084404781936, 0, 0.000044296653679, 0, -0.000021207592430, 0, 0.000008855556142, 0, -0.000003056946315},
+	CoeffsFloat:  ReLUSign.CoeffsFloat(),
+	CoeffsString: ReLUSign.CoeffsString(),
+	AbsMax:       60,
+}
+
+// FNNActivation is the activation of the FNN, with Type one of
+// activation.ReLU, activation.GELU, activation.SiLU or activation.Polynomial.
+// The parameters of activation.ReLU are those of ReLUParameters, see
+// FNNActivationParameters.
+var FNNActivation = activation.Parameters{
+	Type:   activation.ReLU,
+	AbsMax: 60,
+	Deg:    255,
+}
+
+// FNNActivationParameters returns FNNActivation where, for activation.ReLU,
+// ReLU and AbsMax are resolved to ReLUParameters at the time of the call, so
+// that the overrides of ReLUParameters apply to the FNN.
+func FNNActivationParameters() activation.Parameters {
+	p := FNNActivation
+	if p.Type == activation.ReLU {
+		p.ReLU = ReLUParameters
+		p.AbsMax = ReLUParameters.AbsMax
+	}
+	return p
+}
+
+/*
+======== Calibration (margin=0.10)
+Logits: -33.190077 29.750829
+LogitsSpread: 12.042479 50.091572
+OutputSoftMaxExp: -50.091456 0.111367
+OutputSoftMaxNorm: 0.999965 6.145148
+*/
+// OutputSoftMaxParameters is the optional final stage that
+// replaces the logits by the class probabilities.
+// The padding is Min, so AbsMax must be at least Max - Min
+// and ExpMin at most Min - Max.
+var OutputSoftMaxParameters = output.Parameters{
+	K:      Classes,
+	Stride: Cols,
+	ExpMin: -77,
+	ExpMax: 2,
+	ExpDeg: 63,
+	InvMin: 0.5,
+	InvMax: 64,
+	InvDeg: 63,
+	Min:    -40,
+	Max:    37,
+	MaxParameters: innermax.Parameters{
+		AbsMax:       77,
+		CoeffsString: MaxSign.CoeffsString(),
+		CoeffsFloat:  MaxSign.CoeffsFloat(),
 	},
-	CoeffsString: [][]string{
-		{"0", "0.762624277144258", "0", "-0.254211515271906", "0", "0.152531081324984", "0", "-0.108955197152342", "0", "0.084747470549085", "0", "-0.069343539898539", "0", "0.058680122421113", "0", "-0.050860920656460", "0", "0.044882102944092", "0", "-0.040162591936501", "0", "0.036342567537934", "0", "-0.033187287163105", "0", "0.030537242627738", "0", "-0.028280254591926", "0", "0.026334964806040", "0", "-0.024640953695875", "0", "0.023152581142191", "0", "-0.021834685992973", "0", "0.020659571285113", "0", "-0.019605185886683", "0", "0.018653924354774", "0", "-0.017791479861489", "0", "0.017005944397341", "0", "-0.016287427012307", "0", "0.015627793892036", "0", "-0.015020185138093", "0", "0.014458641481989", "0", "-0.013938080246983", "0", "0.013454256086254", "0", "-0.013003489160657", "0", "0.012582469889852", "0", "-0.012188310772315", "0", "0.011818584714956", "0", "-0.011471169358299", "0", "0.011144082208602", "0", "-0.010835528004918", "0", "0.010544027490451", "0", "-0.010268316272171", "0", "0.010007117380252", "0", "-0.009759211324878", "0", "0.009523676838757", "0", "-0.009299758927712", "0", "0.009086557598268", "0", "-0.008883182776046", "0", "0.008689079447000", "0", "-0.008503801958784", "0", "0.008326650096089", "0", "-0.008156939797580", "0", "0.007994374502078", "0", "-0.007838702698588", "0", "0.007689320685736", "0", "-0.007545679774350", "0", "0.007407690391034", "0", "-0.007275219515252", "0", "0.007147680309141", "0", "-0.007024640549262", "0", "0.006906201634427", "0", "-0.006792260548964", "0", "0.006682197924732", "0", "-0.006575738409800", "0", "0.006473137378112", "0", "-0.006374219070479", "0", "0.006278355690561", "0", "-0.006185476549591", "0", "0.006095892798779", "0", "-0.006009302100837", "0", "0.005925155423643", "0", "-0.005843571689380", "0", "0.005764804336032", "0", "-0.005688454663122", "0", "0.005614123364796", "0", "-0.005542042188997", "0", "0.005472350447218", "0", "-0.005404619815629", "0", "0.005338612481341", "0", "-0.005274605927879", "0", "0.005212614485580", "0", "-0.005152213176345", "0", "0.005093332218960", "0", "-0.005036266451860", "0", "0.004980871936824", "0", "-0.004926756919342", "0", "0.004874054720733", "0", "-0.004823003247109", "0", "0.004773279625942", "0", "-0.004724636835620", "0", "0.004677371100699", "0", "-0.004631532976605", "0", "0.004586718771423", "0", "-0.004542924408592", "0", "0.004500464831644", "0", "-0.004459148885465", "0", "0.004418656087280", "0", "-0.004379222425400", "0", "0.004340998783801", "0", "-0.004303621081644", "0", "0.004267029785679", "0", "-0.004231542119279", "0", "0.004197003873487", "0", "-0.004163122004199", "0", "0.004130141881589", "0", "-0.004098179933047", "0", "0.004066875633355", "0", "-0.004036214250760", "0", "0.004006525553240", "0", "-0.003977678630022", "0", "0.003949330875258", "0", "-0.003921649808488", "0", "0.003894931300166", "0", "-0.003868917177509", "0", "0.003843296648700", "0", "-0.003818374760630", "0", "0.003794371286562", "0", "-0.003770887286012", "0", "0.003747838810980", "0", "-0.003725605666276", "0", "0.003703991432474", "0", "-0.003682735746915", "0", "0.003662325133773", "0", "-0.003642658276935", "0", "0.003622986831546", "0", "-0.003603900452643", "0", "0.003586173102685", "0", "-0.003568815850615", "0", "0.003551081703711", "0", "-0.003533777979210", "0", "0.003518491264333", "0", "-0.402770163388345"},
-		{"0", "1.271394952644717", "0", "-0.418908924174358", "0", "0.245571575138300", "0", "-0.169385755345298", "0", "0.125729582605323", "0", "-0.097009562066101", "0", "0.076478923882815", "0", "-0.061000626530583", "0", "0.048918551516010", "0", "-0.039273241583373", "0", "0.031466063228896", "0", "-0.025099035277211", "0", "0.019892095009181", "0", "-0.015637742316596", "0", "0.012175062649524", "0", "-0.009374380172474", "0", "0.007127999698664", "0", "-0.005344544030635", "0", "0.003945451102087", "0", "-0.002862771540468", "0", "0.002037737358615", "0", "-0.001419770737502", "0", "0.000965726103163", "0", "-0.000639239547602", "0", "0.000410113532089", "0", "-0.000253701067931", "0", "0.000150277688521", "0", "-0.000084404781936", "0", "0.000044296653679", "0", "-0.000021207592430", "0", "0.000008855556142", "0", "-0.000003056946315"},
+}
+
+/*
+======== Calibration (margin=0.10)
+Logits: -33.190077 29.750829
+*/
+// ArgmaxParameters is the optional final stage that replaces the
+// logits by the one-hot indicator of the TopK largest logits.
+// The padding is Min, so AbsMax must be at least Max - Min, and
+// Threshold/AbsMax must be resolved by the comparison polynomial
+// (2^-10 for ReLUSign).
+var ArgmaxParameters = argmax.Parameters{
+	K:         Classes,
+	Stride:    Cols,
+	TopK:      1,
+	Threshold: 1.0 / 8,
+	Min:       -40,
+	Max:       37,
+	MaxParameters: innermax.Parameters{
+		AbsMax:       77,
+		CoeffsString: ReLUSign.CoeffsString(),
+		CoeffsFloat:  ReLUSign.CoeffsFloat(),
 	},
-	AbsMax: 60,
+}
+
+// SanitizeParameters are the parameters of the optional noise flooding of the
+// one-hot output of the argmax. It is bootstrapped and binarized (see
+// sanitize.Sanitizer.Binarize) at the scale 2^(2*LogScale), which brings the
+// noise below 2^(LogNoiseBound) times the scale, and is then flooded at the
+// level Level, whose modulus holds the 2^(Lambda + LogNoiseBound + 2*LogScale)
+// of the flooding. The flooding adds an error of standard deviation
+// ~2^(Lambda + LogNoiseBound + LogN/2) = 2^-8.5 per slot, measured at
+// 2^-8.3 on the argmax of the test set (see test/16_sanitize_test.go).
+var SanitizeParameters = sanitize.Parameters{
+	Lambda:        sanitize.MinimumLambda,
+	LogNoiseBound: -56,
+	Level:         2,
+	Rounds:        2,
 }
 
 var (
@@ -130,11 +228,9 @@ var (
 
 	NumCPU = min(runtime.NumCPU(), 4)
 
-	LogP      = []int{58, 58, 58}
-	KTScaling = 1 / math.Sqrt(float64(Cols/Split))
+	LogP = []int{58, 58, 58}
 
-	Padding = Rows - Cols/Split
-	Xs      = ring.Ternary{H: 192}
+	Xs = ring.Ternary{H: 192}
 
 	LogMessageRatio = 9
 	LogPN16 = []int{61, 61, 61, 61, 61}
@@ -144,55 +240,276 @@ var (
 	NumCts    = (NbSamples + NbMatPerCtIn - 1) / NbMatPerCtIn
 )
 
+// RefreshParameters are the parameters of the client-aided refresh. The values
+// at the input of the bootstrappings stay below 2^9 (see the calibration ranges),
+// so that the masks have Lambda+LogMessage+LogScale = 94 bits and the minimum
+// input level is 1.
+var RefreshParameters = refresh.Parameters{
+	Lambda:     40,
+	LogMessage: 9,
+}
+
+// MinimumSecurity is the target security level (in bits) checked against the
+// table of known-secure bounds (see package security) whenever parameters are
+// instantiated. Below it, the instantiation panics, unless AllowInsecureParameters
+// is set, in which case it only prints a warning.
+var (
+	MinimumSecurity         = 128.0
+	AllowInsecureParameters = false
+)
+
+func CheckSecurity(params hefloat.Parameters) {
+	if err := checkSecurity(params); err != nil {
+		panic(err)
+	}
+}
+
+// checkSecurity is CheckSecurity returning an error instead of panicking.
+func checkSecurity(params hefloat.Parameters) (err error) {
+	if err = security.Check(params, MinimumSecurity); err != nil {
+		if !AllowInsecureParameters {
+			return
+		}
+		fmt.Printf("WARNING: insecure parameters: %s\n", err)
+	}
+	return nil
+}
+
+// NewSanitizer returns the sanitizer of SanitizeParameters and prints a warning
+// if SanitizeParameters does not provide circuit privacy (see
+// sanitize.Parameters.CheckLambda).
+func NewSanitizer(params hefloat.Parameters, pk *rlwe.PublicKey) *sanitize.Sanitizer {
+	if err := SanitizeParameters.CheckLambda(); err != nil {
+		fmt.Printf("WARNING: insecure sanitization: %s\n", err)
+	}
+	return sanitize.NewSanitizer(params, SanitizeParameters, pk)
+}
+
 func NewParameters() hefloat.Parameters {
 	return NewParametersCustom(LogN, LevelEncryption)
 }
 
 func NewParametersCustom(LogN, level int) hefloat.Parameters {
-	LogQ := make([]int, level+1)
-	LogQ[0] = LogQ0
-	for i := 1; i < level+1; i++ {
-		LogQ[i] = LogScale
-	}
+	return NewParametersCustomRing(LogN, level, ring.ConjugateInvariant)
+}
+
+// NewParametersCustomRing returns the parameters of NewParametersCustom
+// on the given ring type.
+func NewParametersCustomRing(LogN, level int, ringType ring.Type) hefloat.Parameters {
+
+	c := DefaultConfiguration()
+	c.LogN = LogN
+	c.RingType = ringType
 
-	params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
-		LogN:            LogN,
-		LogQ:            LogQ,
-		LogP:            LogP,
-		LogDefaultScale: LogScale,
-		RingType:        ring.ConjugateInvariant,
-		Xs:              &Xs,
-	})
+	params, err := c.Parameters(level)
 
 	if err != nil {
 		panic(err)
 	}
 
+	CheckSecurity(params)
+
 	return params
 }
 
 func NewBootstrappingParameters(LogN int) bootstrapping.Parameters {
+	return NewBootstrappingParametersRing(LogN, ring.ConjugateInvariant)
+}
+
+// NewBootstrappingParametersRing returns the bootstrapping parameters of
+// residual parameters of degree 2^LogN on the given ring type, see
+// [Configuration.BootstrappingParametersLiteral].
+func NewBootstrappingParametersRing(LogN int, ringType ring.Type) bootstrapping.Parameters {
 
-	BootstrappingParametersLiteral := bootstrapping.NewParametersLiteral()
-	BootstrappingParametersLiteral.LogN = LogN+1
-	BootstrappingParametersLiteral.LogSlots = LogN
-	BootstrappingParametersLiteral.LogMessageRatio = LogMessageRatio + 16 - BootstrappingParametersLiteral.LogN
-	BootstrappingParametersLiteral.LogP = LogPN16
-	BootstrappingParametersLiteral.Xs = XsN16
+	c := DefaultConfiguration()
+	c.LogN = LogN
+	c.RingType = ringType
 
-	btpParams, err := bootstrapping.NewParametersFromLiteral(NewParametersCustom(LogN, LevelBootstrapping), BootstrappingParametersLiteral)
+	btpParams, err := c.BootstrappingParameters()
 	if err != nil {
 		panic(err)
 	}
-	
+
+	CheckSecurity(btpParams.ResidualParameters)
+	CheckSecurity(btpParams.BootstrappingParameters)
+
 	return btpParams
 }
 
+// bootstrappingParametersOf returns the bootstrapping parameters of c for the
+// degree and the ring type of params, after checking their security as
+// CheckSecurity but returning an error instead of panicking.
+func (c Configuration) bootstrappingParametersOf(params hefloat.Parameters) (btpParams bootstrapping.Parameters, err error) {
+
+	c.LogN = params.LogN()
+	c.RingType = params.RingType()
+
+	if btpParams, err = c.BootstrappingParameters(); err != nil {
+		return
+	}
+
+	for _, p := range []hefloat.Parameters{btpParams.ResidualParameters, btpParams.BootstrappingParameters} {
+		if err = checkSecurity(p); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// NewBootstrapper returns the bootstrapper of the profile BootstrappingProfileName
+// for the residual parameters params, see [Configuration.NewBootstrapper].
 func NewBootstrapper(params hefloat.Parameters, sk *rlwe.SecretKey) *btp.Bootstrapper {
-	btpParams := NewBootstrappingParameters(params.LogN())
+	bootstrapper, err := DefaultConfiguration().NewBootstrapper(params, sk)
+	if err != nil {
+		panic(err)
+	}
+	return bootstrapper
+}
+
+// NewBootstrapper returns the bootstrapper of the profile c.Bootstrapping
+// for the residual parameters params, see CheckBootstrapping.
+func (c Configuration) NewBootstrapper(params hefloat.Parameters, sk *rlwe.SecretKey) (bootstrapper *btp.Bootstrapper, err error) {
+
+	var btpParams bootstrapping.Parameters
+	if btpParams, err = c.bootstrappingParametersOf(params); err != nil {
+		return
+	}
+
+	if err = CheckBootstrapping(params, btpParams, c.LevelBootstrapping); err != nil {
+		return
+	}
+
 	return btp.NewBootstrapper(NumCPU, btpParams, sk)
 }
 
+// Files of the bootstrapping keys: the secret keys stay in the directory of the
+// client and the evaluation keys go in the directory of the server, see
+// GenBootstrappingKeys and LoadBootstrapper.
+const (
+	BootstrappingKeysFile       = "btp.evk"
+	BootstrappingSecretKeysFile = "btp.sk"
+)
+
+// GenBootstrappingKeys returns the secret key of params stored in clientDir
+// for the profile BootstrappingProfileName, see [Configuration.GenBootstrappingKeys].
+func GenBootstrappingKeys(params hefloat.Parameters, clientDir, serverDir string) *rlwe.SecretKey {
+	sk, err := DefaultConfiguration().GenBootstrappingKeys(params, clientDir, serverDir)
+	if err != nil {
+		panic(err)
+	}
+	return sk
+}
+
+// GenBootstrappingKeys returns the secret key of params stored in clientDir,
+// after checking that serverDir stores the bootstrapping keys generated under
+// it. If clientDir stores none, it generates a secret key and the bootstrapping
+// keys of the profile c.Bootstrapping under it, and stores the secret
+// keys in clientDir and the bootstrapping keys in serverDir, see
+// keys.StoreBootstrappingKeys. The keys are generated once by the client and
+// loaded by the server at each run with LoadBootstrapper.
+func (c Configuration) GenBootstrappingKeys(params hefloat.Parameters, clientDir, serverDir string) (sk *rlwe.SecretKey, err error) {
+
+	var btpParams bootstrapping.Parameters
+	if btpParams, err = c.bootstrappingParametersOf(params); err != nil {
+		return
+	}
+
+	var skN2 *rlwe.SecretKey
+	sk, skN2, err = keys.LoadBootstrappingSecretKeys(filepath.Join(clientDir, BootstrappingSecretKeysFile), btpParams)
+
+	if err == nil {
+
+		if sk.LevelQ() != params.MaxLevelQ() {
+			return nil, fmt.Errorf("invalid secret key: level %d != %d", sk.LevelQ(), params.MaxLevelQ())
+		}
+
+		// Only the relinearization key is read, not the Galois keys, which
+		// the server checks when it loads them.
+		if err = keys.CheckBootstrappingKeysFile(filepath.Join(serverDir, BootstrappingKeysFile), btpParams, skN2); err != nil {
+			return nil, err
+		}
+
+		return
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	sk = rlwe.NewKeyGenerator(params).GenSecretKeyNew()
+
+	var evk *bootstrapping.EvaluationKeys
+	if evk, skN2, err = btp.GenEvaluationKeys(NumCPU, sk, btpParams); err != nil {
+		return nil, err
+	}
+
+	if err = keys.StoreBootstrappingKeys(filepath.Join(serverDir, BootstrappingKeysFile), btpParams, evk); err != nil {
+		return nil, err
+	}
+
+	if err = keys.StoreBootstrappingSecretKeys(filepath.Join(clientDir, BootstrappingSecretKeysFile), btpParams, sk, skN2); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// LoadBootstrapper returns the bootstrapper of NewBootstrapper with the keys
+// stored in serverDir by GenBootstrappingKeys.
+func LoadBootstrapper(params hefloat.Parameters, serverDir string) *btp.Bootstrapper {
+	bootstrapper, err := DefaultConfiguration().LoadBootstrapper(params, serverDir)
+	if err != nil {
+		panic(err)
+	}
+	return bootstrapper
+}
+
+// LoadBootstrapper returns the bootstrapper of c.NewBootstrapper with the
+// keys stored in serverDir by c.GenBootstrappingKeys.
+func (c Configuration) LoadBootstrapper(params hefloat.Parameters, serverDir string) (bootstrapper *btp.Bootstrapper, err error) {
+
+	var btpParams bootstrapping.Parameters
+	if btpParams, err = c.bootstrappingParametersOf(params); err != nil {
+		return
+	}
+
+	if err = CheckBootstrapping(params, btpParams, c.LevelBootstrapping); err != nil {
+		return
+	}
+
+	var evk *bootstrapping.EvaluationKeys
+	if evk, err = keys.LoadBootstrappingKeys(filepath.Join(serverDir, BootstrappingKeysFile), btpParams); err != nil {
+		return
+	}
+
+	return btp.NewBootstrapperFromKeys(NumCPU, btpParams, evk)
+}
+
+func NewInteractiveBootstrapper(params hefloat.Parameters, transport refresh.Transport) *btp.Bootstrapper {
+	return btp.NewInteractiveBootstrapper(NumCPU, NewParametersCustom(params.LogN(), LevelBootstrapping), RefreshParameters, transport)
+}
+
 func NewDummyBootstrapper(params hefloat.Parameters, sk *rlwe.SecretKey) *btp.Bootstrapper {
 	return btp.NewDummyBootstrapper(NumCPU, NewParametersCustom(params.LogN(), LevelBootstrapping), sk)
 }
+
+// NewSimulatedBootstrapper returns the simulated bootstrapper of the profile
+// BootstrappingProfileName, see [Configuration.NewSimulatedBootstrapper].
+func NewSimulatedBootstrapper(params hefloat.Parameters, sk *rlwe.SecretKey, seed [32]byte) (*btp.Bootstrapper, error) {
+	return DefaultConfiguration().NewSimulatedBootstrapper(params, sk, seed)
+}
+
+// NewSimulatedBootstrapper returns a bootstrapper that refreshes the ciphertexts
+// as NewDummyBootstrapper but adds the error of the profile c.Bootstrapping
+// at the degree of params (see BootstrappingProfile.Noise), drawn from a generator
+// seeded with seed.
+func (c Configuration) NewSimulatedBootstrapper(params hefloat.Parameters, sk *rlwe.SecretKey, seed [32]byte) (*btp.Bootstrapper, error) {
+
+	profile, err := GetBootstrappingProfile(c.Bootstrapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return btp.NewSimulatedBootstrapper(NumCPU, NewParametersCustomRing(params.LogN(), c.LevelBootstrapping, params.RingType()), sk, profile.Noise.At(params.LogN()), seed), nil
+}
@@ -0,0 +1,195 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+
+	btp "app/bootstrapping"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// BootstrappingProfile is a named variant of the bootstrapping parameters,
+// selected by Configuration.Bootstrapping. The zero fields keep the values
+// of bootstrapping.NewParametersLiteral.
+//
+// The output level, the depth and the evaluation keys of a profile are
+// returned by Configuration.BootstrappingSummary, and its precision is
+// measured by TestBootstrappingProfiles (test/bootstrapping_test.go).
+type BootstrappingProfile struct {
+	Name            string
+	Description     string
+	LogP            []int   // auxiliary primes of the key-switching
+	C2S             [][]int // scales of the C2S DFT matrices, grouped in levels (the scales of a level add up to the size of its prime, at most 61 bits)
+	S2C             [][]int // scales of the S2C DFT matrices, grouped in levels (idem)
+	LogMessageRatio int     // added to Configuration.LogMessageRatio
+	Mod1InvDegree   int     // degree of the arcsine correction of the modular reduction
+
+	// Noise is the error of the bootstrapping of the profile, injected by
+	// NewSimulatedBootstrapper at the degree of the parameters (see
+	// btp.NoiseModel.At). It is measured by btp.MeasureNoise on the
+	// bootstrapping of residual parameters of degree 2^13, which fit in
+	// the memory of a laptop, and its growth between 2^12 and 2^13, see
+	// btp.NoiseModel.WithGrowth. TestNoiseGrowth checks that the growth
+	// between 2^11 and 2^12 predicts the error at 2^13. The constant and
+	// linear terms of the latency profile grow faster with the degree, so
+	// that their growth is the one of their excess over 2^11, see
+	// btp.NoiseModel.WithExcessGrowth.
+	Noise btp.NoiseModel
+}
+
+// The figures of the descriptions are those of the default Configuration,
+// whose bootstrapping ring is of degree 2^16, except for the error, which
+// was measured at LogN=13 and is extrapolated to LogN=15 by Noise.At.
+var (
+	BootstrappingBalanced = BootstrappingProfile{
+		Name:        "balanced",
+		Description: "default factorization of the DFTs; error measured at LogN=13 of 2^-25.8 on [-1, 1] and 2^-21.8 on [-16, 16], extrapolated at LogN=15 to 2^-25.7 and 2^-19.8; output level 12, depth 13, 56 Galois keys of 186MB",
+		LogP:        LogPN16,
+		Noise:       btp.NoiseModel{LogN: 13, LogStd: -25.80, LogLinear: -27.99, LogCubic: -31.39, GrowthStd: 0.02, GrowthCubic: 1},
+	}
+
+	BootstrappingPrecision = BootstrappingProfile{
+		Name:            "precision",
+		Description:     "arcsine correction of the modular reduction, which removes its error growing with the cube of the values, and a message ratio smaller by 2 bits; C2S scales of 25 bits to remain under the security bound; error measured at LogN=13 of 2^-27.8 on [-1, 1] and 2^-27.7 on [-16, 16], extrapolated at LogN=15 to the same; output level 12, depth 15, 56 Galois keys of 198MB",
+		LogP:            LogPN16,
+		C2S:             [][]int{{25, 25}, {25, 25}},
+		LogMessageRatio: -2,
+		Mod1InvDegree:   3,
+		Noise:           btp.NoiseModel{LogN: 13, LogStd: -27.82, LogLinear: -32.27, LogCubic: -41.68, GrowthCubic: 1},
+	}
+
+	BootstrappingLatency = BootstrappingProfile{
+		Name:        "latency",
+		Description: "C2S DFT in a single level of two matrices and S2C DFT in two levels, the smallest modulus for 11 more rotations; error measured at LogN=13 of 2^-23.0 on [-1, 1] and 2^-21.1 on [-16, 16], extrapolated at LogN=15 to 2^-18.1 and 2^-17.6; output level 12, depth 11, 67 Galois keys of 145MB",
+		LogP:        LogPN16,
+		C2S:         [][]int{{30, 30}},
+		S2C:         [][]int{{39}, {39}},
+		Noise:       btp.NoiseModel{LogN: 13, LogStd: -23.00, LogLinear: -24.87, LogCubic: -31.35, GrowthStd: 2.45, GrowthLinear: 1.79, GrowthCubic: 1},
+	}
+
+	BootstrappingLowMemory = BootstrappingProfile{
+		Name:        "low-memory",
+		Description: "S2C DFT in four levels, which need fewer rotations; error measured at LogN=13 of 2^-25.8 on [-1, 1] and 2^-21.8 on [-16, 16], extrapolated at LogN=15 to 2^-24.4 and 2^-19.5; output level 12, depth 14, 43 Galois keys of 192MB",
+		LogP:        LogPN16,
+		S2C:         [][]int{{39}, {39}, {39}, {39}},
+		Noise:       btp.NoiseModel{LogN: 13, LogStd: -25.82, LogLinear: -28.11, LogCubic: -31.39, GrowthLinear: 2.21, GrowthCubic: 1},
+	}
+)
+
+// BootstrappingProfiles are the profiles selectable by name.
+var BootstrappingProfiles = []BootstrappingProfile{
+	BootstrappingBalanced,
+	BootstrappingPrecision,
+	BootstrappingLatency,
+	BootstrappingLowMemory,
+}
+
+// BootstrappingProfileName is the profile of DefaultConfiguration.
+var BootstrappingProfileName = BootstrappingBalanced.Name
+
+// GetBootstrappingProfile returns the profile of the given name.
+func GetBootstrappingProfile(name string) (p BootstrappingProfile, err error) {
+	i := slices.IndexFunc(BootstrappingProfiles, func(p BootstrappingProfile) bool { return p.Name == name })
+	if i < 0 {
+		return p, fmt.Errorf("invalid bootstrapping profile: %q is not one of %v", name, BootstrappingProfileNames())
+	}
+	return BootstrappingProfiles[i], nil
+}
+
+// BootstrappingProfileNames returns the names of BootstrappingProfiles.
+func BootstrappingProfileNames() (names []string) {
+	for _, p := range BootstrappingProfiles {
+		names = append(names, p.Name)
+	}
+	return
+}
+
+// Apply sets the fields of the profile on the literal of a Configuration.
+func (p BootstrappingProfile) Apply(literal *bootstrapping.ParametersLiteral) {
+	if p.LogP != nil {
+		literal.LogP = p.LogP
+	}
+	if p.C2S != nil {
+		literal.C2S = p.C2S
+	}
+	if p.S2C != nil {
+		literal.S2C = p.S2C
+	}
+	literal.LogMessageRatio += p.LogMessageRatio
+	literal.Mod1InvDegree = p.Mod1InvDegree
+}
+
+// BootstrappingSummary describes the bootstrapping of a Configuration.
+type BootstrappingSummary struct {
+	Profile           string
+	OutputLevel       int // level of the bootstrapped ciphertexts
+	MinimumInputLevel int // minimum level of the ciphertexts to bootstrap
+	Depth             int // levels consumed in the bootstrapping ring
+	LogQP             float64
+	GaloisKeys        int
+	KeySize           int // size in bytes of an evaluation key
+}
+
+func (s BootstrappingSummary) String() string {
+	return fmt.Sprintf("%s: output level %d, minimum input level %d, depth %d, logQP %.0f, %d Galois keys of %dMB",
+		s.Profile, s.OutputLevel, s.MinimumInputLevel, s.Depth, s.LogQP, s.GaloisKeys, s.KeySize>>20)
+}
+
+// BootstrappingSummary returns the description of the bootstrapping of the configuration.
+func (c Configuration) BootstrappingSummary() (s BootstrappingSummary, err error) {
+
+	var btpParams bootstrapping.Parameters
+	if btpParams, err = c.BootstrappingParameters(); err != nil {
+		return
+	}
+
+	return summarize(c.Bootstrapping, btpParams), nil
+}
+
+func summarize(profile string, btpParams bootstrapping.Parameters) BootstrappingSummary {
+
+	params := btpParams.BootstrappingParameters
+
+	digits := len(params.DecompositionMatrixDimensions(params.MaxLevelQ(), params.MaxLevelP(), rlwe.DigitDecomposition{}))
+
+	return BootstrappingSummary{
+		Profile:           profile,
+		OutputLevel:       btpParams.ResidualParameters.MaxLevel(),
+		MinimumInputLevel: params.LevelsConsumedPerRescaling() - 1,
+		Depth:             params.MaxLevel() - btpParams.ResidualParameters.MaxLevel(),
+		LogQP:             params.LogQP(),
+		GaloisKeys:        len(btpParams.GaloisElements(params)),
+		KeySize:           2 * digits * (params.QCount() + params.PCount()) * params.N() * 8,
+	}
+}
+
+// CheckBootstrapping returns an error if the bootstrapping parameters do not
+// match what the stages of the circuit expect on the residual parameters
+// params: bootstrapped ciphertexts at the given level (LevelBootstrapping
+// for the default configuration) on the moduli of params, and ciphertexts
+// bootstrapped from level 0.
+func CheckBootstrapping(params hefloat.Parameters, btpParams bootstrapping.Parameters, level int) (err error) {
+
+	residual := btpParams.ResidualParameters
+
+	if residual.LogN() != params.LogN() || residual.RingType() != params.RingType() {
+		return fmt.Errorf("invalid bootstrapping parameters: residual ring (LogN=%d, %s) != (LogN=%d, %s)", residual.LogN(), residual.RingType(), params.LogN(), params.RingType())
+	}
+
+	if residual.MaxLevel() != level {
+		return fmt.Errorf("invalid bootstrapping parameters: output level %d != %d", residual.MaxLevel(), level)
+	}
+
+	if residual.MaxLevel() > params.MaxLevel() || !slices.Equal(residual.Q(), params.Q()[:residual.MaxLevel()+1]) {
+		return fmt.Errorf("invalid bootstrapping parameters: residual moduli do not match the moduli of the parameters")
+	}
+
+	if inputLevel := btpParams.BootstrappingParameters.LevelsConsumedPerRescaling() - 1; inputLevel != 0 {
+		return fmt.Errorf("invalid bootstrapping parameters: minimum input level %d != 0", inputLevel)
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+// Package activation implements the activation functions of the FNN.
+//
+// All activations f are evaluated on scaled inputs: given x/AbsMax with
+// x in [-AbsMax, AbsMax], they return f(x)/AbsMax. The caller scales
+// the first linear layer by 1/AbsMax and the second by AbsMax.
+package activation
+
+import (
+	"fmt"
+
+	"app/matrix"
+	"app/matrix/relu"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+type Type string
+
+const (
+	ReLU       = Type("relu")
+	GELU       = Type("gelu")
+	SiLU       = Type("silu")
+	Polynomial = Type("polynomial")
+)
+
+type Parameters struct {
+	Type   Type
+	AbsMax float64         // Max |x| at the input of the activation
+	ReLU   relu.Parameters // Parameters of ReLU
+	Deg    int             // Degree of the Chebyshev approximation of the gate of GELU and SiLU
+	Coeffs []float64       // Coefficients in the monomial basis of the polynomial activation
+}
+
+type Evaluator interface {
+	EvaluateEncrypted(in []rlwe.Ciphertext) (err error)
+	EvaluateApproximate(in, out []*mat.Dense)
+	EvaluateExact(in, out []*mat.Dense)
+}
+
+// NewEvaluator returns the Evaluator for the activation of the given type.
+func NewEvaluator(p Parameters, eval *matrix.Evaluator, btp he.Bootstrapper[rlwe.Ciphertext]) Evaluator {
+	switch p.Type {
+	case ReLU:
+		p.ReLU.AbsMax = p.AbsMax
+		return relu.NewEvaluator(p.ReLU, eval, btp)
+	case GELU:
+		return NewGatedEvaluator(p.AbsMax, p.Deg, gateGELU, eval, btp)
+	case SiLU:
+		return NewGatedEvaluator(p.AbsMax, p.Deg, gateSiLU, eval, btp)
+	case Polynomial:
+		return NewPolynomialEvaluator(p.AbsMax, p.Coeffs, eval, btp)
+	default:
+		panic(fmt.Errorf("invalid activation type: %s", p.Type))
+	}
+}
+
+// bootstrapIfNeeded bootstraps in, in place, if it has less than depth levels
+// above the minimum input level of btp.
+func bootstrapIfNeeded(in []rlwe.Ciphertext, depth int, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	if in[0].Level() >= depth+btp.MinimumInputLevel() {
+		return
+	}
+
+	var out []rlwe.Ciphertext
+	if out, err = btp.BootstrapMany(in); err != nil {
+		return fmt.Errorf("btp.BootstrapMany: %w", err)
+	}
+
+	copy(in, out)
+
+	return
+}
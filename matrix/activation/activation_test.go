@@ -0,0 +1,121 @@
+package activation
+
+import (
+	"math"
+	"testing"
+
+	"app/bootstrapping"
+	"app/matrix"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60, 60, 60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
+
+func TestActivation(t *testing.T) {
+
+	AbsMax := 60.0
+
+	n := 1024
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 2*float64(i)/float64(n-1) - 1
+	}
+
+	for _, p := range []Parameters{
+		{Type: GELU, AbsMax: AbsMax, Deg: 255},
+		{Type: SiLU, AbsMax: AbsMax, Deg: 255},
+		{Type: Polynomial, AbsMax: AbsMax, Coeffs: []float64{0.25, 0.5, 0.125}},
+	} {
+		t.Run(string(p.Type), func(t *testing.T) {
+
+			eval := NewEvaluator(p, nil, nil)
+
+			in := []*mat.Dense{mat.NewDense(1, n, x)}
+			have := []*mat.Dense{mat.NewDense(1, n, nil)}
+			want := []*mat.Dense{mat.NewDense(1, n, nil)}
+
+			eval.EvaluateApproximate(in, have)
+			eval.EvaluateExact(in, want)
+
+			var maxErr float64
+			for i := range x {
+				maxErr = max(maxErr, math.Abs(have[0].At(0, i)-want[0].At(0, i))*AbsMax)
+			}
+
+			t.Logf("MaxErr: %e", maxErr)
+
+			require.Less(t, maxErr, 1e-3)
+		})
+	}
+}
+
+func TestActivationEncrypted(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+	ecd := hefloat.NewEncoder(params)
+	enc := rlwe.NewEncryptor(params, sk)
+	dec := rlwe.NewDecryptor(params, sk)
+
+	evk := rlwe.NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk))
+	evalMat := matrix.NewEvaluator(params, 1, []*hefloat.Evaluator{hefloat.NewEvaluator(params, evk)})
+	btp := bootstrapping.NewDummyBootstrapper(1, params, sk)
+
+	AbsMax := 60.0
+
+	n := params.MaxSlots()
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 2*float64(i)/float64(n-1) - 1
+	}
+
+	for _, p := range []Parameters{
+		{Type: GELU, AbsMax: AbsMax, Deg: 255},
+		{Type: SiLU, AbsMax: AbsMax, Deg: 255},
+		{Type: Polynomial, AbsMax: AbsMax, Coeffs: []float64{0.25, 0.5, 0.125}},
+	} {
+		t.Run(string(p.Type), func(t *testing.T) {
+
+			eval := NewEvaluator(p, evalMat, btp)
+
+			want := []*mat.Dense{mat.NewDense(1, n, nil)}
+			eval.EvaluateExact([]*mat.Dense{mat.NewDense(1, n, x)}, want)
+
+			pt := hefloat.NewPlaintext(params, params.MaxLevel())
+			require.NoError(t, ecd.Encode(x, pt))
+			ct := hefloat.NewCiphertext(params, 1, pt.Level())
+			require.NoError(t, enc.Encrypt(pt, ct))
+
+			cts := []rlwe.Ciphertext{*ct}
+			require.NoError(t, eval.EvaluateEncrypted(cts))
+
+			have := make([]float64, n)
+			require.NoError(t, ecd.Decode(dec.DecryptNew(&cts[0]), have))
+
+			var maxErr float64
+			for i := range x {
+				maxErr = max(maxErr, math.Abs(have[i]-want[0].At(0, i))*AbsMax)
+			}
+
+			t.Logf("MaxErr: %e", maxErr)
+
+			require.Less(t, maxErr, 1e-3)
+		})
+	}
+}
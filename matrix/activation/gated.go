@@ -0,0 +1,109 @@
+package activation
+
+import (
+	"fmt"
+	"math"
+
+	"app/matrix"
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// gateGELU is the standard normal CDF: GELU(x) = x * gateGELU(x).
+func gateGELU(x float64) (y float64) {
+	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
+}
+
+// gateSiLU is the sigmoid: SiLU(x) = x * gateSiLU(x).
+func gateSiLU(x float64) (y float64) {
+	return 1 / (1 + math.Exp(-x))
+}
+
+// GatedEvaluator evaluates activations of the form f(x) = x * gate(x),
+// such as GELU and SiLU, where gate is approximated by a Chebyshev
+// polynomial over [-AbsMax, AbsMax].
+type GatedEvaluator struct {
+	AbsMax   float64
+	Gate     func(x float64) (y float64)
+	GatePoly *he.Polynomial
+	*matrix.Evaluator
+	he.Bootstrapper[rlwe.Ciphertext]
+}
+
+func NewGatedEvaluator(AbsMax float64, deg int, gate func(x float64) (y float64), eval *matrix.Evaluator, btp he.Bootstrapper[rlwe.Ciphertext]) *GatedEvaluator {
+
+	// The input is x/AbsMax, hence the gate is approximated over [-1, 1].
+	f := func(x float64) (y float64) {
+		return gate(x * AbsMax)
+	}
+
+	return &GatedEvaluator{
+		AbsMax:       AbsMax,
+		Gate:         gate,
+		GatePoly:     utils.GetChebyshevPoly(-1, 1, deg, f),
+		Evaluator:    eval,
+		Bootstrapper: btp,
+	}
+}
+
+// Depth returns the depth of the circuit.
+func (eval *GatedEvaluator) Depth() int {
+	return eval.GatePoly.Depth() + 1 // x * gate(x)
+}
+
+func (eval *GatedEvaluator) EvaluateEncrypted(in []rlwe.Ciphertext) (err error) {
+
+	if err = bootstrapIfNeeded(in, eval.Depth(), eval.Bootstrapper); err != nil {
+		return fmt.Errorf("[bootstrapIfNeeded]: %w", err)
+	}
+
+	var gate []rlwe.Ciphertext
+	if gate, err = eval.Polynomial(in, eval.GatePoly); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][Polynomial][in,GatePoly]: %w", err)
+	}
+
+	if err = eval.Rescale(gate, gate); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][Rescale][gate,gate]: %w", err)
+	}
+
+	if err = eval.DotCt(in, gate, in); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][DotCt][in,gate,in]: %w", err)
+	}
+
+	if err = eval.Rescale(in, in); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][Rescale][in,in]: %w", err)
+	}
+
+	return
+}
+
+func (eval *GatedEvaluator) EvaluateExact(in, out []*mat.Dense) {
+
+	f := func(i, j int, x float64) (y float64) {
+		return x * eval.Gate(x*eval.AbsMax)
+	}
+
+	for i := range in {
+		out[i].Apply(f, in[i])
+	}
+}
+
+func (eval *GatedEvaluator) EvaluateApproximate(in, out []*mat.Dense) {
+
+	coeffs := make([]float64, len(eval.GatePoly.Coeffs))
+	for i := range coeffs {
+		coeffs[i], _ = eval.GatePoly.Coeffs[i][0].Float64()
+	}
+
+	f := func(i, j int, x float64) (y float64) {
+		return x * utils.ChebEval(coeffs, -1, 1, x)
+	}
+
+	for i := range in {
+		out[i].Apply(f, in[i])
+	}
+}
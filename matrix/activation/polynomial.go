@@ -0,0 +1,96 @@
+package activation
+
+import (
+	"fmt"
+	"math"
+
+	"app/matrix"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// PolynomialEvaluator evaluates a low-degree polynomial activation
+// p(x) = c[0] + c[1] x + ... + c[d] x^d, as found in HE-trained models.
+type PolynomialEvaluator struct {
+	AbsMax float64
+	Coeffs []float64 // Coefficients of p(x)
+	Scaled []float64 // Coefficients of p(AbsMax * x) / AbsMax
+	*matrix.Evaluator
+	he.Bootstrapper[rlwe.Ciphertext]
+}
+
+func NewPolynomialEvaluator(AbsMax float64, coeffs []float64, eval *matrix.Evaluator, btp he.Bootstrapper[rlwe.Ciphertext]) *PolynomialEvaluator {
+
+	scaled := make([]float64, len(coeffs))
+	for i := range scaled {
+		scaled[i] = coeffs[i] * math.Pow(AbsMax, float64(i-1))
+	}
+
+	return &PolynomialEvaluator{
+		AbsMax:       AbsMax,
+		Coeffs:       coeffs,
+		Scaled:       scaled,
+		Evaluator:    eval,
+		Bootstrapper: btp,
+	}
+}
+
+// Depth returns the depth of the circuit.
+func (eval *PolynomialEvaluator) Depth() int {
+	return bignum.NewPolynomial(bignum.Monomial, eval.Scaled, nil).Depth()
+}
+
+func (eval *PolynomialEvaluator) EvaluateEncrypted(in []rlwe.Ciphertext) (err error) {
+
+	if err = bootstrapIfNeeded(in, eval.Depth(), eval.Bootstrapper); err != nil {
+		return fmt.Errorf("[bootstrapIfNeeded]: %w", err)
+	}
+
+	poly := he.NewPolynomial(bignum.NewPolynomial(bignum.Monomial, eval.Scaled, nil))
+
+	var out []rlwe.Ciphertext
+	if out, err = eval.Polynomial(in, poly); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][Polynomial][in,poly]: %w", err)
+	}
+
+	if err = eval.Rescale(out, out); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][Rescale][out,out]: %w", err)
+	}
+
+	copy(in, out)
+
+	return
+}
+
+func (eval *PolynomialEvaluator) EvaluateExact(in, out []*mat.Dense) {
+
+	f := func(i, j int, x float64) (y float64) {
+		return horner(eval.Coeffs, x*eval.AbsMax) / eval.AbsMax
+	}
+
+	for i := range in {
+		out[i].Apply(f, in[i])
+	}
+}
+
+func (eval *PolynomialEvaluator) EvaluateApproximate(in, out []*mat.Dense) {
+
+	f := func(i, j int, x float64) (y float64) {
+		return horner(eval.Scaled, x)
+	}
+
+	for i := range in {
+		out[i].Apply(f, in[i])
+	}
+}
+
+func horner(coeffs []float64, x float64) (y float64) {
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = y*x + coeffs[i]
+	}
+	return
+}
@@ -0,0 +1,83 @@
+// Package argmax implements an encrypted one-hot argmax (or top-k indicator)
+// over vectors of K values packed every Stride slots.
+package argmax
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"golang.org/x/exp/maps"
+
+	"app/matrix"
+	"app/matrix/softmax/innermax"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+type Parameters struct {
+	K             int                 // Number of values per vector
+	Stride        int                 // Number of slots between the start of two consecutive vectors
+	TopK          int                 // Number of largest values flagged (1 for argmax)
+	Threshold     float64             // Values within Threshold of the max are flagged, values below max - 2*Threshold are not
+	Min           float64             // Lower bound of the values, assigned to the slots in [K, BlockSize)
+	Max           float64             // Upper bound of the values
+	MaxParameters innermax.Parameters // Comparison polynomial, AbsMax must upper bound Max - Min
+}
+
+// BlockSize returns the smallest power of two greater or equal to K,
+// which is the size of the blocks on which the max is computed.
+func (p Parameters) BlockSize() int {
+	return 1 << bits.Len64(uint64(p.K-1))
+}
+
+// Pad returns the value assigned to the slots in [K, BlockSize),
+// which is smaller than or equal to all values in [Min, Max].
+func (p Parameters) Pad() float64 {
+	return p.Min
+}
+
+// CheckRange returns an error if the comparison polynomial does not
+// cover the differences of the values in [Min, Max], padding included.
+func (p Parameters) CheckRange() error {
+	if p.Min > p.Max {
+		return fmt.Errorf("invalid range: Min=%f > Max=%f", p.Min, p.Max)
+	}
+	if p.Max-p.Min > float64(p.MaxParameters.AbsMax) {
+		return fmt.Errorf("invalid range: Max-Min=%f > AbsMax=%d", p.Max-p.Min, p.MaxParameters.AbsMax)
+	}
+	return nil
+}
+
+type Evaluator struct {
+	Parameters
+	*matrix.Evaluator
+	he.Bootstrapper[rlwe.Ciphertext]
+}
+
+func NewEvaluator(p Parameters, eval *matrix.Evaluator, btp he.Bootstrapper[rlwe.Ciphertext]) *Evaluator {
+	return &Evaluator{
+		Parameters:   p,
+		Evaluator:    eval,
+		Bootstrapper: btp,
+	}
+}
+
+func GaloisElements(params hefloat.Parameters, p Parameters, numcts int) (galEls []uint64) {
+	m := map[uint64]bool{}
+
+	for _, galEl := range innermax.GaloisElements(params, p.BlockSize(), numcts) {
+		m[galEl] = true
+	}
+
+	for _, galEl := range rlwe.GaloisElementsForReplicate(params, 1, p.BlockSize()) {
+		m[galEl] = true
+	}
+
+	galEls = maps.Keys(m)
+	slices.Sort(galEls)
+
+	return
+}
@@ -0,0 +1,133 @@
+package argmax
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"app/bootstrapping"
+	"app/matrix"
+	"app/matrix/minimax"
+	"app/matrix/softmax/innermax"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60, 60, 60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
+
+func TestArgmax(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	K := 25
+	stride := 128
+	vectors := params.MaxSlots() / stride
+	nbCts := 2
+
+	r := rand.New(rand.NewPCG(0, 0))
+
+	sign := minimax.MustLoad("sign_p512_a6_e15_d31-31")
+
+	// Distinct values in [-24, 24] with a gap of 2
+	in := make([]*mat.Dense, nbCts*vectors)
+	for i := range in {
+		row := make([]float64, K)
+		for j, c := range r.Perm(K) {
+			row[j] = float64(2*c - K + 1)
+		}
+		in[i] = mat.NewDense(1, K, row)
+	}
+
+	for _, topk := range []int{1, 2} {
+
+		p := Parameters{
+			K:         K,
+			Stride:    stride,
+			TopK:      topk,
+			Threshold: 1,
+			Min:       -24,
+			Max:       24,
+			MaxParameters: innermax.Parameters{
+				AbsMax:       64,
+				CoeffsString: sign.CoeffsString(),
+				CoeffsFloat:  sign.CoeffsFloat(),
+			},
+		}
+
+		require.NoError(t, p.CheckRange())
+
+		eval := NewEvaluator(p, nil, nil)
+
+		want := make([]*mat.Dense, len(in))
+		for i := range want {
+			want[i] = mat.NewDense(1, K, nil)
+		}
+
+		eval.EvaluateExact(in, want)
+
+		t.Run("Approximate", func(t *testing.T) {
+			have := make([]*mat.Dense, len(in))
+			for i := range have {
+				have[i] = mat.NewDense(1, K, nil)
+			}
+			eval.EvaluateApproximate(in, have)
+			for i := range have {
+				require.InDeltaSlice(t, want[i].RawMatrix().Data, have[i].RawMatrix().Data, 1e-2)
+			}
+		})
+
+		t.Run("Encrypted", func(t *testing.T) {
+
+			kgen := rlwe.NewKeyGenerator(params)
+			sk := kgen.GenSecretKeyNew()
+			ecd := hefloat.NewEncoder(params)
+			enc := rlwe.NewEncryptor(params, sk)
+			dec := rlwe.NewDecryptor(params, sk)
+
+			rlk := kgen.GenRelinearizationKeyNew(sk)
+			evk := rlwe.NewMemEvaluationKeySet(rlk, kgen.GenGaloisKeysNew(GaloisElements(params, p, nbCts), sk)...)
+
+			eval.Evaluator = matrix.NewEvaluator(params, stride, []*hefloat.Evaluator{hefloat.NewEvaluator(params, evk)})
+			eval.Bootstrapper = bootstrapping.NewDummyBootstrapper(1, params, sk)
+
+			cts := make([]rlwe.Ciphertext, nbCts)
+			for i := range cts {
+				values := make([]float64, params.MaxSlots())
+				for j := range vectors {
+					copy(values[j*stride:], in[i*vectors+j].RawMatrix().Data)
+				}
+				pt := hefloat.NewPlaintext(params, 0)
+				require.NoError(t, ecd.Encode(values, pt))
+				ct := hefloat.NewCiphertext(params, 1, 0)
+				require.NoError(t, enc.Encrypt(pt, ct))
+				cts[i] = *ct
+			}
+
+			cts, err = eval.EvaluateEncrypted(cts)
+			require.NoError(t, err)
+
+			for i := range cts {
+				have := make([]float64, params.MaxSlots())
+				require.NoError(t, ecd.Decode(dec.DecryptNew(&cts[i]), have))
+				for j := range vectors {
+					require.InDeltaSlice(t, want[i*vectors+j].RawMatrix().Data, have[j*stride:j*stride+K], 1e-2)
+					for _, c := range have[j*stride+K : (j+1)*stride] {
+						require.InDelta(t, 0, c, 1e-2)
+					}
+				}
+			}
+		})
+	}
+}
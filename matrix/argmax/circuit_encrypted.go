@@ -0,0 +1,158 @@
+package argmax
+
+import (
+	"fmt"
+
+	"app/matrix/softmax/innermax"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/structs"
+)
+
+// EvaluateEncrypted returns, for each vector, the indicator of its TopK
+// largest values: 1 in the slots of the TopK largest values and 0 elsewhere.
+// Slots outside of the vectors are set to zero.
+func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err error) {
+
+	params := eval.Evaluators[0].Parameters()
+
+	P := eval.BlockSize()
+
+	// Same level and scale as the output of InnerMax
+	var x []rlwe.Ciphertext
+	if x, err = eval.BootstrapMany(in); err != nil {
+		return nil, fmt.Errorf("[BootstrapMany][in]: %w", err)
+	}
+
+	pad := make([]float64, params.MaxSlots())
+	mask := make([]float64, params.MaxSlots())
+	for i := range pad {
+		if j := i % eval.Stride; j < eval.K {
+			mask[i] = 1
+		} else if j < P {
+			pad[i] = eval.Pad()
+		}
+	}
+
+	if err = eval.AddVec(x, pad, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][AddVec][x,pad,x]: %w", err)
+	}
+
+	for k := range eval.TopK {
+
+		var ind []rlwe.Ciphertext
+		if ind, err = eval.oneHot(x); err != nil {
+			return nil, fmt.Errorf("[oneHot][x]: %w", err)
+		}
+
+		if k == 0 {
+			out = ind
+		} else if err = eval.AddCt(out, ind, out); err != nil {
+			return nil, fmt.Errorf("[matrix.Evaluator][AddCt][out,ind,out]: %w", err)
+		}
+
+		if k == eval.TopK-1 {
+			break
+		}
+
+		// x = x - ind * (x - pad): the flagged values are set to pad,
+		// at a level from which x can still be bootstrapped
+		if ind[0].Level() < 1+eval.MinimumInputLevel() {
+			if ind, err = eval.BootstrapMany(ind); err != nil {
+				return nil, fmt.Errorf("[BootstrapMany][ind]: %w", err)
+			}
+		}
+
+		tmp := structs.Vector[rlwe.Ciphertext](x).Clone()
+
+		if err = eval.AddScalar(tmp, -eval.Pad(), tmp); err != nil {
+			return nil, fmt.Errorf("[matrix.Evaluator][AddScalar][tmp,-pad,tmp]: %w", err)
+		}
+
+		if err = eval.DotCt(tmp, ind, tmp); err != nil {
+			return nil, fmt.Errorf("[matrix.Evaluator][DotCt][tmp,ind,tmp]: %w", err)
+		}
+
+		if err = eval.Rescale(tmp, tmp); err != nil {
+			return nil, fmt.Errorf("[matrix.Evaluator][Rescale][tmp,tmp]: %w", err)
+		}
+
+		if err = eval.SubCt(x, tmp, x); err != nil {
+			return nil, fmt.Errorf("[matrix.Evaluator][SubCt][x,tmp,x]: %w", err)
+		}
+
+		// Same level and scale as the output of InnerMax
+		if x, err = eval.BootstrapMany(x); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany][x]: %w", err)
+		}
+	}
+
+	// The output keeps the minimum input level of the bootstrapper,
+	// so that it can be bootstrapped (see sanitize.Sanitizer.Binarize).
+	if out[0].Level() < 1+eval.MinimumInputLevel() {
+		if out, err = eval.BootstrapMany(out); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany][out]: %w", err)
+		}
+	}
+
+	if err = eval.DotVec(out, mask, out); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][DotVec][out,mask,out]: %w", err)
+	}
+
+	if err = eval.Rescale(out, out); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale][out,out]: %w", err)
+	}
+
+	return
+}
+
+// oneHot returns step((x - max(x) + Threshold)/AbsMax).
+func (eval *Evaluator) oneHot(x []rlwe.Ciphertext) (ind []rlwe.Ciphertext, err error) {
+
+	P := eval.BlockSize()
+
+	AbsMax := float64(eval.MaxParameters.AbsMax)
+
+	maxEval := innermax.NewEvaluator(eval.MaxParameters, eval.Evaluator, eval.Bootstrapper)
+
+	var max []rlwe.Ciphertext
+	if max, err = maxEval.InnerMax(structs.Vector[rlwe.Ciphertext](x).Clone(), P); err != nil {
+		return nil, fmt.Errorf("[innermax.Evaluator][InnerMax]: %w", err)
+	}
+
+	if err = eval.MaskAndReplicate(max, 1/AbsMax, P, false); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][MaskAndReplicate]: %w", err)
+	}
+
+	ind = structs.Vector[rlwe.Ciphertext](x).Clone()
+
+	if err = eval.DropLevel(ind, ind[0].Level()-max[0].Level()); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][DropLevel]: %w", err)
+	}
+
+	if err = eval.MulScalar(ind, 1/AbsMax, ind); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][MulScalar]: %w", err)
+	}
+
+	if err = eval.SubCt(ind, max, ind); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][SubCt]: %w", err)
+	}
+
+	if err = eval.AddScalar(ind, eval.Threshold/AbsMax, ind); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][AddScalar]: %w", err)
+	}
+
+	if err = eval.Rescale(ind, ind); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale]: %w", err)
+	}
+
+	if ind, err = maxEval.Step(ind); err != nil {
+		return nil, fmt.Errorf("[innermax.Evaluator][Step]: %w", err)
+	}
+
+	if err = eval.Rescale(ind, ind); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale]: %w", err)
+	}
+
+	return
+}
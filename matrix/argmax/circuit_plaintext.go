@@ -0,0 +1,108 @@
+package argmax
+
+import (
+	"slices"
+
+	"app/matrix/softmax/innermax"
+	"app/utils"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// EvaluateExact sets each row of out to the indicator of the TopK
+// largest values of the corresponding row of in (ties broken by index).
+func (eval *Evaluator) EvaluateExact(in, out []*mat.Dense) {
+
+	rows, cols := in[0].Dims()
+
+	idx := make([]int, cols)
+
+	for k := range in {
+		for i := range rows {
+
+			row := slices.Clone(in[k].RawRowView(i))
+
+			for j := range idx {
+				idx[j] = j
+			}
+
+			slices.SortStableFunc(idx, func(a, b int) int {
+				switch {
+				case row[a] > row[b]:
+					return -1
+				case row[a] < row[b]:
+					return 1
+				default:
+					return 0
+				}
+			})
+
+			res := out[k].RawRowView(i)
+			clear(res)
+			for _, j := range idx[:min(eval.TopK, cols)] {
+				res[j] = 1
+			}
+		}
+	}
+}
+
+// EvaluateApproximate is the plaintext mirror of EvaluateEncrypted.
+func (eval *Evaluator) EvaluateApproximate(in, out []*mat.Dense) {
+
+	rows, cols := in[0].Dims()
+
+	P := eval.BlockSize()
+
+	AbsMax := float64(eval.MaxParameters.AbsMax)
+
+	maxEval := innermax.NewEvaluator(eval.MaxParameters, nil, nil)
+
+	x := make([]*mat.Dense, len(in))
+	acc := make([]*mat.Dense, len(in))
+	for k := range in {
+		x[k] = mat.NewDense(rows, P, nil)
+		acc[k] = mat.NewDense(rows, P, nil)
+		for i := range rows {
+			row := x[k].RawRowView(i)
+			copy(row, in[k].RawRowView(i))
+			for j := cols; j < P; j++ {
+				row[j] = eval.Pad()
+			}
+		}
+	}
+
+	for t := range eval.TopK {
+
+		ind := make([]*mat.Dense, len(x))
+		for k := range x {
+			ind[k] = mat.DenseCopyOf(x[k])
+		}
+
+		// x - max(x)
+		maxEval.InnerMaxPlaintext(ind)
+
+		f := func(i, j int, y float64) float64 {
+			return (utils.CompositeEval(eval.MaxParameters.CoeffsFloat, -1, 1, (y+eval.Threshold)/AbsMax) + 1) / 2
+		}
+
+		for k := range ind {
+			ind[k].Apply(f, ind[k])
+			acc[k].Add(acc[k], ind[k])
+		}
+
+		if t == eval.TopK-1 {
+			break
+		}
+
+		for k := range x {
+			xk := x[k].RawMatrix().Data
+			for j, c := range ind[k].RawMatrix().Data {
+				xk[j] -= c * (xk[j] - eval.Pad())
+			}
+		}
+	}
+
+	for k := range out {
+		out[k].Copy(acc[k].Slice(0, rows, 0, cols))
+	}
+}
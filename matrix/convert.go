@@ -0,0 +1,431 @@
+package matrix
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils"
+	"github.com/Pro7ech/lattigo/utils/concurrency"
+)
+
+// Conversion moves a batch of matrices from the layout In to the layout Out.
+// Both layouts must describe the same logical Rows x (Heads * Cols) matrices,
+// and each sample of Out must be stored in In.
+//
+// The t-th output ciphertext is the sum, over the terms of Terms[t], of the
+// permutation Perms[term.Perm] applied to the input ciphertext term.In.
+// Identical permutations, e.g. those of all the full ciphertexts of a batch,
+// are only stored once.
+//
+// A periodic Conversion (see NewPeriodicConversion) is the conversion of one
+// period of samples, which [Evaluator.Convert] repeats over a batch of any size.
+type Conversion struct {
+	In, Out  Layout
+	Perms    []he.Permutation[float64]
+	Terms    [][]ConversionTerm
+	Periodic bool
+}
+
+// ConversionTerm is the contribution of an input ciphertext to an output ciphertext.
+type ConversionTerm struct {
+	In   int
+	Perm int
+}
+
+// ConversionCost is the estimated cost of evaluating a Conversion.
+type ConversionCost struct {
+	LinearTransformations int // number of evaluated linear transformations
+	Rotations             int // number of key-switchings
+	PtMul                 int // number of plaintext-ciphertext multiplications
+	Add                   int // number of ciphertext additions
+	Plaintexts            int // number of encoded diagonals
+}
+
+func (c ConversionCost) String() string {
+	return fmt.Sprintf("{LT=%d, Rot=%d, PtMul=%d, Add=%d, Pt=%d}", c.LinearTransformations, c.Rotations, c.PtMul, c.Add, c.Plaintexts)
+}
+
+// NewConversion returns the Conversion from the layout in to the layout out,
+// where each moved value is multiplied by scaling.
+func NewConversion(params hefloat.Parameters, in, out Layout, scaling float64) (c *Conversion, err error) {
+
+	if err = in.Validate(params); err != nil {
+		return nil, fmt.Errorf("invalid input layout: %w", err)
+	}
+
+	if err = out.Validate(params); err != nil {
+		return nil, fmt.Errorf("invalid output layout: %w", err)
+	}
+
+	if in.Rows != out.Rows || in.Cols*in.Heads != out.Cols*out.Heads {
+		return nil, fmt.Errorf("invalid conversion: %dx%d matrices cannot be converted to %dx%d matrices", in.Rows, in.Cols*in.Heads, out.Rows, out.Cols*out.Heads)
+	}
+
+	position := map[int]int{}
+	for p, s := range in.Samples {
+		if s >= 0 {
+			position[s] = p
+		}
+	}
+
+	for _, s := range out.Samples {
+		if _, ok := position[s]; s >= 0 && !ok {
+			return nil, fmt.Errorf("invalid conversion: sample %d is not stored in the input layout", s)
+		}
+	}
+
+	c = &Conversion{
+		In:    in,
+		Out:   out,
+		Terms: make([][]ConversionTerm, out.NumCts()),
+	}
+
+	// Permutations are indexed by their hash, and compared on a hash hit.
+	index := map[uint64][]int{}
+
+	// The output ciphertexts are processed one at a time
+	// to bound the size of the permutations in memory.
+	for t := range c.Terms {
+
+		perms := map[int]he.Permutation[float64]{}
+
+		// Positions whose heads are, at least partly, stored in the t-th ciphertext.
+		for q := t * out.MatPerCt / out.Heads; q < min(DivIntCeil((t+1)*out.MatPerCt, out.Heads), len(out.Samples)); q++ {
+
+			s := out.Samples[q]
+
+			if s < 0 {
+				continue
+			}
+
+			p := position[s]
+
+			for r := range in.Rows {
+				for j := range in.Cols * in.Heads {
+
+					ctOut, slotOut := out.slot(q, r, j)
+
+					if ctOut != t {
+						continue
+					}
+
+					ctIn, slotIn := in.slot(p, r, j)
+
+					perms[ctIn] = append(perms[ctIn], struct {
+						X int
+						Y int
+						C float64
+					}{X: slotIn, Y: slotOut, C: scaling})
+				}
+			}
+		}
+
+		ctsIn := make([]int, 0, len(perms))
+		for s := range perms {
+			ctsIn = append(ctsIn, s)
+		}
+		slices.Sort(ctsIn)
+
+		for _, s := range ctsIn {
+
+			key := hashPermutation(perms[s])
+
+			k := slices.IndexFunc(index[key], func(k int) bool { return slices.Equal(c.Perms[k], perms[s]) })
+
+			if k < 0 {
+				k = len(c.Perms)
+				index[key] = append(index[key], k)
+				c.Perms = append(c.Perms, perms[s])
+			} else {
+				k = index[key][k]
+			}
+
+			c.Terms[t] = append(c.Terms[t], ConversionTerm{In: s, Perm: k})
+		}
+	}
+
+	return
+}
+
+// NewPeriodicConversion returns the periodic Conversion from the shape in to
+// the shape out, i.e. from their Rows, Cols, Padd, PaddRows, MatPerCt and
+// Heads. It is the Conversion of a period of samples stored in order, which
+// [Evaluator.Convert] applies to each period of a batch: its permutations,
+// and thus its Galois elements, do not depend on the size of the batch.
+//
+// If in stores no samples, the period is the smallest batch of samples that
+// fills an integer number of input and output ciphertexts. Otherwise, the
+// period is given by the samples of in, which must be 0, ..., n-1, and of out,
+// e.g. to reorder them, and both layouts must fill their ciphertexts.
+func NewPeriodicConversion(params hefloat.Parameters, in, out Layout, scaling float64) (c *Conversion, err error) {
+
+	period := func(l Layout) int {
+		return l.MatPerCt / gcd(l.MatPerCt, l.Heads)
+	}
+
+	if in.MatPerCt < 1 || in.Heads < 1 || out.MatPerCt < 1 || out.Heads < 1 {
+		return nil, fmt.Errorf("invalid conversion: MatPerCt and Heads must be at least 1")
+	}
+
+	if len(in.Samples) == 0 {
+
+		n := period(in) / gcd(period(in), period(out)) * period(out)
+
+		in.Samples = NewLayout(in.Rows, in.Cols, in.Padd, in.MatPerCt, n).Samples
+		out.Samples = NewLayout(out.Rows, out.Cols, out.Padd, out.MatPerCt, n).Samples
+
+	} else {
+
+		for i, s := range in.Samples {
+			if s != i {
+				return nil, fmt.Errorf("invalid conversion: the samples of the input period are not in order")
+			}
+		}
+
+		if len(in.Samples)%period(in) != 0 || len(out.Samples)%period(out) != 0 {
+			return nil, fmt.Errorf("invalid conversion: the period does not fill the input and output ciphertexts")
+		}
+	}
+
+	if c, err = NewConversion(params, in, out, scaling); err != nil {
+		return
+	}
+
+	c.Periodic = true
+
+	return
+}
+
+// repeat returns the input layout of the periodic conversion on the
+// samples of in and the terms of each output ciphertext, in which the
+// input ciphertexts past the last one of in are omitted.
+func (c Conversion) repeat(in Layout) (out Layout, terms [][]ConversionTerm) {
+
+	period := len(c.In.Samples)
+	numCts := [2]int{c.In.NumCts(), c.Out.NumCts()}
+
+	out = c.Out
+	out.Samples = make([]int, DivIntCeil(len(in.Samples), period)*len(c.Out.Samples))
+
+	for q := range out.Samples {
+		// The input samples of the period are 0, ..., period-1, in order.
+		if s := c.Out.Samples[q%len(c.Out.Samples)]; s >= 0 && q/len(c.Out.Samples)*period+s < len(in.Samples) {
+			out.Samples[q] = in.Samples[q/len(c.Out.Samples)*period+s]
+		} else {
+			out.Samples[q] = -1
+		}
+	}
+
+	// Trailing empty positions are dropped, e.g. the padding of the last period.
+	for len(out.Samples) > 0 && out.Samples[len(out.Samples)-1] < 0 {
+		out.Samples = out.Samples[:len(out.Samples)-1]
+	}
+
+	terms = make([][]ConversionTerm, out.NumCts())
+	for t := range terms {
+		for _, term := range c.Terms[t%numCts[1]] {
+			if term.In += t / numCts[1] * numCts[0]; term.In < in.NumCts() {
+				terms[t] = append(terms[t], term)
+			}
+		}
+	}
+
+	return
+}
+
+// slot returns the ciphertext and the slot storing the value at the given
+// row and logical column of the matrix at the given position of the layout.
+func (l Layout) slot(pos, row, col int) (ct, slot int) {
+	m := pos*l.Heads + col/l.Cols
+	return m / l.MatPerCt, (m%l.MatPerCt)*l.Stride() + row*(l.Cols+l.Padd) + col%l.Cols
+}
+
+func hashPermutation(perm he.Permutation[float64]) uint64 {
+	h := fnv.New64a()
+	buf := make([]byte, 16)
+	for _, e := range perm {
+		for i := range 8 {
+			buf[i] = byte(e.X >> (8 * i))
+			buf[8+i] = byte(e.Y >> (8 * i))
+		}
+		h.Write(buf)
+	}
+	return h.Sum64()
+}
+
+// giantStep returns the giant step used to evaluate the k-th permutation.
+// A single diagonal is evaluated as a baby step, i.e. with one hoisted
+// rotation, since the naive evaluation of the diagonal 0 alone is not
+// supported by [he.MultiplyByDiagMatrix].
+func (c Conversion) giantStep(params hefloat.Parameters, k int) int {
+	if indexes := c.Perms[k].Indexes(params.LogMaxDimensions()); len(indexes) > 1 {
+		return he.OptimalLinearTransformationGiantStep(indexes, params.MaxSlots())
+	}
+	return params.MaxSlots()
+}
+
+// GaloisElements returns the Galois elements required to evaluate the conversion.
+func (c Conversion) GaloisElements(params hefloat.Parameters) (galEls []uint64) {
+
+	for k := range c.Perms {
+
+		ltParams := he.LinearTransformationParameters{
+			Indexes:       c.Perms[k].Indexes(params.LogMaxDimensions()),
+			LogDimensions: params.LogMaxDimensions(),
+			GiantStep:     c.giantStep(params, k),
+		}
+
+		galEls = append(galEls, ltParams.GaloisElements(params)...)
+	}
+
+	return utils.GetDistincts(galEls)
+}
+
+// Cost returns the estimated cost of evaluating the conversion.
+// Rotations of the same input ciphertext are not hoisted across terms,
+// so the count is an upper bound.
+func (c Conversion) Cost(params hefloat.Parameters) (cost ConversionCost) {
+
+	slots := params.MaxSlots()
+
+	rotations := make([]int, len(c.Perms))
+	diagonals := make([]int, len(c.Perms))
+
+	for k := range c.Perms {
+
+		indexes := c.Perms[k].Indexes(params.LogMaxDimensions())
+
+		diagonals[k] = len(indexes)
+		cost.Plaintexts += len(indexes)
+
+		_, rotN1, rotN2 := he.BSGSIndex(indexes, slots, c.giantStep(params, k))
+		for _, rot := range [][]int{rotN1, rotN2} {
+			for _, i := range rot {
+				if i != 0 {
+					rotations[k]++
+				}
+			}
+		}
+	}
+
+	for t := range c.Terms {
+		for i, term := range c.Terms[t] {
+			cost.LinearTransformations++
+			cost.Rotations += rotations[term.Perm]
+			cost.PtMul += diagonals[term.Perm]
+			if i != 0 {
+				cost.Add++
+			}
+		}
+	}
+
+	return
+}
+
+// NewConversionLinearTransformations encodes the permutations of the conversion.
+// See [Evaluator.NewLinearTransformation] for the other arguments.
+func (eval *Evaluator) NewConversionLinearTransformations(level int, scaleIn, scaleOut rlwe.Scale, c *Conversion) (lts []*he.LinearTransformation, err error) {
+
+	params := eval.Evaluators[0].Parameters()
+
+	lts = make([]*he.LinearTransformation, len(c.Perms))
+	for k := range lts {
+
+		diagonals := c.Perms[k].Diagonals(params.LogMaxDimensions())
+
+		lts[k] = he.NewLinearTransformation(params, he.LinearTransformationParameters{
+			Indexes:       diagonals.Indexes(),
+			LevelQ:        level,
+			LevelP:        params.MaxLevelP(),
+			Scale:         params.GetScalingFactor(scaleIn, scaleOut, level),
+			LogDimensions: params.LogMaxDimensions(),
+			GiantStep:     c.giantStep(params, k),
+		})
+
+		if err = eval.EncodeLinearTransformation(diagonals, lts[k]); err != nil {
+			return nil, fmt.Errorf("[matrix.Evaluator].EncodeLinearTransformation: %w", err)
+		}
+	}
+
+	return
+}
+
+// Convert evaluates the conversion c on in, whose layout must be c.In,
+// with the linear transformations returned by NewConversionLinearTransformations.
+// If c is periodic, in can store any samples and only its shape must be that of c.In.
+// As for [Evaluator.MulPt], the result is not rescaled.
+func (eval *Evaluator) Convert(in *Tensor, c *Conversion, lts []*he.LinearTransformation) (out *Tensor, err error) {
+
+	if err = in.Expect(c.In); err != nil {
+		return
+	}
+
+	layout, terms := c.Out, c.Terms
+
+	if c.Periodic {
+		layout, terms = c.repeat(in.Layout)
+	} else if !slices.Equal(in.Samples, c.In.Samples) {
+		return nil, fmt.Errorf("invalid layout: samples do not match the input layout of the conversion")
+	}
+
+	if len(lts) != len(c.Perms) {
+		return nil, fmt.Errorf("invalid linear transformations: expected %d but has %d", len(c.Perms), len(lts))
+	}
+
+	params := eval.Evaluators[0].Parameters()
+
+	level := in.Cts[0].Level()
+	for i := range in.Cts {
+		level = min(level, in.Cts[i].Level())
+	}
+
+	cts := make([]rlwe.Ciphertext, len(terms))
+
+	m := concurrency.NewRessourceManager(eval.GetEvaluatorsWithHoistingBuffer())
+	for t := range cts {
+		m.Run(func(eval *EvaluatorWithHoistingBuffer) (err error) {
+
+			cts[t] = *hefloat.NewCiphertext(params, 1, level)
+
+			// Output ciphertexts without samples are left empty.
+			if len(terms[t]) == 0 {
+				if len(lts) != 0 {
+					cts[t].Scale = in.Cts[0].Scale.Mul(lts[0].Scale)
+				}
+				return
+			}
+
+			buf := hefloat.NewCiphertext(params, 1, level)
+
+			for i, term := range terms[t] {
+
+				opOut := &cts[t]
+				if i != 0 {
+					opOut = buf
+				}
+
+				if err = he.NewLinearTransformationEvaluator(eval).Evaluate(&in.Cts[term.In], lts[term.Perm], eval.HoistingBuffer, opOut); err != nil {
+					return fmt.Errorf("[he.LinearTransformationEvaluator].Evaluate: %w", err)
+				}
+
+				if i != 0 {
+					if err = eval.Add(&cts[t], buf, &cts[t]); err != nil {
+						return fmt.Errorf("[hefloat.Evaluator].Add: %w", err)
+					}
+				}
+			}
+
+			return
+		})
+	}
+
+	if err = m.Wait(); err != nil {
+		return
+	}
+
+	return NewTensor(layout, cts)
+}
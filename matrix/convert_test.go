@@ -0,0 +1,277 @@
+package matrix
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversion(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+	tc := newTestContext(params)
+
+	enc := NewEncryptor(params, tc.sk)
+	dec := NewDecryptor(params, tc.sk)
+
+	rows := 8
+	cols := 16
+
+	r := sampling.NewSource([32]byte{})
+
+	newMatrices := func(n, rows, cols int) (in []*mat.Dense) {
+		in = make([]*mat.Dense, n)
+		for i := range in {
+			m := make([]float64, rows*cols)
+			for j := range m {
+				m[j] = r.Float64(-1, 1)
+			}
+			in[i] = mat.NewDense(rows, cols, m)
+		}
+		return
+	}
+
+	convert := func(t *testing.T, in *Tensor, c *Conversion) (out *Tensor) {
+
+		gks := tc.kgen.GenGaloisKeysNew(c.GaloisElements(params), tc.sk)
+
+		eval := NewEvaluator(params, rows, []*hefloat.Evaluator{tc.eval.WithKey(rlwe.NewMemEvaluationKeySet(nil, gks...))})
+
+		lts, err := eval.NewConversionLinearTransformations(in.Cts[0].Level(), in.Cts[0].Scale, in.Cts[0].Scale, c)
+		require.NoError(t, err)
+
+		out, err = eval.Convert(in, c, lts)
+		require.NoError(t, err)
+		require.NoError(t, eval.Rescale(out.Cts, out.Cts))
+
+		return
+	}
+
+	t.Run("Split", func(t *testing.T) {
+
+		// Splitting full ciphertexts uses the same permutation for all of them.
+		n := params.MaxSlots() / (rows * cols)
+
+		in := NewLayout(rows, cols, 0, n, 3*n)
+
+		for _, heads := range []int{2, 4} {
+
+			out, err := in.Split(heads, 0, 0)
+			require.NoError(t, err)
+
+			c, err := NewConversion(params, in, out, 1)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(c.Perms))
+			require.Equal(t, 3, len(c.Terms))
+		}
+	})
+
+	t.Run("Periodic", func(t *testing.T) {
+
+		// 3 samples per ciphertext split in 2 samples per ciphertext
+		// repeat every 6 samples, i.e. 2 input and 3 output ciphertexts.
+		heads := 2
+
+		layout := NewLayout(rows, cols, 0, 3, 0)
+
+		split, err := layout.Split(heads, 0, rows-cols/heads)
+		require.NoError(t, err)
+		split.MatPerCt = 2 * heads
+
+		c, err := NewPeriodicConversion(params, layout, split, 1)
+		require.NoError(t, err)
+		require.True(t, c.Periodic)
+		require.Equal(t, 6, len(c.In.Samples))
+		require.Equal(t, 3, len(c.Terms))
+
+		for _, n := range []int{1, 5, 11} {
+
+			data := newMatrices(n, rows, cols)
+
+			in, err := enc.EncryptTensorNew(data, 0, 3)
+			require.NoError(t, err)
+
+			out := convert(t, in, c)
+			require.NoError(t, out.Expect(split))
+			require.Equal(t, in.Samples, out.Samples)
+			require.Equal(t, DivIntCeil(n, 2), len(out.Cts))
+
+			have, err := dec.DecryptTensorNew(out)
+			require.NoError(t, err)
+
+			for i := range data {
+				hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, data[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			}
+		}
+	})
+
+	t.Run("SplitPadded", func(t *testing.T) {
+
+		heads := 4
+		padd := rows - cols/heads
+
+		data := newMatrices(5, rows, cols)
+
+		in, err := enc.EncryptTensorNew(data, 0, 2)
+		require.NoError(t, err)
+
+		layout, err := in.Split(heads, padd, 0)
+		require.NoError(t, err)
+
+		c, err := NewConversion(params, in.Layout, layout, 1)
+		require.NoError(t, err)
+
+		// The last ciphertext only stores one sample.
+		require.Equal(t, 2, len(c.Perms))
+		require.Equal(t, 3, c.Cost(params).LinearTransformations)
+
+		have, err := dec.DecryptTensorNew(convert(t, in, c))
+		require.NoError(t, err)
+
+		for i := range data {
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, data[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+
+	t.Run("Pool", func(t *testing.T) {
+
+		// Rows reduced to their first row, e.g. by an inner sum,
+		// packed into a single row per matrix as by the pooling.
+		n := 10
+		matPerCt := 3
+
+		data := newMatrices(n, 1, cols)
+
+		in, err := enc.EncryptTensorNew(data, (rows-1)*cols, matPerCt)
+		require.NoError(t, err)
+
+		layout, err := NewLayout(rows, cols, 0, matPerCt, n).Pool()
+		require.NoError(t, err)
+
+		c, err := NewConversion(params, in.Layout, layout, 0.5)
+		require.NoError(t, err)
+		require.Equal(t, layout.NumCts(), len(c.Terms))
+
+		cost := c.Cost(params)
+		require.Equal(t, in.NumCts(), cost.LinearTransformations)
+		require.Equal(t, in.NumCts()-layout.NumCts(), cost.Add)
+
+		out := convert(t, in, c)
+		require.NoError(t, out.Expect(layout))
+
+		have, err := dec.DecryptTensorNew(out)
+		require.NoError(t, err)
+
+		for i := range data {
+			want := mat.DenseCopyOf(data[i])
+			want.Scale(0.5, want)
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, want.RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+
+	t.Run("PeriodicPool", func(t *testing.T) {
+
+		// The first rows of rows ciphertexts packed in one as by Pool,
+		// which moves each input ciphertext with a single rotation.
+		matPerCt := 3
+
+		pooled, err := NewLayout(rows, cols, 0, matPerCt, matPerCt*rows).Pool()
+		require.NoError(t, err)
+
+		view := NewLayout(1, cols, 0, matPerCt, matPerCt*rows)
+		view.PaddRows = rows - 1
+
+		c, err := NewPeriodicConversion(params, view, pooled, 1)
+		require.NoError(t, err)
+		require.Equal(t, rows, len(c.Perms))
+
+		_, err = NewPeriodicConversion(params, view, NewLayout(1, cols, 0, matPerCt*rows, 1), 1)
+		require.Error(t, err)
+
+		n := 2*matPerCt*rows + 4
+
+		data := newMatrices(n, 1, cols)
+
+		in, err := enc.EncryptTensorNew(data, (rows-1)*cols, matPerCt)
+		require.NoError(t, err)
+
+		view.Samples = in.Samples
+		require.NoError(t, in.Relayout(view))
+
+		out := convert(t, in, c)
+
+		want, err := NewLayout(rows, cols, 0, matPerCt, n).Pool()
+		require.NoError(t, err)
+		require.NoError(t, out.Expect(want))
+		require.Equal(t, want.NumCts(), out.NumCts())
+		require.Equal(t, want.Samples[:len(out.Samples)], out.Samples)
+
+		have, err := dec.DecryptTensorNew(out)
+		require.NoError(t, err)
+
+		for i := range data {
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, data[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+
+	t.Run("Compact", func(t *testing.T) {
+
+		// 10 samples of one row, 3 per ciphertext, pooled in
+		// 2 ciphertexts of 6 rows, then compacted in one.
+		n := 10
+		matPerCt := 3
+
+		data := newMatrices(n, 1, cols)
+
+		pooled, err := NewLayout(2, cols, 0, matPerCt, n).Pool()
+		require.NoError(t, err)
+		require.Equal(t, 2, pooled.NumCts())
+
+		values := make([]*mat.Dense, len(pooled.Samples))
+		for i, s := range pooled.Samples {
+			if values[i] = mat.NewDense(1, cols, nil); s >= 0 {
+				values[i] = data[s]
+			}
+		}
+
+		cts, err := enc.EncryptNew(values, 0, pooled.MatPerCt)
+		require.NoError(t, err)
+
+		in, err := NewTensor(pooled, cts)
+		require.NoError(t, err)
+
+		layout := in.Compact(params)
+		require.Equal(t, 1, layout.NumCts())
+
+		c, err := NewConversion(params, in.Layout, layout, 1)
+		require.NoError(t, err)
+
+		out := convert(t, in, c)
+		require.NoError(t, out.Expect(layout))
+
+		have, err := dec.DecryptTensorNew(out)
+		require.NoError(t, err)
+
+		for i := range data {
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, data[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+
+		in := NewLayout(rows, cols, 0, 2, 5)
+
+		_, err := NewConversion(params, in, NewLayout(rows, cols/2, 0, 2, 5), 1)
+		require.Error(t, err)
+
+		_, err = NewConversion(params, in, NewLayout(rows, cols, 0, 2, 6), 1)
+		require.Error(t, err)
+	})
+}
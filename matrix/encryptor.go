@@ -14,15 +14,17 @@ type Encryptor struct {
 	*hefloat.Encoder
 }
 
-func NewEncryptor(params hefloat.Parameters, sk *rlwe.SecretKey) (enc *Encryptor) {
+// NewEncryptor returns an Encryptor under the given key, which can be a
+// *rlwe.SecretKey or a *rlwe.PublicKey.
+func NewEncryptor(params hefloat.Parameters, key rlwe.EncryptionKey) (enc *Encryptor) {
 	return &Encryptor{
-		Encryptor: rlwe.NewEncryptor(params, sk),
+		Encryptor: rlwe.NewEncryptor(params, key),
 		Encoder:   hefloat.NewEncoder(params),
 	}
 }
 
-func (enc *Encryptor) WithKey(sk *rlwe.SecretKey) *Encryptor {
-	return &Encryptor{Encryptor: enc.Encryptor.WithKey(sk), Encoder: enc.Encoder}
+func (enc *Encryptor) WithKey(key rlwe.EncryptionKey) *Encryptor {
+	return &Encryptor{Encryptor: enc.Encryptor.WithKey(key), Encoder: enc.Encoder}
 }
 
 func (enc *Encryptor) EncryptNew(in []*mat.Dense, padd, matPerCt int) (cts []rlwe.Ciphertext, err error) {
@@ -1,5 +1,5 @@
 package matrix
-/*
+
 import (
 	"fmt"
 	"testing"
@@ -16,13 +16,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 )
-*/
 
-/*
 func TestSplitAndMerge(t *testing.T) {
 
 	params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
-		LogN:            13,
+		LogN:            11,
 		LogQ:            []int{60, 45, 45},
 		LogP:            []int{60},
 		LogDefaultScale: 45,
@@ -31,94 +29,114 @@ func TestSplitAndMerge(t *testing.T) {
 	require.NoError(t, err)
 	tc := newTestContext(params)
 
-	kgen := tc.kgen
-	sk := tc.sk
+	enc := NewEncryptor(params, tc.sk)
+	dec := NewDecryptor(params, tc.sk)
 
-	enc := NewEncryptor(params, sk)
-	dec := NewDecryptor(params, sk)
+	rows := 10
+	cols := 32
 
-	rows := 25
-	cols := 64
-	split := 4
-	padd := rows - cols/split
+	// Heads per ciphertext after the split, 0 for the heads
+	// of as many samples as the slots can store.
+	for _, hc := range []struct{ split, matPerCt int }{{1, 0}, {2, 0}, {4, 0}, {8, 0}, {16, 0}, {16, 4}} {
 
-	eval := NewEvaluator(params, rows, tc.eval.WithKey(rlwe.NewMemEvaluationKeySet(nil, gks...)))
+		split := hc.split
 
-	ltSplit, err := eval.NewLinearTransformation(
-		params,
-		params.MaxLevel(),
-		params.DefaultScale(),
-		params.DefaultScale(),
-		false,
-		SplitDiagonals(params, rows, cols, split, padd, 1),
-		tc.ecd)
+		t.Run(fmt.Sprintf("Heads=%d/MatPerCt=%d", split, hc.matPerCt), func(t *testing.T) {
 
-	require.NoError(t, err)
+			// Heads narrower than rows are padded to rows x rows,
+			// wider heads to (cols/split) x (cols/split).
+			dims := max(rows, cols/split)
+			padd := dims - cols/split
 
-	ltMerge, err := eval.NewLinearTransformation(
-		params,
-		params.MaxLevel()-1,
-		params.DefaultScale(),
-		params.DefaultScale(),
-		false,
-		MergeDiagonals(params, rows, cols, split, padd, 1),
-		tc.ecd)
+			n := params.MaxSlots() / (split * dims * dims)
+			require.Greater(t, n, 0)
 
-	galEls := append(ltSplit.GaloisElements(params), ltMerge.GaloisElements(params)...)
+			layout := NewLayout(rows, cols, 0, n, 0)
 
-	m := map[uint64]bool{}
-	for _, galEl := range galEls {
-		m[galEl] = true
-	}
+			heads, err := layout.Split(split, padd, dims-rows)
+			require.NoError(t, err)
 
-	fmt.Println("galoisElements", len(m))
+			// The heads of a sample span several ciphertexts.
+			if hc.matPerCt != 0 {
+				n = 2
+				layout.MatPerCt = 1
+				heads.MatPerCt = hc.matPerCt
+			}
 
-	gks := kgen.GenGaloisKeysNew(maps.Keys(m), sk)
+			require.NoError(t, heads.Validate(params))
 
-	n := 2
+			cSplit, err := NewPeriodicConversion(params, layout, heads, 1)
+			require.NoError(t, err)
 
-	r := sampling.NewSource([32]byte{})
+			cMerge, err := NewPeriodicConversion(params, heads, layout, 1)
+			require.NoError(t, err)
 
-	in := make([]*mat.Dense, n)
-	for i := range in {
-		m := make([]float64, rows*cols)
-		for j := range m {
-			m[j] = r.Float64(-0.1, 0.1)
-		}
-		in[i] = mat.NewDense(rows, cols, m)
-	}
+			m := map[uint64]bool{}
+			for _, galEl := range cSplit.GaloisElements(params) {
+				m[galEl] = true
+			}
+			for _, galEl := range cMerge.GaloisElements(params) {
+				m[galEl] = true
+			}
 
-	ct, err := enc.EncryptNew(in, 0, n)
-	require.NoError(t, err)
+			gks := tc.kgen.GenGaloisKeysNew(maps.Keys(m), tc.sk)
 
-	
-	now := time.Now()
-	require.NoError(t, eval.EvaluateLinearTransformation(ct, ltSplit, ct))
-	require.NoError(t, eval.Rescale(ct, ct))
-	fmt.Println(time.Since(now))
+			eval := NewEvaluator(params, dims, []*hefloat.Evaluator{tc.eval.WithKey(rlwe.NewMemEvaluationKeySet(nil, gks...))})
 
-	have, err := dec.DecryptNew(ct, rows, cols/split, padd, n*split)
-	require.NoError(t, err)
+			ltSplit, err := eval.NewConversionLinearTransformations(params.MaxLevel(), params.DefaultScale(), params.DefaultScale(), cSplit)
+			require.NoError(t, err)
 
-	want := []*mat.Dense{}
-	for i := range in {
-		want = append(want, SplitHeads(in[i], split)...)
-	}
+			ltMerge, err := eval.NewConversionLinearTransformations(params.MaxLevel()-1, params.DefaultScale(), params.DefaultScale(), cMerge)
+			require.NoError(t, err)
 
-	for i := range have {
-		hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, want[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
-	}
+			r := sampling.NewSource([32]byte{})
 
-	now = time.Now()
-	require.NoError(t, eval.EvaluateLinearTransformation(ct, ltMerge, ct))
-	require.NoError(t, eval.Rescale(ct, ct))
-	fmt.Println(time.Since(now))
+			in := make([]*mat.Dense, n)
+			for i := range in {
+				m := make([]float64, rows*cols)
+				for j := range m {
+					m[j] = r.Float64(-0.1, 0.1)
+				}
+				in[i] = mat.NewDense(rows, cols, m)
+			}
 
-	have, err = dec.DecryptNew(ct, rows, cols, 0, n)
-	require.NoError(t, err)
+			ct, err := enc.EncryptTensorNew(in, 0, layout.MatPerCt)
+			require.NoError(t, err)
+
+			now := time.Now()
+			ct, err = eval.Convert(ct, cSplit, ltSplit)
+			require.NoError(t, err)
+			require.NoError(t, eval.Rescale(ct.Cts, ct.Cts))
+			fmt.Println(time.Since(now))
+			require.NoError(t, ct.Expect(heads))
+
+			// Each head is a dims x dims matrix whose
+			// first rows x cols/split entries are the head.
+			have, err := dec.DecryptNew(ct.Cts, dims, cols/split, padd, heads.MatPerCt)
+			require.NoError(t, err)
+
+			want := []*mat.Dense{}
+			for i := range in {
+				want = append(want, SplitHeads(in[i], split)...)
+			}
+
+			for i := range want {
+				head := mat.DenseCopyOf(have[i].Slice(0, rows, 0, cols/split))
+				hefloat.VerifyTestVectors(params, tc.ecd, nil, head.RawMatrix().Data, want[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			}
+
+			now = time.Now()
+			ct, err = eval.Convert(ct, cMerge, ltMerge)
+			require.NoError(t, err)
+			require.NoError(t, eval.Rescale(ct.Cts, ct.Cts))
+			fmt.Println(time.Since(now))
+
+			merged, err := dec.DecryptTensorNew(ct)
+			require.NoError(t, err)
 
-	for i := range in {
-		hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, in[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			for i := range in {
+				hefloat.VerifyTestVectors(params, tc.ecd, nil, merged[i].RawMatrix().Data, MergeHeads(SplitHeads(in[i], split)).RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			}
+		})
 	}
 }
-*/
\ No newline at end of file
@@ -278,6 +278,95 @@ func TestMatrices(t *testing.T) {
 		}
 	})
 
+	t.Run("CtxCtRect", func(t *testing.T) {
+
+		// M and P are padded to 40, N is not.
+		require.Equal(t, 20, TileDims(params, 40, 20, 36, 2))
+
+		// Smaller tiles pack more matrices per ciphertext.
+		require.Equal(t, 4, TileDims(params, 12, 12, 12, 64))
+
+		for _, dims := range [][3]int{{7, 12, 5}, {40, 20, 36}} {
+
+			M, N, P := dims[0], dims[1], dims[2]
+
+			t.Run(fmt.Sprintf("%dx%dx%d", M, N, P), func(t *testing.T) {
+
+				n := 2
+
+				tile := TileDims(params, M, N, P, n)
+
+				galEls := RectMulParametersGaloisElements(params, tile)
+
+				rlk := tc.kgen.GenRelinearizationKeyNew(tc.sk)
+				gks := tc.kgen.GenGaloisKeysNew(galEls, tc.sk)
+
+				eval := NewEvaluator(params, M, []*hefloat.Evaluator{tc.eval.WithKey(rlwe.NewMemEvaluationKeySet(rlk, gks...))})
+
+				mulParams, err := eval.NewRectMulParameters(params.MaxLevel(), M, N, P, tile, 1.0, params.DefaultScale(), params.DefaultScale())
+				require.NoError(t, err)
+
+				r := sampling.NewSource([32]byte{})
+
+				in := make([]*mat.Dense, n)
+				for i := range in {
+					m := make([]float64, M*N)
+					for j := range m {
+						m[j] = r.Float64(-0.1, 0.1)
+					}
+					in[i] = mat.NewDense(M, N, m)
+				}
+
+				w := make([]*mat.Dense, n)
+				for i := range w {
+					m := make([]float64, N*P)
+					for j := range m {
+						m[j] = r.Float64(-0.1, 0.1)
+					}
+					w[i] = mat.NewDense(N, P, m)
+				}
+
+				ct0, err := enc.EncryptTiledNew(in, mulParams.Tile)
+				require.NoError(t, err)
+
+				ct1, err := enc.EncryptTiledNew(w, mulParams.Tile)
+				require.NoError(t, err)
+
+				now := time.Now()
+				ct2, err := eval.MulCtRect(ct0, ct1, mulParams)
+				require.NoError(t, err)
+				for i := range ct2 {
+					for j := range ct2[i] {
+						require.NoError(t, eval.Rescale(ct2[i][j], ct2[i][j]))
+					}
+				}
+				fmt.Println(time.Since(now))
+
+				have, err := dec.DecryptTiledNew(ct2, M, P, mulParams.Tile, n)
+				require.NoError(t, err)
+
+				// Empty or mismatched operands are errors.
+				_, err = enc.EncryptTiledNew(nil, mulParams.Tile)
+				require.Error(t, err)
+				_, err = enc.EncryptTiledNew([]*mat.Dense{in[0], w[0]}, mulParams.Tile)
+				require.Error(t, err)
+				_, err = eval.MulCtRect(Tiled{}, ct1, mulParams)
+				require.Error(t, err)
+				_, err = eval.MulCtRect(ct0, Tiled{{}}, mulParams)
+				require.Error(t, err)
+				empty := Tiled{append([][]rlwe.Ciphertext{nil}, ct1[0][1:]...)}
+				_, err = eval.MulCtRect(ct0, append(empty, ct1[1:]...), mulParams)
+				require.Error(t, err)
+
+				want := mat.NewDense(M, P, make([]float64, M*P))
+				for i := range n {
+					want.Mul(in[i], w[i])
+					hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, want.RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+				}
+			})
+		}
+	})
+
 	t.Run("CtxPt", func(t *testing.T) {
 
 		rows := 25
@@ -330,4 +419,113 @@ func TestMatrices(t *testing.T) {
 			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, want.RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 		}
 	})
+
+	t.Run("CtxPtTiled", func(t *testing.T) {
+
+		rows := 25
+		block := 16
+		colsIn := 32
+		colsOut := 40
+
+		matPerCt := params.MaxSlots() / (rows * block)
+
+		galEls := TiledWeightsGaloisElements(params, block)
+
+		gks := tc.kgen.GenGaloisKeysNew(galEls, tc.sk)
+
+		eval := NewEvaluator(params, rows, []*hefloat.Evaluator{tc.eval.WithKey(rlwe.NewMemEvaluationKeySet(nil, gks...))})
+
+		n := 4
+
+		r := sampling.NewSource([32]byte{})
+
+		in := make([]*mat.Dense, n)
+		for i := range in {
+			m := make([]float64, rows*colsIn)
+			for j := range m {
+				m[j] = r.Float64(-0.1, 0.1)
+			}
+			in[i] = mat.NewDense(rows, colsIn, m)
+		}
+
+		w := mat.NewDense(colsIn, colsOut, make([]float64, colsIn*colsOut))
+		for j := range colsIn * colsOut {
+			w.RawMatrix().Data[j] = r.Float64(-0.1, 0.1)
+		}
+
+		tw := NewTiledWeights(w, block)
+		blocksIn, blocksOut := tw.Dims()
+
+		split := make([][]*mat.Dense, blocksIn)
+		for k := range split {
+			split[k] = make([]*mat.Dense, n)
+		}
+
+		for i := range in {
+			for k, m := range SplitHeads(in[i], blocksIn) {
+				split[k][i] = m
+			}
+		}
+
+		ct := make([][]rlwe.Ciphertext, blocksIn)
+		for k := range ct {
+			ct[k], err = enc.EncryptNew(split[k], 0, matPerCt)
+			require.NoError(t, err)
+		}
+
+		now := time.Now()
+		out, err := eval.MulTiledPt(ct, tw, params.DefaultScale())
+		require.NoError(t, err)
+		for l := range out {
+			require.NoError(t, eval.Rescale(out[l], out[l]))
+		}
+		fmt.Println(time.Since(now))
+
+		blocks := make([][]*mat.Dense, blocksOut)
+		for l := range blocks {
+			blocks[l], err = dec.DecryptNew(out[l], rows, block, 0, matPerCt)
+			require.NoError(t, err)
+		}
+
+		want := mat.NewDense(rows, colsOut, make([]float64, rows*colsOut))
+		for i := range n {
+
+			merged := make([]*mat.Dense, blocksOut)
+			for l := range merged {
+				merged[l] = blocks[l][i]
+			}
+
+			have := mat.DenseCopyOf(MergeHeads(merged).Slice(0, rows, 0, colsOut))
+
+			want.Mul(in[i], w)
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have.RawMatrix().Data, want.RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+
+		// Block by block: out[l] = sum_k in[k] x w[k][l].
+		for l := range blocksOut {
+
+			var acc []rlwe.Ciphertext
+			for k := range blocksIn {
+
+				var part [][]rlwe.Ciphertext
+				part, err = eval.MulTiledPt(ct[k:k+1], tw.InBlock(k).OutBlock(l), params.DefaultScale())
+				require.NoError(t, err)
+
+				if k == 0 {
+					acc = part[0]
+				} else {
+					require.NoError(t, eval.AddCt(acc, part[0], acc))
+				}
+			}
+
+			require.NoError(t, eval.Rescale(acc, acc))
+
+			have, err := dec.DecryptNew(acc, rows, block, 0, matPerCt)
+			require.NoError(t, err)
+
+			for i := range n {
+				hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, blocks[l][i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			}
+		}
+	})
 }
@@ -2,45 +2,9 @@ package matrix
 
 import (
 	"gonum.org/v1/gonum/mat"
-
-	"github.com/Pro7ech/lattigo/he"
-	"github.com/Pro7ech/lattigo/he/hefloat"
 )
 
-func MergeDiagonals(params hefloat.Parameters, rows, cols, split, padd int, scaling float64) (perm he.Diagonals[float64]) {
-	return mergePermutation(params, rows, cols, split, padd, scaling).Diagonals(params.LogMaxDimensions())
-}
-
-func MergeIndexes(params hefloat.Parameters, rows, cols, split, padd int) (indexes []int) {
-	return mergePermutation(params, rows, cols, split, padd, 1).Indexes(params.LogMaxDimensions())
-}
-
-func MergeGaloisElements(params hefloat.Parameters, rows, cols, split, padd int) (galEls []uint64) {
-	return mergePermutation(params, rows, cols, split, padd, 1).GaloisElements(params, params.LogMaxDimensions())
-}
-
-func mergePermutation(params hefloat.Parameters, rows, cols, split, padd int, scaling float64) (perm he.Permutation[float64]) {
-	flatten := split * rows * (cols/split + padd)
-
-	nbmatrices := params.MaxSlots() / flatten
-
-	perm = he.NewPermutation[float64](nbmatrices * rows * cols)
-
-	for d := range nbmatrices {
-		for i := range rows {
-			for j := range split {
-				for k := range cols / split {
-					idx := i*cols + j*(cols/split) + k + d*rows*cols
-					perm[idx].X = k + i*(cols/split+padd) + j*(rows*(cols/split+padd)) + d*flatten
-					perm[idx].Y = idx
-					perm[idx].C = scaling
-				}
-			}
-		}
-	}
-	return perm
-}
-
+// MergeHeads is the inverse of SplitHeads.
 func MergeHeads(in []*mat.Dense) (out *mat.Dense) {
 
 	rows, cols := in[0].Dims()
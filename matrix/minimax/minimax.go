@@ -0,0 +1,239 @@
+// Package minimax generates, validates and caches minimax composite
+// polynomials approximating sign(x), which are used by the ReLU and
+// max evaluators.
+package minimax
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+
+	"app/utils"
+)
+
+// Polynomials are the pre-computed composite polynomials shipped with the repository.
+//
+//go:embed polynomials/*.json
+var polynomials embed.FS
+
+// NbSamples is the number of points on which the error of a composite polynomial is measured.
+var NbSamples = 1 << 16
+
+// Parameters are the parameters of a minimax composite polynomial approximation of
+// sign(x) over [-1, -2^{-LogAlpha}] U [2^{-LogAlpha}, 1].
+// See hefloat.GenMinimaxCompositePolynomial.
+type Parameters struct {
+	Prec     uint  // Bit-precision used by the multi-interval Remez algorithm
+	LogAlpha int   // log2 of the distinguishing precision
+	LogErr   int   // -log2 of the target error, and of the upper bound on the scheme error
+	Degrees  []int // Degree of each polynomial, in order of evaluation
+}
+
+// Name returns the name under which the composite polynomial is cached,
+// e.g. sign_p512_a10_e15_d255-63.
+func (p Parameters) Name() string {
+	degrees := make([]string, len(p.Degrees))
+	for i := range degrees {
+		degrees[i] = strconv.Itoa(p.Degrees[i])
+	}
+	return fmt.Sprintf("sign_p%d_a%d_e%d_d%s", p.Prec, p.LogAlpha, p.LogErr, strings.Join(degrees, "-"))
+}
+
+// Polynomial is a minimax composite polynomial approximation of sign(x).
+type Polynomial struct {
+	Parameters
+	LogMaxErr float64    // log2 of the maximum error measured on the interval
+	Coeffs    [][]string // Coefficients in the Chebyshev basis over [-1, 1]
+}
+
+// CoeffsString returns the coefficients as strings,
+// as expected by hefloat.NewMinimaxCompositePolynomial.
+func (p Polynomial) CoeffsString() [][]string {
+	return p.Coeffs
+}
+
+// CoeffsFloat returns the coefficients as float64,
+// as expected by utils.CompositeEval.
+func (p Polynomial) CoeffsFloat() (coeffs [][]float64) {
+	coeffs = make([][]float64, len(p.Coeffs))
+	for i := range coeffs {
+		coeffs[i] = make([]float64, len(p.Coeffs[i]))
+		for j := range coeffs[i] {
+			coeffs[i][j], _ = strconv.ParseFloat(p.Coeffs[i][j], 64)
+		}
+	}
+	return
+}
+
+// MaxErr returns the maximum error |P(x) - sign(x)|
+// on [-1, -2^{-LogAlpha}] U [2^{-LogAlpha}, 1].
+func (p Polynomial) MaxErr() (maxErr float64) {
+
+	coeffs := p.CoeffsFloat()
+
+	alpha := math.Exp2(-float64(p.LogAlpha))
+
+	for i := 0; i < NbSamples; i++ {
+		x := alpha + (1-alpha)*float64(i)/float64(NbSamples-1)
+		maxErr = max(maxErr, math.Abs(utils.CompositeEval(coeffs, -1, 1, x)-1))
+		maxErr = max(maxErr, math.Abs(utils.CompositeEval(coeffs, -1, 1, -x)+1))
+	}
+
+	return
+}
+
+// Validate checks that the composite polynomial matches its parameters
+// and that its maximum error on [-1, -2^{-LogAlpha}] U [2^{-LogAlpha}, 1]
+// is not larger than the target 2^{-LogErr}.
+func (p Polynomial) Validate() (err error) {
+
+	if len(p.Coeffs) != len(p.Degrees) {
+		return fmt.Errorf("invalid polynomial: #polynomials=%d != #degrees=%d", len(p.Coeffs), len(p.Degrees))
+	}
+
+	for i := range p.Coeffs {
+		if len(p.Coeffs[i]) != p.Degrees[i]+1 {
+			return fmt.Errorf("invalid polynomial[%d]: #coefficients=%d != degree+1=%d", i, len(p.Coeffs[i]), p.Degrees[i]+1)
+		}
+	}
+
+	if logMaxErr := math.Log2(p.MaxErr()); logMaxErr > -float64(p.LogErr) {
+		return fmt.Errorf("invalid polynomial: log2(MaxErr)=%f > -LogErr=%d", logMaxErr, -p.LogErr)
+	}
+
+	return
+}
+
+// Generate generates the composite polynomial for the given parameters
+// and validates it, so that it returns an error if the degrees are too
+// small to reach the target error. This can take several minutes for
+// large degrees.
+func Generate(p Parameters) (poly Polynomial, err error) {
+
+	if len(p.Degrees) == 0 {
+		return poly, fmt.Errorf("invalid parameters: no degree")
+	}
+
+	coeffs := hefloat.GenMinimaxCompositePolynomial(p.Prec, p.LogAlpha, p.LogErr, p.Degrees, bignum.Sign)
+
+	poly.Parameters = p
+	poly.Coeffs = make([][]string, len(coeffs))
+	for i := range coeffs {
+		poly.Coeffs[i] = make([]string, len(coeffs[i]))
+		for j := range coeffs[i] {
+			poly.Coeffs[i][j] = formatCoeff(&coeffs[i][j])
+		}
+	}
+
+	poly.LogMaxErr = math.Log2(poly.MaxErr())
+
+	if err = poly.Validate(); err != nil {
+		return poly, fmt.Errorf("[Polynomial][Validate][%s]: %w", p.Name(), err)
+	}
+
+	return
+}
+
+// formatCoeff formats a coefficient with enough decimals to
+// be exactly recovered as a float64.
+func formatCoeff(c *big.Float) string {
+	if c.Sign() == 0 {
+		return "0"
+	}
+	return c.Text('g', 17)
+}
+
+// Load loads the composite polynomial with the given name, first from
+// the directory dir (if not empty) and then from the pre-computed polynomials.
+// The polynomial is validated before being returned.
+func Load(dir, name string) (poly Polynomial, err error) {
+
+	var data []byte
+
+	if dir != "" {
+		data, err = os.ReadFile(filepath.Join(dir, name+".json"))
+	}
+
+	if dir == "" || errors.Is(err, fs.ErrNotExist) {
+		data, err = polynomials.ReadFile("polynomials/" + name + ".json")
+	}
+
+	if err != nil {
+		return poly, fmt.Errorf("[minimax.Load][%s]: %w", name, err)
+	}
+
+	if err = json.Unmarshal(data, &poly); err != nil {
+		return poly, fmt.Errorf("[json.Unmarshal][%s]: %w", name, err)
+	}
+
+	if poly.Name() != name {
+		return poly, fmt.Errorf("[minimax.Load][%s]: name does not match parameters %s", name, poly.Name())
+	}
+
+	if err = poly.Validate(); err != nil {
+		return poly, fmt.Errorf("[Polynomial][Validate][%s]: %w", name, err)
+	}
+
+	return
+}
+
+// MustLoad is identical to Load with an empty directory, but panics on error.
+func MustLoad(name string) (poly Polynomial) {
+	var err error
+	if poly, err = Load("", name); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Store writes the composite polynomial in dir under its name.
+func Store(dir string, poly Polynomial) (err error) {
+
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("os.MkdirAll(%s): %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(poly, "", "\t")
+	if err != nil {
+		return fmt.Errorf("json.MarshalIndent: %w", err)
+	}
+
+	path := filepath.Join(dir, poly.Name()+".json")
+
+	if err = os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("os.WriteFile(%s): %w", path, err)
+	}
+
+	return
+}
+
+// LoadOrGenerate loads the composite polynomial for the given parameters
+// from dir or the pre-computed polynomials if it exists, else generates
+// and stores it in dir.
+func LoadOrGenerate(dir string, p Parameters) (poly Polynomial, err error) {
+
+	if poly, err = Load(dir, p.Name()); err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+
+	if poly, err = Generate(p); err != nil {
+		return poly, fmt.Errorf("[minimax.Generate]: %w", err)
+	}
+
+	if err = Store(dir, poly); err != nil {
+		return poly, fmt.Errorf("[minimax.Store]: %w", err)
+	}
+
+	return
+}
@@ -0,0 +1,45 @@
+package minimax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinimax(t *testing.T) {
+
+	t.Run("Load", func(t *testing.T) {
+		for _, name := range []string{"sign_p512_a6_e15_d31-31", "sign_p512_a10_e15_d255-63", "sign_p512_a3_e3_d15", "sign_p512_a5_e7_d127"} {
+			poly, err := Load(t.TempDir(), name)
+			require.NoError(t, err)
+			require.Equal(t, name, poly.Name())
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		poly, err := Load("", "sign_p512_a6_e15_d31-31")
+		require.NoError(t, err)
+		require.NoError(t, poly.Validate())
+		poly.LogErr = 20
+		require.Error(t, poly.Validate())
+		poly.LogErr = 15
+		poly.Coeffs[0][1] = "0.8"
+		require.Error(t, poly.Validate())
+	})
+
+	t.Run("Generate", func(t *testing.T) {
+		_, err := Generate(Parameters{Prec: 128, LogAlpha: 4, LogErr: 10, Degrees: []int{15}})
+		require.Error(t, err)
+	})
+
+	t.Run("LoadOrGenerate", func(t *testing.T) {
+		dir := t.TempDir()
+		p := Parameters{Prec: 128, LogAlpha: 2, LogErr: 6, Degrees: []int{15}}
+		poly, err := LoadOrGenerate(dir, p)
+		require.NoError(t, err)
+		require.NoError(t, poly.Validate())
+		cached, err := Load(dir, p.Name())
+		require.NoError(t, err)
+		require.Equal(t, poly, cached)
+	})
+}
@@ -28,13 +28,22 @@ func (p MulParameters) Dimensions() int {
 }
 
 func (eval *Evaluator) NewMulParameters(LevelQ int, scaling float64, transposeLeft, transposeRight bool, inputAScale, inputBScale rlwe.Scale) (p *MulParameters, err error) {
+	return eval.NewMulParametersWithDims(LevelQ, eval.dims, scaling, transposeLeft, transposeRight, inputAScale, inputBScale)
+}
+
+// NewMulParametersWithDims is identical to NewMulParameters, but for dims x dims
+// matrices instead of the dimension of the evaluator.
+func (eval *Evaluator) NewMulParametersWithDims(LevelQ, dims int, scaling float64, transposeLeft, transposeRight bool, inputAScale, inputBScale rlwe.Scale) (p *MulParameters, err error) {
 
 	if LevelQ < 3 {
 		return nil, fmt.Errorf("invalid LevelQ: must be greater than 3 but is %d", LevelQ)
 	}
 
 	params := eval.Evaluators[0].Parameters()
-	dims := eval.dims
+
+	if dims*dims > params.MaxSlots() {
+		return nil, fmt.Errorf("invalid dims: dims^2=%d > slots=%d", dims*dims, params.MaxSlots())
+	}
 
 	ecds := make([]*hefloat.Encoder, len(eval.Evaluators))
 	for i := range ecds {
@@ -0,0 +1,328 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// RectMulParameters are the parameters for the product of an
+// M x N matrix by an N x P matrix.
+// Each dimension of the operands is zero-padded to a multiple of Tile
+// and the operands are split into Tile x Tile blocks, which are
+// multiplied with the square algorithm.
+type RectMulParameters struct {
+	M, N, P int
+	Tile    int
+	*MulParameters
+}
+
+// MaxTileDims returns the largest tile dimension T such that
+// a T x T matrix fits in a ciphertext.
+func MaxTileDims(params hefloat.Parameters) int {
+	return int(math.Sqrt(float64(params.MaxSlots())))
+}
+
+// TileDims returns the tile dimension T minimizing the cost of the
+// product of n M x N matrices by n N x P matrices, each dimension being
+// padded independently to a multiple of T.
+// The cost is estimated as the number of T x T block products,
+// ceil(M/T) * ceil(N/T) * ceil(P/T), times their 3T-2 key-switches
+// (T-1 rotations of the rows, T-1 of the columns and T relinearizations),
+// times the number of ciphertexts storing the n matrices of a block,
+// ceil(n * T^2 / slots). Ties go to the largest tile.
+func TileDims(params hefloat.Parameters, M, N, P, n int) (tile int) {
+	slots := params.MaxSlots()
+	cost := math.MaxInt
+	for T := min(max(M, N, P), MaxTileDims(params)); T > 0; T-- {
+		if c := DivIntCeil(M, T) * DivIntCeil(N, T) * DivIntCeil(P, T) * (3*T - 2) * DivIntCeil(n*T*T, slots); c < cost {
+			tile, cost = T, c
+		}
+	}
+	return
+}
+
+// NewRectMulParameters returns the parameters for the product of an
+// M x N matrix by an N x P matrix split into tile x tile blocks,
+// e.g. with the tile given by [TileDims].
+// See [Evaluator.NewMulParameters] for the other arguments.
+func (eval *Evaluator) NewRectMulParameters(LevelQ, M, N, P, tile int, scaling float64, inputAScale, inputBScale rlwe.Scale) (p *RectMulParameters, err error) {
+
+	if M < 1 || N < 1 || P < 1 {
+		return nil, fmt.Errorf("invalid dimensions: %dx%d times %dx%d", M, N, N, P)
+	}
+
+	if tile < 1 {
+		return nil, fmt.Errorf("invalid tile: %d", tile)
+	}
+
+	var mulParams *MulParameters
+	if mulParams, err = eval.NewMulParametersWithDims(LevelQ, tile, scaling, false, false, inputAScale, inputBScale); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator].NewMulParametersWithDims: %w", err)
+	}
+
+	return &RectMulParameters{
+		M:             M,
+		N:             N,
+		P:             P,
+		Tile:          tile,
+		MulParameters: mulParams,
+	}, nil
+}
+
+// RectMulParametersGaloisElements returns the Galois elements required
+// for the product of matrices split into tile x tile blocks.
+func RectMulParametersGaloisElements(params hefloat.Parameters, tile int) (galEls []uint64) {
+	return MulParametersGaloisElements(params, tile, false, false)
+}
+
+// MatPerCt returns the number of tiles packed in a ciphertext.
+func (p RectMulParameters) MatPerCt(params hefloat.Parameters) int {
+	return params.MaxSlots() / (p.Tile * p.Tile)
+}
+
+// Tiled is a batch of encrypted matrices split into square tiles.
+// Tiled[i][j] stores the tile (i, j) of all the matrices of the batch.
+type Tiled [][][]rlwe.Ciphertext
+
+// Level returns the minimum level of the tiles.
+func (t Tiled) Level() (level int) {
+	level = math.MaxInt
+	for i := range t {
+		for j := range t[i] {
+			for k := range t[i][j] {
+				level = min(level, t[i][j][k].Level())
+			}
+		}
+	}
+	return
+}
+
+// check returns the number of ciphertexts per tile of t, or an error
+// if t does not have rows x cols tiles of the same, non-zero, number
+// of ciphertexts.
+func (t Tiled) check(rows, cols int) (n int, err error) {
+
+	if len(t) != rows {
+		return 0, fmt.Errorf("expected %dx%d tiles but has %d rows of tiles", rows, cols, len(t))
+	}
+
+	for i := range t {
+
+		if len(t[i]) != cols {
+			return 0, fmt.Errorf("expected %dx%d tiles but row %d has %d tiles", rows, cols, i, len(t[i]))
+		}
+
+		for j := range t[i] {
+
+			if i == 0 && j == 0 {
+				n = len(t[i][j])
+			}
+
+			if len(t[i][j]) == 0 || len(t[i][j]) != n {
+				return 0, fmt.Errorf("tile (%d, %d) has %d ciphertexts but tile (0, 0) has %d", i, j, len(t[i][j]), n)
+			}
+		}
+	}
+
+	return
+}
+
+// Tile zero-pads the matrix to a multiple of tile and
+// splits it into tile x tile blocks.
+func Tile(in *mat.Dense, tile int) (out [][]*mat.Dense) {
+
+	rows, cols := in.Dims()
+
+	out = make([][]*mat.Dense, DivIntCeil(rows, tile))
+	for i := range out {
+		out[i] = make([]*mat.Dense, DivIntCeil(cols, tile))
+		for j := range out[i] {
+			out[i][j] = mat.NewDense(tile, tile, nil)
+			for k := range min(tile, rows-i*tile) {
+				for l := range min(tile, cols-j*tile) {
+					out[i][j].Set(k, l, in.At(i*tile+k, j*tile+l))
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// Untile is the inverse of Tile: it assembles the blocks and
+// removes the padding to return a rows x cols matrix.
+func Untile(in [][]*mat.Dense, rows, cols int) (out *mat.Dense) {
+
+	tile, _ := in[0][0].Dims()
+
+	out = mat.NewDense(rows, cols, nil)
+	for i := range rows {
+		for j := range cols {
+			out.Set(i, j, in[i/tile][j/tile].At(i%tile, j%tile))
+		}
+	}
+
+	return
+}
+
+// EncryptTiledNew zero-pads and splits the matrices into tile x tile
+// blocks and encrypts each block position separately.
+// It returns an error if in is empty or if its matrices differ in shape.
+func (enc *Encryptor) EncryptTiledNew(in []*mat.Dense, tile int) (ct Tiled, err error) {
+
+	params := enc.Parameters()
+
+	if len(in) == 0 {
+		return nil, fmt.Errorf("invalid input: no matrices")
+	}
+
+	rows, cols := in[0].Dims()
+	for i := range in {
+		if r, c := in[i].Dims(); r != rows || c != cols {
+			return nil, fmt.Errorf("invalid input: matrix %d is %dx%d but matrix 0 is %dx%d", i, r, c, rows, cols)
+		}
+	}
+
+	if tile < 1 {
+		return nil, fmt.Errorf("invalid tile: %d", tile)
+	}
+
+	if tile*tile > params.MaxSlots() {
+		return nil, fmt.Errorf("invalid tile: tile^2=%d > slots=%d", tile*tile, params.MaxSlots())
+	}
+
+	tiles := make([][][]*mat.Dense, len(in))
+	for i := range in {
+		tiles[i] = Tile(in[i], tile)
+	}
+
+	matPerCt := params.MaxSlots() / (tile * tile)
+
+	ct = make(Tiled, len(tiles[0]))
+	for i := range ct {
+		ct[i] = make([][]rlwe.Ciphertext, len(tiles[0][i]))
+		for j := range ct[i] {
+
+			blocks := make([]*mat.Dense, len(in))
+			for k := range in {
+				blocks[k] = tiles[k][i][j]
+			}
+
+			if ct[i][j], err = enc.EncryptNew(blocks, 0, matPerCt); err != nil {
+				return nil, fmt.Errorf("[matrix.Encryptor].EncryptNew: %w", err)
+			}
+		}
+	}
+
+	return
+}
+
+// DecryptTiledNew decrypts n rows x cols matrices that were
+// split into tile x tile blocks.
+func (dec *Decryptor) DecryptTiledNew(ct Tiled, rows, cols, tile, n int) (out []*mat.Dense, err error) {
+
+	matPerCt := dec.Parameters().MaxSlots() / (tile * tile)
+
+	tiles := make([][][]*mat.Dense, n)
+	for k := range tiles {
+		tiles[k] = make([][]*mat.Dense, len(ct))
+		for i := range ct {
+			tiles[k][i] = make([]*mat.Dense, len(ct[i]))
+		}
+	}
+
+	for i := range ct {
+		for j := range ct[i] {
+
+			var blocks []*mat.Dense
+			if blocks, err = dec.DecryptNew(ct[i][j], tile, tile, 0, matPerCt); err != nil {
+				return nil, fmt.Errorf("[matrix.Decryptor].DecryptNew: %w", err)
+			}
+
+			for k := range n {
+				tiles[k][i][j] = blocks[k]
+			}
+		}
+	}
+
+	out = make([]*mat.Dense, n)
+	for k := range out {
+		out[k] = Untile(tiles[k], rows, cols)
+	}
+
+	return
+}
+
+// MulCtRect evaluates the product of the tiled M x N matrices A by
+// the tiled N x P matrices B, i.e. C[i][j] = sum_k A[i][k] * B[k][j].
+// As for [Evaluator.MulCt], the result is not rescaled.
+// It returns an error if A or B do not have the tiles of p or if
+// their tiles do not have the same, non-zero, number of ciphertexts.
+func (eval *Evaluator) MulCtRect(A, B Tiled, p *RectMulParameters) (C Tiled, err error) {
+
+	tilesM := DivIntCeil(p.M, p.Tile)
+	tilesN := DivIntCeil(p.N, p.Tile)
+	tilesP := DivIntCeil(p.P, p.Tile)
+
+	var n, m int
+	if n, err = A.check(tilesM, tilesN); err != nil {
+		return nil, fmt.Errorf("invalid A: %w", err)
+	}
+
+	if m, err = B.check(tilesN, tilesP); err != nil {
+		return nil, fmt.Errorf("invalid B: %w", err)
+	}
+
+	if m != n {
+		return nil, fmt.Errorf("invalid B: %d ciphertexts per tile but A has %d", m, n)
+	}
+
+	params := eval.Evaluators[0].Parameters()
+
+	level := min(A.Level(), B.Level())
+
+	C = make(Tiled, tilesM)
+	for i := range C {
+		C[i] = make([][]rlwe.Ciphertext, tilesP)
+		for j := range C[i] {
+
+			C[i][j] = make([]rlwe.Ciphertext, n)
+			for l := range n {
+				C[i][j][l] = *hefloat.NewCiphertext(params, 1, level)
+			}
+
+			var buf []rlwe.Ciphertext
+			if tilesN > 1 {
+				buf = make([]rlwe.Ciphertext, n)
+				for l := range n {
+					buf[l] = *hefloat.NewCiphertext(params, 1, level)
+				}
+			}
+
+			for k := range tilesN {
+
+				if k == 0 {
+					if err = eval.MulCt(A[i][k], B[k][j], p.MulParameters, C[i][j]); err != nil {
+						return nil, fmt.Errorf("[matrix.Evaluator].MulCt: %w", err)
+					}
+					continue
+				}
+
+				if err = eval.MulCt(A[i][k], B[k][j], p.MulParameters, buf); err != nil {
+					return nil, fmt.Errorf("[matrix.Evaluator].MulCt: %w", err)
+				}
+
+				if err = eval.AddCt(C[i][j], buf, C[i][j]); err != nil {
+					return nil, fmt.Errorf("[matrix.Evaluator].AddCt: %w", err)
+				}
+			}
+		}
+	}
+
+	return
+}
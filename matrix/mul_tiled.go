@@ -0,0 +1,152 @@
+package matrix
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/concurrency"
+)
+
+// TiledWeights is a plaintext weight matrix zero-padded to a multiple
+// of Block and split into Block x Block tiles.
+// Blocks[k][l] multiplies the k-th column block of the input
+// and contributes to the l-th column block of the output.
+type TiledWeights struct {
+	Block  int
+	Blocks [][]*mat.Dense
+}
+
+// NewTiledWeights splits w into block x block tiles.
+func NewTiledWeights(w *mat.Dense, block int) *TiledWeights {
+	return &TiledWeights{
+		Block:  block,
+		Blocks: Tile(w, block),
+	}
+}
+
+// Dims returns the number of input and output column blocks.
+func (w TiledWeights) Dims() (in, out int) {
+	return len(w.Blocks), len(w.Blocks[0])
+}
+
+// InBlock returns the weights of the k-th input column block, which
+// multiply only in[k] in [Evaluator.MulTiledPt].
+func (w TiledWeights) InBlock(k int) *TiledWeights {
+	return &TiledWeights{
+		Block:  w.Block,
+		Blocks: w.Blocks[k : k+1],
+	}
+}
+
+// OutBlock returns the weights of the l-th output column block.
+func (w TiledWeights) OutBlock(l int) *TiledWeights {
+	blocks := make([][]*mat.Dense, len(w.Blocks))
+	for k := range blocks {
+		blocks[k] = w.Blocks[k][l : l+1]
+	}
+	return &TiledWeights{
+		Block:  w.Block,
+		Blocks: blocks,
+	}
+}
+
+// TiledWeightsGaloisElements returns the Galois elements required to
+// multiply by weights split into block x block tiles.
+func TiledWeightsGaloisElements(params hefloat.Parameters, block int) (galEls []uint64) {
+	return DiagonalizeGaloisElements(params, block)
+}
+
+// MulTiledPt evaluates out = in x w, where in[k] are the ciphertexts of
+// the k-th column block of the input, packed with rows of w.Block slots,
+// and out[l] are the ciphertexts of the l-th column block of the output.
+//
+// The tiles of a row of blocks are encoded one row at a time and
+// evaluated together on each input ciphertext, so that the rotations of
+// the input are hoisted and shared among all the output blocks.
+// As for [Evaluator.MulPt], the result is not rescaled.
+func (eval *Evaluator) MulTiledPt(in [][]rlwe.Ciphertext, w *TiledWeights, scaleOut rlwe.Scale) (out [][]rlwe.Ciphertext, err error) {
+
+	blocksIn, blocksOut := w.Dims()
+
+	if len(in) != blocksIn {
+		return nil, fmt.Errorf("invalid input: expected %d column blocks but has %d", blocksIn, len(in))
+	}
+
+	params := eval.Evaluators[0].Parameters()
+	slots := params.MaxSlots()
+
+	level := in[0][0].Level()
+	for k := range in {
+		for i := range in[k] {
+			level = min(level, in[k][i].Level())
+		}
+	}
+
+	n := len(in[0])
+
+	out = make([][]rlwe.Ciphertext, blocksOut)
+	for l := range out {
+		out[l] = make([]rlwe.Ciphertext, n)
+		for i := range n {
+			out[l][i] = *hefloat.NewCiphertext(params, 1, level)
+		}
+	}
+
+	for k := range blocksIn {
+
+		if len(in[k]) != n {
+			return nil, fmt.Errorf("invalid input: column block %d has %d ciphertexts but expected %d", k, len(in[k]), n)
+		}
+
+		lts := make([]*he.LinearTransformation, blocksOut)
+		for l := range lts {
+			if lts[l], err = eval.NewLinearTransformation(
+				level,
+				in[k][0].Scale,
+				scaleOut,
+				false,
+				Diagonalize(w.Blocks[k][l], slots/w.Block, slots)); err != nil {
+				return nil, fmt.Errorf("[matrix.Evaluator].NewLinearTransformation: %w", err)
+			}
+		}
+
+		m := concurrency.NewRessourceManager(eval.GetEvaluatorsWithHoistingBuffer())
+		for i := range n {
+			m.Run(func(eval *EvaluatorWithHoistingBuffer) (err error) {
+
+				buf := make([]*rlwe.Ciphertext, blocksOut)
+				for l := range buf {
+					if k == 0 {
+						buf[l] = &out[l][i]
+					} else {
+						buf[l] = hefloat.NewCiphertext(params, 1, level)
+					}
+				}
+
+				if err = he.NewLinearTransformationEvaluator(eval).EvaluateMany(&in[k][i], lts, eval.HoistingBuffer, buf); err != nil {
+					return fmt.Errorf("[he.LinearTransformationEvaluator].EvaluateMany: %w", err)
+				}
+
+				if k != 0 {
+					for l := range buf {
+						if err = eval.Add(&out[l][i], buf[l], &out[l][i]); err != nil {
+							return fmt.Errorf("[hefloat.Evaluator].Add: %w", err)
+						}
+					}
+				}
+
+				return
+			})
+		}
+
+		if err = m.Wait(); err != nil {
+			return
+		}
+	}
+
+	return
+}
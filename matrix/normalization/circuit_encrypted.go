@@ -23,27 +23,10 @@ func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext, k int) (err error
 
 	variances := structs.Vector[rlwe.Ciphertext](in).Clone()
 
-	if err = utils.RunWithBench("Normalization: E[x]", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
-
-		LevelIn = in[0].Level()
-		LogScaleIn = in[0].LogScale()
-
-		if err = eval.InnerSum(variances, 1, k, variances); err != nil {
+	if !eval.RMSNorm {
+		if err = eval.mean(in, variances, k); err != nil {
 			return
 		}
-
-		// 1 Level
-		if err = eval.MaskAndReplicate(variances, 1/float64(k), k, true); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.MaxAndReplicate]: %w", err)
-		}
-
-		LevelOut = variances[0].Level()
-		LogScaleOut = variances[0].LogScale()
-
-		return
-
-	}); err != nil {
-		return
 	}
 
 	if err = utils.RunWithBench("Normalization: Var[x]", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
@@ -51,11 +34,6 @@ func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext, k int) (err error
 		LevelIn = variances[0].Level()
 		LogScaleIn = variances[0].LogScale()
 
-		// 1 Level
-		if err = eval.SubCt(in, variances, in); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][SubCt][in,mean,in]: %w", err)
-		}
-
 		if err = eval.DotCt(in, in, variances); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][DotCt][in,in,in]: %w", err)
 		}
@@ -68,12 +46,13 @@ func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext, k int) (err error
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][Rescale][variance,variance]: %w", err)
 		}
 
-		if err = eval.AddScalar(variances, 1e-6, variances); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][AddScalar][variance,1e-6,variances]: %w", err)
+		// k * Epsilon since the sum is divided by k in MaskAndCompress
+		if err = eval.AddScalar(variances, float64(k)*eval.Epsilon, variances); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][AddScalar][variance,k*Epsilon,variances]: %w", err)
 		}
 
 		if eval.BootstrapBefore {
-			eval.DropLevel(variances, variances[0].Level()-1)
+			eval.DropLevel(variances, variances[0].Level()-1-eval.MinimumInputLevel())
 		}
 
 		var half *rlwe.Ciphertext
@@ -104,7 +83,7 @@ func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext, k int) (err error
 		}
 
 		if eval.BootstrapAfter {
-			eval.Evaluators[0].DropLevel(variance, variance.Level()-eval.InvSqrtPoly.Depth()-2*eval.InvSqrtIter)
+			eval.Evaluators[0].DropLevel(variance, variance.Level()-eval.InvSqrtPoly.Depth()-2*eval.InvSqrtIter-eval.MinimumInputLevel())
 		}
 
 		if err = utils.RunWithBench("Normalization: Poly", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
@@ -215,3 +194,37 @@ func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext, k int) (err error
 
 	return
 }
+
+// mean sets mean to E[x] replicated over each row of size k, and in to x - E[x].
+func (eval *Evaluator) mean(in, mean []rlwe.Ciphertext, k int) (err error) {
+
+	if err = utils.RunWithBench("Normalization: E[x]", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = in[0].Level()
+		LogScaleIn = in[0].LogScale()
+
+		if err = eval.InnerSum(mean, 1, k, mean); err != nil {
+			return
+		}
+
+		// 1 Level
+		if err = eval.MaskAndReplicate(mean, 1/float64(k), k, true); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.MaxAndReplicate]: %w", err)
+		}
+
+		LevelOut = mean[0].Level()
+		LogScaleOut = mean[0].LogScale()
+
+		return
+
+	}); err != nil {
+		return
+	}
+
+	// 1 Level
+	if err = eval.SubCt(in, mean, in); err != nil {
+		return fmt.Errorf("[matrix.Evaluator][SubCt][in,mean,in]: %w", err)
+	}
+
+	return
+}
@@ -26,10 +26,14 @@ func (eval *Evaluator) EvaluateApproximate(in, out *mat.Dense) (Min, Max float64
 	copy(variance, in.RawMatrix().Data)
 
 	// E[x]
-	utils.InnerFunction(mean, 1, cols, f, mean)
-	utils.MaskAndReplicate(mean, 1, cols)
-	for i := range mean {
-		mean[i] /= float64(cols)
+	if eval.RMSNorm {
+		clear(mean)
+	} else {
+		utils.InnerFunction(mean, 1, cols, f, mean)
+		utils.MaskAndReplicate(mean, 1, cols)
+		for i := range mean {
+			mean[i] /= float64(cols)
+		}
 	}
 
 	outRaw := out.RawMatrix().Data
@@ -46,7 +50,7 @@ func (eval *Evaluator) EvaluateApproximate(in, out *mat.Dense) (Min, Max float64
 
 	utils.MaskAndReplicate(variance, 1/float64(cols), cols)
 	for i := range mean {
-		variance[i] += 1e-6
+		variance[i] += eval.Epsilon
 	}
 
 	Max = slices.Max(variance)
@@ -97,11 +101,13 @@ func (eval *Evaluator) EvaluateExact(in, out *mat.Dense) (Min, Max float64) {
 		mo := out.RawMatrix().Data[i*cols : (i+1)*cols]
 
 		mean := 0.0
-		for j := range cols {
-			mean += mi[j]
-		}
+		if !eval.RMSNorm {
+			for j := range cols {
+				mean += mi[j]
+			}
 
-		mean /= float64(cols)
+			mean /= float64(cols)
+		}
 
 		variance := 0.0
 		for j := range cols {
@@ -111,10 +117,10 @@ func (eval *Evaluator) EvaluateExact(in, out *mat.Dense) (Min, Max float64) {
 		}
 		variance /= float64(cols)
 
-		varInv := 1.0 / math.Sqrt(variance+1e-6)
+		varInv := 1.0 / math.Sqrt(variance+eval.Epsilon)
 
-		Min = min(Min, variance+1e-6)
-		Max = max(Max, variance+1e-6)
+		Min = min(Min, variance+eval.Epsilon)
+		Max = max(Max, variance+eval.Epsilon)
 
 		for j := range cols {
 			mo[j] = mo[j]*varInv*gamma[j] + beta[j]
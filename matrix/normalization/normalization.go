@@ -21,6 +21,8 @@ type Parameters struct {
 	InvSqrtIter     int
 	BootstrapBefore bool
 	BootstrapAfter  bool
+	Epsilon         float64 // Added to the variance before 1/sqrt
+	RMSNorm         bool    // If true, the mean is not subtracted (RMSNorm instead of LayerNorm)
 }
 
 type Evaluator struct {
@@ -45,6 +47,9 @@ func NewEvaluator(params Parameters, eval *matrix.Evaluator, btp he.Bootstrapper
 }
 
 func (eval *Evaluator) CircuitDepth() int {
+	if eval.RMSNorm {
+		return 3 + 2*eval.InvSqrtIter + eval.InvSqrtPoly.Depth()
+	}
 	return 4 + 2*eval.InvSqrtIter + eval.InvSqrtPoly.Depth()
 }
 
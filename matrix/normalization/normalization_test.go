@@ -88,8 +88,21 @@ func TestNormalization(t *testing.T) {
 		InvSqrtIter:     iters,
 		ToTVecSize:      rows * cols,
 		BootstrapBefore: true,
+		Epsilon:         1e-6,
 	}
 
+	for _, RMSNorm := range []bool{false, true} {
+		p.RMSNorm = RMSNorm
+		t.Run(fmt.Sprintf("RMSNorm=%t", RMSNorm), func(t *testing.T) {
+			testNormalization(t, tc, params, p, in)
+		})
+	}
+}
+
+func testNormalization(t *testing.T, tc *testContext, params hefloat.Parameters, p Parameters, in *mat.Dense) {
+
+	rows, cols := in.Dims()
+
 	eval := NewEvaluator(p, nil, nil)
 
 	fmt.Println("Depth:", eval.CircuitDepth())
@@ -118,7 +131,7 @@ func TestNormalization(t *testing.T) {
 		evk := rlwe.NewMemEvaluationKeySet(rlk, kgen.GenGaloisKeysNew(galEls, sk)...)
 
 		eval.Evaluator = matrix.NewEvaluator(params, cols, []*hefloat.Evaluator{tc.eval.WithKey(evk)})
-		eval.Bootstrapper = bootstrapping.NewDummyBootstrapper(params, 1, sk)
+		eval.Bootstrapper = bootstrapping.NewDummyBootstrapper(1, params, sk)
 
 		pt := hefloat.NewPlaintext(params, params.MaxLevel())
 
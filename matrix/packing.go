@@ -0,0 +1,288 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+)
+
+// PackingStage is a stage of a circuit evaluated independently on each
+// ciphertext of a batch. In and Out are the shapes of the matrices before
+// and after the stage: their MatPerCt and Samples are ignored, since the
+// stage keeps the number of samples per ciphertext chosen by the optimizer.
+// The input of a stage can only be repacked if Levels is at least 1, since
+// a repacking consumes a level.
+//
+// A stage which Converts is itself a conversion from In to Out, e.g. the
+// split of the heads, and outputs the packing of the next stage: it can
+// thus change the number of samples per ciphertext within the level of
+// its conversion, and is priced as this conversion plus Cost.
+type PackingStage struct {
+	Name     string
+	In, Out  Layout
+	Cost     float64 // cost of the stage per ciphertext
+	Tensors  int     // number of tensors repacked at the input of the stage, or converted by it, 1 if zero
+	Levels   int     // spare levels at the input of the stage
+	Converts bool    // the stage converts In to the packing of the next stage
+	// SamplesPerCt, if not zero, is the number of samples per ciphertext
+	// at the input of the stage, e.g. the packing of a residual.
+	SamplesPerCt int
+}
+
+// PackingWeights are the costs of the operations of a repacking,
+// in the same unit as [PackingStage.Cost].
+type PackingWeights struct {
+	Rotation float64
+	PtMul    float64
+	Add      float64
+	Level    float64 // cost of the level consumed by a repacking, per output ciphertext
+}
+
+// Cost returns the weighted cost of a conversion with numCts output ciphertexts.
+func (w PackingWeights) Cost(c ConversionCost, numCts int) float64 {
+	return w.Rotation*float64(c.Rotations) + w.PtMul*float64(c.PtMul) + w.Add*float64(c.Add) + w.Level*float64(numCts)
+}
+
+// PackingPlan is the packing of each stage of a circuit.
+// Layouts[k] is the layout at the input of the k-th stage; if Repack[k]
+// is true, it is obtained by converting the output of the previous stage,
+// else the previous stage directly outputs this layout, which is also the
+// case if the previous stage Converts.
+type PackingPlan struct {
+	Stages  []PackingStage
+	Layouts []Layout
+	Repack  []bool
+	Cost    float64
+}
+
+// SamplesPerCt returns the number of samples per ciphertext at the input of the k-th stage.
+func (p PackingPlan) SamplesPerCt(k int) int {
+	return p.Layouts[k].MatPerCt / p.Layouts[k].Heads
+}
+
+// Conversion returns the repacking at the input of the k-th stage as a
+// periodic conversion (see [NewPeriodicConversion]), so that it applies
+// to any number of samples with the same keys.
+func (p PackingPlan) Conversion(params hefloat.Parameters, k int) (c *Conversion, err error) {
+
+	if k <= 0 || k >= len(p.Stages) || !p.Repack[k] {
+		return nil, fmt.Errorf("invalid stage: stage %d is not repacked", k)
+	}
+
+	in := withSamplesPerCt(p.Stages[k-1].Out, p.SamplesPerCt(k-1), 0)
+	out := withSamplesPerCt(p.Stages[k].In, p.SamplesPerCt(k), 0)
+
+	return NewPeriodicConversion(params, in, out, 1)
+}
+
+func (p PackingPlan) String() string {
+	var sb strings.Builder
+	for k := range p.Stages {
+		repack := ""
+		if p.Repack[k] {
+			repack = " (repacked)"
+		}
+		fmt.Fprintf(&sb, "%-16s %d samples/ct, %d cts%s\n", p.Stages[k].Name, p.SamplesPerCt(k), p.Layouts[k].NumCts(), repack)
+	}
+	fmt.Fprintf(&sb, "Cost: %.1f", p.Cost)
+	return sb.String()
+}
+
+// MaxSamplesPerCt returns the largest number of samples of the
+// given shape that fit in a ciphertext.
+func MaxSamplesPerCt(params hefloat.Parameters, shape Layout) int {
+	return params.MaxSlots() / (shape.Heads * shape.Stride())
+}
+
+// withSamplesPerCt returns the layout of n samples of the given shape,
+// in order, with samplesPerCt samples per ciphertext.
+func withSamplesPerCt(shape Layout, samplesPerCt, n int) (l Layout) {
+	l = NewLayout(shape.Rows, shape.Cols, shape.Padd, samplesPerCt*shape.Heads, n)
+	l.PaddRows = shape.PaddRows
+	l.Heads = shape.Heads
+	return
+}
+
+// OptimizePacking returns the number of samples per ciphertext of each
+// stage that minimizes the total cost of the stages on n samples, plus
+// the cost of the repackings between stages with different packings.
+// The packing of the first stage is chosen as any other, unless fixed
+// by its SamplesPerCt.
+//
+// A repacking on n samples is priced from the [Conversion] of the smallest
+// batch after which the conversion repeats, scaled to n samples, and so is
+// the conversion of a stage which Converts, without the price of its level,
+// which the stage consumes whatever the packing. Stages without spare level
+// keep the packing of the previous stage, unless it Converts, and an error
+// is returned if no packing satisfies these constraints.
+func OptimizePacking(params hefloat.Parameters, stages []PackingStage, n int, w PackingWeights) (plan *PackingPlan, err error) {
+
+	if len(stages) == 0 || n < 1 {
+		return nil, fmt.Errorf("invalid input: %d stages and %d samples", len(stages), n)
+	}
+
+	maxSamples := make([]int, len(stages))
+	for k, stage := range stages {
+		if stage.In.Heads < 1 || stage.Out.Heads < 1 {
+			return nil, fmt.Errorf("invalid stage %s: Heads must be at least 1", stage.Name)
+		}
+		// The output of a conversion has the packing of the next stage.
+		out := MaxSamplesPerCt(params, stage.Out)
+		if stage.Converts {
+			if k == len(stages)-1 {
+				return nil, fmt.Errorf("invalid stage %s: the last stage cannot convert", stage.Name)
+			}
+			out = n
+		}
+		if maxSamples[k] = min(MaxSamplesPerCt(params, stage.In), out, n); maxSamples[k] < 1 {
+			return nil, fmt.Errorf("invalid stage %s: a sample does not fit in a ciphertext", stage.Name)
+		}
+		if stage.SamplesPerCt < 0 || stage.SamplesPerCt > maxSamples[k] {
+			return nil, fmt.Errorf("invalid stage %s: %d samples per ciphertext do not fit in a ciphertext", stage.Name, stage.SamplesPerCt)
+		}
+	}
+
+	// The level of a conversion stage is consumed whatever the packing.
+	wConvert := w
+	wConvert.Level = 0
+
+	// Repackings between the same shapes are only priced once.
+	type repackKey struct {
+		out, in [5]int
+		q, p    int
+		convert bool
+	}
+
+	repacks := map[repackKey]float64{}
+
+	// cost[k][p-1] is the minimum cost of the stages [0, k]
+	// with p samples per ciphertext at the k-th stage.
+	cost := make([][]float64, len(stages))
+	prev := make([][]int, len(stages))
+
+	for k, stage := range stages {
+
+		cost[k] = make([]float64, maxSamples[k])
+		prev[k] = make([]int, maxSamples[k])
+
+		for p := 1; p <= maxSamples[k]; p++ {
+
+			cost[k][p-1] = math.Inf(1)
+
+			if stage.SamplesPerCt != 0 && p != stage.SamplesPerCt {
+				continue
+			}
+
+			eval := stage.Cost * float64(DivIntCeil(n, p))
+
+			if k == 0 {
+				cost[k][p-1] = eval
+				continue
+			}
+
+			for q := 1; q <= maxSamples[k-1]; q++ {
+
+				// Either the previous stage converts its input to the packing
+				// of this stage, or its output is repacked to this packing.
+				from, to, tensors, weights := stages[k-1].In, stages[k-1].Out, stages[k-1].Tensors, wConvert
+
+				if !stages[k-1].Converts {
+					from, to, tensors, weights = stages[k-1].Out, stage.In, stage.Tensors, w
+					if stage.Levels < 1 && needsRepack(from, q, to, p) {
+						continue
+					}
+				} else if needsRepack(to, p, stage.In, p) {
+					continue
+				}
+
+				key := repackKey{
+					out:     [5]int{from.Rows, from.Cols, from.Padd, from.PaddRows, from.Heads},
+					in:      [5]int{to.Rows, to.Cols, to.Padd, to.PaddRows, to.Heads},
+					q:       q,
+					p:       p,
+					convert: stages[k-1].Converts,
+				}
+
+				repack, ok := repacks[key]
+				if !ok {
+					if repack, err = repackCost(params, from, q, to, p, n, weights); err != nil {
+						return nil, fmt.Errorf("[matrix].repackCost: %w", err)
+					}
+					repacks[key] = repack
+				}
+
+				repack *= float64(max(1, tensors))
+
+				if tot := cost[k-1][q-1] + repack + eval; tot < cost[k][p-1] {
+					cost[k][p-1] = tot
+					prev[k][p-1] = q
+				}
+			}
+		}
+	}
+
+	plan = &PackingPlan{
+		Stages:  stages,
+		Layouts: make([]Layout, len(stages)),
+		Repack:  make([]bool, len(stages)),
+	}
+
+	last := len(stages) - 1
+
+	p := 1
+	for q := range cost[last] {
+		if cost[last][q] < cost[last][p-1] {
+			p = q + 1
+		}
+	}
+
+	if plan.Cost = cost[last][p-1]; math.IsInf(plan.Cost, 1) {
+		return nil, fmt.Errorf("invalid stages: no packing repacks only the stages with a spare level")
+	}
+
+	for k := last; k >= 0; k-- {
+		plan.Layouts[k] = withSamplesPerCt(stages[k].In, p, n)
+		if k > 0 {
+			q := prev[k][p-1]
+			plan.Repack[k] = !stages[k-1].Converts && needsRepack(stages[k-1].Out, q, stages[k].In, p)
+			p = q
+		}
+	}
+
+	return
+}
+
+// needsRepack returns true if the output of a stage with shape out and q
+// samples per ciphertext differs from the input of the next stage with
+// shape in and p samples per ciphertext.
+func needsRepack(out Layout, q int, in Layout, p int) bool {
+	return p != q || out.Rows != in.Rows || out.Cols != in.Cols || out.Padd != in.Padd || out.PaddRows != in.PaddRows || out.Heads != in.Heads
+}
+
+// repackCost returns the weighted cost of converting n samples from the shape
+// out with q samples per ciphertext to the shape in with p samples per ciphertext.
+func repackCost(params hefloat.Parameters, out Layout, q int, in Layout, p, n int, w PackingWeights) (cost float64, err error) {
+
+	if !needsRepack(out, q, in, p) {
+		return
+	}
+
+	// The conversion repeats every lcm(p, q) samples.
+	block := min(n, p/gcd(p, q)*q)
+
+	var c *Conversion
+	if c, err = NewConversion(params, withSamplesPerCt(out, q, block), withSamplesPerCt(in, p, block), 1); err != nil {
+		return 0, fmt.Errorf("[matrix].NewConversion: %w", err)
+	}
+
+	return w.Cost(c.Cost(params), c.Out.NumCts()) * float64(n) / float64(block), nil
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
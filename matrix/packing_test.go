@@ -0,0 +1,131 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizePacking(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	rows := 8
+	cols := 16
+	heads := 4
+	n := 16
+
+	// At 1024 slots, 8 samples fit in a ciphertext before the
+	// split, but only 4 once split and padded to 8 x 8 heads.
+	merged := NewLayout(rows, cols, 0, 1, 1)
+	split, err := merged.Split(heads, rows-cols/heads, 0)
+	require.NoError(t, err)
+
+	require.Equal(t, 8, MaxSamplesPerCt(params, merged))
+	require.Equal(t, 4, MaxSamplesPerCt(params, split))
+
+	// Only the inputs of Split and After can be repacked.
+	stages := []PackingStage{
+		{Name: "Before", In: merged, Out: merged, Cost: 10},
+		{Name: "Split", In: merged, Out: split, Cost: 1, Levels: 1},
+		{Name: "Heads", In: split, Out: split, Cost: 10},
+		{Name: "Merge", In: split, Out: merged, Cost: 1},
+		{Name: "After", In: merged, Out: merged, Cost: 10, Levels: 1},
+	}
+
+	t.Run("NoRepack", func(t *testing.T) {
+
+		plan, err := OptimizePacking(params, stages, n, PackingWeights{Rotation: 1, PtMul: 1, Add: 1, Level: 100})
+		require.NoError(t, err)
+
+		for k := range stages {
+			require.Equal(t, 4, plan.SamplesPerCt(k))
+			require.False(t, plan.Repack[k])
+		}
+
+		// 4 ciphertexts per stage.
+		require.Equal(t, 4*(10+1+10+1+10), int(plan.Cost))
+	})
+
+	t.Run("Repack", func(t *testing.T) {
+
+		plan, err := OptimizePacking(params, stages, n, PackingWeights{Rotation: 0.01, PtMul: 0.001, Add: 0.01})
+		require.NoError(t, err)
+
+		require.Equal(t, []int{8, 4, 4, 4, 8}, []int{plan.SamplesPerCt(0), plan.SamplesPerCt(1), plan.SamplesPerCt(2), plan.SamplesPerCt(3), plan.SamplesPerCt(4)})
+		require.Equal(t, []bool{false, true, false, false, true}, plan.Repack)
+
+		// The conversions are periodic: they do not depend on n.
+		c, err := plan.Conversion(params, 1)
+		require.NoError(t, err)
+		require.True(t, c.Periodic)
+		require.Equal(t, 1, c.In.NumCts())
+		require.Equal(t, 2, c.Out.NumCts())
+
+		c, err = plan.Conversion(params, 4)
+		require.NoError(t, err)
+		require.True(t, c.Periodic)
+		require.Equal(t, 2, c.In.NumCts())
+		require.Equal(t, 1, c.Out.NumCts())
+
+		_, err = plan.Conversion(params, 2)
+		require.Error(t, err)
+	})
+
+	t.Run("NoLevel", func(t *testing.T) {
+
+		noLevel := make([]PackingStage, len(stages))
+		copy(noLevel, stages)
+		noLevel[4].Levels = 0
+
+		// After cannot be repacked, so the packing of the heads is kept.
+		plan, err := OptimizePacking(params, noLevel, n, PackingWeights{Rotation: 0.01, PtMul: 0.001, Add: 0.01})
+		require.NoError(t, err)
+
+		require.Equal(t, []int{8, 4, 4, 4, 4}, []int{plan.SamplesPerCt(0), plan.SamplesPerCt(1), plan.SamplesPerCt(2), plan.SamplesPerCt(3), plan.SamplesPerCt(4)})
+		require.Equal(t, []bool{false, true, false, false, false}, plan.Repack)
+	})
+
+	t.Run("Converts", func(t *testing.T) {
+
+		// Split and Merge change the packing within their conversion,
+		// and After keeps the packing of a residual of Before.
+		converts := []PackingStage{
+			{Name: "Before", In: merged, Out: merged, Cost: 10},
+			{Name: "Split", In: merged, Out: split, Converts: true},
+			{Name: "Heads", In: split, Out: split, Cost: 10},
+			{Name: "Merge", In: split, Out: merged, Converts: true},
+			{Name: "After", In: merged, Out: merged, Cost: 10},
+		}
+
+		plan, err := OptimizePacking(params, converts, n, PackingWeights{Rotation: 0.01, PtMul: 0.001, Add: 0.01, Level: 100})
+		require.NoError(t, err)
+
+		require.Equal(t, []int{8, 8, 4, 4, 8}, []int{plan.SamplesPerCt(0), plan.SamplesPerCt(1), plan.SamplesPerCt(2), plan.SamplesPerCt(3), plan.SamplesPerCt(4)})
+		require.Equal(t, make([]bool, len(converts)), plan.Repack)
+
+		converts[0].SamplesPerCt = 2
+		converts[4].SamplesPerCt = 2
+
+		plan, err = OptimizePacking(params, converts, n, PackingWeights{Rotation: 0.01, PtMul: 0.001, Add: 0.01, Level: 100})
+		require.NoError(t, err)
+
+		require.Equal(t, []int{2, 2, 4, 4, 2}, []int{plan.SamplesPerCt(0), plan.SamplesPerCt(1), plan.SamplesPerCt(2), plan.SamplesPerCt(3), plan.SamplesPerCt(4)})
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+
+		_, err := OptimizePacking(params, nil, n, PackingWeights{})
+		require.Error(t, err)
+
+		_, err = OptimizePacking(params, []PackingStage{{Name: "Large", In: NewLayout(64, 32, 0, 1, 1), Out: merged}}, n, PackingWeights{})
+		require.Error(t, err)
+
+		// The output of Split must be repacked for Merge, which has no spare level.
+		_, err = OptimizePacking(params, []PackingStage{stages[1], {Name: "Merge", In: merged, Out: merged}}, n, PackingWeights{})
+		require.Error(t, err)
+	})
+}
@@ -56,7 +56,15 @@ func (eval *Evaluator) Step(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err er
 
 	stepPoly[n-1] = he.NewPolynomial(lastPoly)
 
-	if out, err = eval.Polynomial(in, stepPoly[0]); err != nil {
+	if in[0].Level() < stepPoly[0].Depth()+eval.MinimumInputLevel() {
+		if out, err = eval.BootstrapMany(in); err != nil {
+			return nil, fmt.Errorf("btp.BootstrapMany: %w", err)
+		}
+	} else {
+		out = in
+	}
+
+	if out, err = eval.Polynomial(out, stepPoly[0]); err != nil {
 		return nil, fmt.Errorf("[matrix.Evaluator][Polynomial][in,stepPoly]: %w", err)
 	}
 
@@ -66,7 +74,7 @@ func (eval *Evaluator) Step(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err er
 			return nil, fmt.Errorf("[matrix.Evaluator][Rescale][out,out]: %w", err)
 		}
 
-		if out[0].Level() < stepPoly[i].Depth() {
+		if out[0].Level() < stepPoly[i].Depth()+eval.MinimumInputLevel() {
 			if out, err = eval.BootstrapMany(out); err != nil {
 				return nil, fmt.Errorf("btp.BootstrapMany: %w", err)
 			}
@@ -1,41 +1,76 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"image"
 	"image/color"
 	"image/draw"
 	"image/png"
+	"math"
 	"os"
+	"strconv"
+	"strings"
 
+	"app/matrix/minimax"
 	"app/utils"
 
-	"github.com/Pro7ech/lattigo/he/hefloat"
-	"github.com/Pro7ech/lattigo/utils/bignum"
-
 	"github.com/vdobler/chart"
 	"github.com/vdobler/chart/imgg"
 )
 
+var (
+	dir      = flag.String("dir", "minimax", "directory in which the composite polynomials are cached")
+	prec     = flag.Uint("prec", 512, "bit-precision of the Remez algorithm")
+	logalpha = flag.Int("logalpha", 10, "log2 of the distinguishing precision")
+	logerr   = flag.Int("logerr", 15, "log2 of the upper bound on the scheme error")
+	degrees  = flag.String("deg", "255,63", "comma separated degrees of the composite polynomial")
+	name     = flag.String("name", "", "name of the composite polynomial to load and validate (overrides the other parameters)")
+	plot     = flag.Bool("plot", false, "plot the approximated ReLU in sign.png")
+)
+
 func main() {
 
-	Coeffs := hefloat.GenMinimaxCompositePolynomial(512, 3, 10, []int{63}, bignum.Sign)
+	flag.Parse()
 
-	fmt.Println("COEFFICIENTS:")
-	fmt.Printf("{\n")
-	for i := range Coeffs {
-		hefloat.PrettyPrintCoefficients(15, Coeffs[i], true, false, false)
-	}
-	fmt.Printf("},\n")
+	var poly minimax.Polynomial
+	var err error
+
+	if *name != "" {
+		if poly, err = minimax.Load(*dir, *name); err != nil {
+			panic(err)
+		}
+	} else {
 
-	coeffs := make([][]float64, len(Coeffs))
-	for i := range coeffs {
-		coeffs[i] = make([]float64, len(Coeffs[i]))
-		for j := range coeffs[i] {
-			coeffs[i][j], _ = Coeffs[i][j].Float64()
+		p := minimax.Parameters{
+			Prec:     *prec,
+			LogAlpha: *logalpha,
+			LogErr:   *logerr,
+		}
+
+		for _, d := range strings.Split(*degrees, ",") {
+			var deg int
+			if deg, err = strconv.Atoi(strings.TrimSpace(d)); err != nil {
+				panic(fmt.Errorf("invalid degree %q: %w", d, err))
+			}
+			p.Degrees = append(p.Degrees, deg)
+		}
+
+		if poly, err = minimax.LoadOrGenerate(*dir, p); err != nil {
+			panic(err)
 		}
 	}
 
+	fmt.Printf("Name: %s\n", poly.Name())
+	fmt.Printf("Interval: [-1, -2^-%d] U [2^-%d, 1]\n", poly.LogAlpha, poly.LogAlpha)
+	fmt.Printf("MaxErr: 2^%f\n", math.Log2(poly.MaxErr()))
+
+	if !*plot {
+		return
+	}
+
+	coeffs := poly.CoeffsFloat()
+
 	hminimax := func(x float64) (y float64) {
 		sign := utils.CompositeEval(coeffs, -1, 1, x)
 		return (x*sign + x) / 2
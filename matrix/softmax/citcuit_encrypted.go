@@ -11,8 +11,38 @@ import (
 	"github.com/Pro7ech/lattigo/utils/structs"
 )
 
+// EvaluateEncrypted evaluates in place the softmax of the vectors of K values
+// of cts, by chunks of at most K ciphertexts. If Valid < K, the last K - Valid
+// values of each vector are padding, which are excluded from the normalization
+// and set to ExpMin/2 before the maximum. This is exact as long as the maximum
+// of the valid values is in [ExpMin/2, -ExpMin/2] and within AbsMax of ExpMin/2,
+// so that the padding is neither the maximum nor out of the domain of the
+// exponential and of the maximum.
 func (eval *Evaluator) EvaluateEncrypted(cts []rlwe.Ciphertext) (err error) {
 
+	if len(cts) > eval.K {
+		for i := 0; i < len(cts); i += eval.K {
+			if err = eval.EvaluateEncrypted(cts[i:min(i+eval.K, len(cts))]); err != nil {
+				return
+			}
+		}
+		return
+	}
+
+	if valid := eval.valid(); valid < eval.K {
+
+		padding := make([]float64, eval.ToTVecSize)
+		for i := 0; i < len(padding); i += eval.K {
+			for j := valid; j < eval.K; j++ {
+				padding[i+j] = eval.ExpMin / 2
+			}
+		}
+
+		if err = eval.AddVec(cts, padding, cts); err != nil {
+			return fmt.Errorf("[matrix.Evaluator][AddVec]: %w", err)
+		}
+	}
+
 	num := cts
 
 	// a*(x - max(x))+b
@@ -57,7 +87,7 @@ func (eval *Evaluator) EvaluateEncrypted(cts []rlwe.Ciphertext) (err error) {
 		LevelIn = norm[0].Level()
 		LogScaleIn = norm[0].LogScale()
 
-		if err = eval.InnerSum(norm, 1, eval.K, norm); err != nil {
+		if err = eval.InnerSum(norm, 1, eval.valid(), norm); err != nil {
 			return
 		}
 
@@ -83,7 +113,7 @@ func (eval *Evaluator) EvaluateEncrypted(cts []rlwe.Ciphertext) (err error) {
 		LevelIn = ct.Level()
 		LogScaleIn = ct.LogScale()
 
-		if ct.Level() < eval.InvPoly.Depth() {
+		if ct.Level() < eval.InvPoly.Depth()+eval.MinimumInputLevel() {
 			if ct, err = eval.Bootstrap(ct); err != nil {
 				return
 			}
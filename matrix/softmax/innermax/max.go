@@ -123,7 +123,8 @@ func (eval *Evaluator) InnerMax(in []rlwe.Ciphertext, k int) (out []rlwe.Ciphert
 			shift = k - (1 << (bits.Len64(uint64(k)-1) - 1))
 		}
 
-		if in[0].Level() < 1+eval.Parameters.Depth() {
+		// Capped at the output level, in which case Max bootstraps in Step
+		if in[0].Level() < min(1+eval.Parameters.Depth()+eval.MinimumInputLevel(), eval.OutputLevel()) {
 			if in, err = eval.BootstrapMany(in); err != nil {
 				return nil, fmt.Errorf("[BootstrapMany][in]: %w", err)
 			}
@@ -210,7 +211,7 @@ func (eval *Evaluator) InnerMax(in []rlwe.Ciphertext, k int) (out []rlwe.Ciphert
 				in[i] = in[pack*i]
 			}
 
-			in = append(in[:(len(in)+pack-1)/pack])
+			in = in[:(len(in)+pack-1)/pack]
 
 			prevk = (k >> 1)
 
@@ -325,7 +326,17 @@ func (eval *Evaluator) Step(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err er
 
 	stepPoly[n-1] = he.NewPolynomial(lastPoly)
 
-	if in[0].Level() < stepPoly[0].Depth() {
+	// Levels needed by the i-th polynomial, the last one keeping a level
+	// for the product of the step, above the minimum input level of the
+	// bootstrapping.
+	levels := func(i int) (l int) {
+		if l = stepPoly[i].Depth() + eval.MinimumInputLevel(); i == n-1 {
+			l++
+		}
+		return
+	}
+
+	if in[0].Level() < levels(0) {
 		if out, err = eval.BootstrapMany(in); err != nil {
 			return nil, fmt.Errorf("btp.BootstrapMany: %w", err)
 		}
@@ -343,7 +354,7 @@ func (eval *Evaluator) Step(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err er
 			return nil, fmt.Errorf("[matrix.Evaluator][Rescale][out,out]: %w", err)
 		}
 
-		if out[0].Level() < stepPoly[i].Depth() {
+		if out[0].Level() < levels(i) {
 			if out, err = eval.BootstrapMany(out); err != nil {
 				return nil, fmt.Errorf("btp.BootstrapMany: %w", err)
 			}
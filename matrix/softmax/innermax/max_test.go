@@ -1,15 +1,13 @@
 package innermax
 
 import (
-	"flag"
-	"fmt"
-
-	//"math"
 	"slices"
 	"testing"
 
 	"app/bootstrapping"
 	"app/matrix"
+	"app/matrix/minimax"
+	"app/refresh"
 
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/ring"
@@ -19,139 +17,98 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var printPrecisionStats = flag.Bool("print-precision", false, "print precision stats")
+// The maximum level is 12, as lib.LevelBootstrapping, which is smaller than
+// 1+Depth()+1 = 13 for the sign polynomial below: with the client-aided
+// refresh, whose minimum input level is 1, the bootstrappings of InnerMax
+// are capped at the output level and Step bootstraps between its polynomials.
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60, 60, 60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
 
-func TestInnermax(t *testing.T) {
+func TestInnerMax(t *testing.T) {
 
-	var err error
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
 
-	A := -0.5
-	B := 0.5
 	K := 50
-	D := 34
-
-	absolutemax := 8
+	D := 4
 
-	params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
-		LogN:            10,
-		LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
-		LogP:            []int{58, 58, 58},
-		LogDefaultScale: 45,
-		RingType:        ring.ConjugateInvariant,
-		Xs:              &ring.Ternary{H: 192},
-	})
+	n := (params.MaxSlots() / K) * K
 
-	var n int
+	sign := minimax.MustLoad("sign_p512_a6_e15_d31-31")
 
-	if maxSlots := params.MaxSlots(); maxSlots%K == 0 {
-		n = maxSlots
-	} else {
-		n = (maxSlots / K) * K
+	p := Parameters{
+		AbsMax:       8,
+		CoeffsString: sign.CoeffsString(),
+		CoeffsFloat:  sign.CoeffsFloat(),
 	}
 
+	// Values in [-0.5, 0.5] with a distinct maximum in [1, 2] at the start of each vector
 	r := sampling.NewSource([32]byte{})
-
 	values := make([][]float64, D)
-
 	for i := range values {
-		m := make([]float64, n)
-		for j := range m {
-			m[j] = r.Float64(A, B)
+		values[i] = make([]float64, params.MaxSlots())
+		for j := range n {
+			values[i][j] = r.Float64(-0.5, 0.5)
 		}
-
 		for j := range n / K {
-			m[j*K] = 1 + (1/256.0)*float64(i*(n/K)+j)
+			values[i][j*K] = 1 + float64(i*(n/K)+j)/256
 		}
-
-		values[i] = m
 	}
 
-	/*
-		for i := range values{
-			for j := range values[i]{
-				fmt.Printf("%10.7f ", values[i][j])
-			}
-			fmt.Println()
-		}
-		fmt.Println()
-	*/
-
-	t.Run("Encrypted", func(t *testing.T) {
-
-		kgen := rlwe.NewKeyGenerator(params)
-		sk := kgen.GenSecretKeyNew()
-		enc := rlwe.NewEncryptor(params, sk)
-		dec := rlwe.NewDecryptor(params, sk)
-		ecd := hefloat.NewEncoder(params)
-
-		btp := bootstrapping.NewDummyBootstrapper(params, 1, sk)
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+	ecd := hefloat.NewEncoder(params)
+	enc := rlwe.NewEncryptor(params, sk)
+	dec := rlwe.NewDecryptor(params, sk)
 
-		evals := make([]*hefloat.Evaluator, 1)
-		evals[0] = hefloat.NewEvaluator(params, nil)
+	rlk := kgen.GenRelinearizationKeyNew(sk)
+	// The power-of-two rotations of Extract, as in softmax.GaloisElements
+	galEls := append(GaloisElements(params, K, D), rlwe.GaloisElementsForInnerSum(params, 1, K)...)
+	evk := rlwe.NewMemEvaluationKeySet(rlk, kgen.GenGaloisKeysNew(galEls, sk)...)
 
-		mEval := matrix.NewEvaluator(params, K, evals)
-
-		rlk := kgen.GenRelinearizationKeyNew(sk)
-		galEls := GaloisElements(params, K, D)
-		evk := rlwe.NewMemEvaluationKeySet(rlk, kgen.GenGaloisKeysNew(galEls, sk)...)
-
-		pt := hefloat.NewPlaintext(params, params.MaxLevel())
-
-		cts := make([]rlwe.Ciphertext, D)
+	bootstrappers := map[string]*bootstrapping.Bootstrapper{
+		"Dummy":   bootstrapping.NewDummyBootstrapper(1, params, sk),
+		"Refresh": bootstrapping.NewInteractiveBootstrapper(1, params, refresh.Parameters{Lambda: 40, LogMessage: 9}, refresh.NewLoopback(refresh.NewClient(params, sk))),
+	}
 
-		for i := range cts {
-			require.NoError(t, ecd.Encode(values[i], pt))
-			ct := hefloat.NewCiphertext(params, 1, params.MaxLevel())
-			require.NoError(t, enc.Encrypt(pt, ct))
-			cts[i] = *ct
-		}
+	for _, name := range []string{"Dummy", "Refresh"} {
 
-		eval := NewEvaluator(mEval, btp)
-		eval.SetKeys(evk)
+		t.Run(name, func(t *testing.T) {
 
-		cts, err = eval.InnerMax(cts, absolutemax, K)
-		require.NoError(t, err)
+			btp := bootstrappers[name]
 
-		have := make([][]float64, D)
-		want := make([][]float64, D)
-		for i := range len(cts) {
-			m := make([]float64, cts[i].Slots())
-			require.NoError(t, ecd.Decode(dec.DecryptNew(&cts[i]), m))
+			eval := NewEvaluator(p, matrix.NewEvaluator(params, K, []*hefloat.Evaluator{hefloat.NewEvaluator(params, evk)}), btp)
 
-			have[i] = make([]float64, n/K)
-			for j := range n / K {
-				have[i][j] = m[j*K]
+			cts := make([]rlwe.Ciphertext, D)
+			for i := range cts {
+				pt := hefloat.NewPlaintext(params, params.MaxLevel())
+				require.NoError(t, ecd.Encode(values[i], pt))
+				ct := hefloat.NewCiphertext(params, 1, params.MaxLevel())
+				require.NoError(t, enc.Encrypt(pt, ct))
+				cts[i] = *ct
 			}
 
-			want[i] = make([]float64, n/K)
-			for j := range n / K {
-				want[i][j] = slices.Max(values[i][j*K : (j+1)*K])
+			// The refresh fails on ciphertexts below its minimum input level
+			cts, err := eval.InnerMax(cts, K)
+			require.NoError(t, err)
+			require.Len(t, cts, D)
+			if rbtp, ok := btp.Bootstrappers[0].(*refresh.Bootstrapper); ok {
+				require.Equal(t, 1, rbtp.MinimumInputLevel())
+				require.Greater(t, rbtp.Count, 0)
 			}
-			fmt.Println(i, want[i], have[i])
-			hefloat.VerifyTestVectors(params, ecd, nil, want[i], have[i], 25.0, 0, *printPrecisionStats, t)
-		}
 
-		/*
-			for j := range 4 * K {
-					if j%K == 0 && j > 0 {
-						fmt.Println()
-					}
-					fmt.Printf("%2d ", j)
-					for k := range D{
-						fmt.Printf("%11.5f", values[k][j])
-					}
-					fmt.Printf("     |")
-
-					for k := range have{
-						fmt.Printf("%11.5f", have[k][j])
-					}
-					fmt.Println()
+			for i := range cts {
+				have := make([]float64, params.MaxSlots())
+				require.NoError(t, ecd.Decode(dec.DecryptNew(&cts[i]), have))
+				for j := range n / K {
+					require.InDelta(t, slices.Max(values[i][j*K:(j+1)*K]), have[j*K], 1e-2)
 				}
-				fmt.Println()
-		*/
-
-		//
-
-	})
-
+			}
+		})
+	}
 }
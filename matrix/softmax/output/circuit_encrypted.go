@@ -0,0 +1,171 @@
+package output
+
+import (
+	"fmt"
+
+	"app/matrix/softmax/innermax"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/structs"
+)
+
+// EvaluateEncrypted returns the softmax of each vector.
+// Slots outside of the vectors are set to zero.
+func (eval *Evaluator) EvaluateEncrypted(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err error) {
+
+	params := eval.Evaluators[0].Parameters()
+
+	P := eval.BlockSize()
+
+	aExp, bExp := eval.ExpPoly.ChangeOfBasis()
+	aExpF64, _ := aExp.Float64()
+	bExpF64, _ := bExp.Float64()
+
+	aInv, bInv := eval.InvPoly.ChangeOfBasis()
+	aInvF64, _ := aInv.Float64()
+	bInvF64, _ := bInv.Float64()
+
+	pad := make([]float64, params.MaxSlots())
+	mask := make([]float64, params.MaxSlots())
+	maskInvA := make([]float64, params.MaxSlots())
+	maskInvB := make([]float64, params.MaxSlots())
+	for i := range pad {
+		if j := i % eval.Stride; j < eval.K {
+			mask[i] = 1
+			if j == 0 {
+				maskInvA[i] = aInvF64
+				maskInvB[i] = bInvF64
+			}
+		} else if j < P {
+			pad[i] = eval.Pad()
+		}
+	}
+
+	// Same level and scale as the output of InnerMax
+	var x []rlwe.Ciphertext
+	if x, err = eval.BootstrapMany(in); err != nil {
+		return nil, fmt.Errorf("[BootstrapMany][in]: %w", err)
+	}
+
+	if err = eval.AddVec(x, pad, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][AddVec][x,pad,x]: %w", err)
+	}
+
+	// a*(x - max(x)) + b
+	maxEval := innermax.NewEvaluator(eval.MaxParameters, eval.Evaluator, eval.Bootstrapper)
+
+	var max []rlwe.Ciphertext
+	if max, err = maxEval.InnerMax(structs.Vector[rlwe.Ciphertext](x).Clone(), P); err != nil {
+		return nil, fmt.Errorf("[innermax.Evaluator][InnerMax]: %w", err)
+	}
+
+	if err = eval.MaskAndReplicate(max, aExpF64, P, false); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][MaskAndReplicate][max]: %w", err)
+	}
+
+	if err = eval.DropLevel(x, x[0].Level()-max[0].Level()); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][DropLevel]: %w", err)
+	}
+
+	if err = eval.MulScalar(x, aExpF64, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][MulScalar]: %w", err)
+	}
+
+	if err = eval.SubCt(x, max, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][SubCt]: %w", err)
+	}
+
+	if err = eval.AddScalar(x, bExpF64, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][AddScalar]: %w", err)
+	}
+
+	if err = eval.Rescale(x, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale]: %w", err)
+	}
+
+	// exp(x - max(x)), with the slots outside of the vectors set to zero
+	if x[0].Level() < eval.ExpPoly.Depth()+1+eval.MinimumInputLevel() {
+		if x, err = eval.BootstrapMany(x); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany][x]: %w", err)
+		}
+	}
+
+	if x, err = eval.Polynomial(x, eval.ExpPoly); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Polynomial][x,ExpPoly]: %w", err)
+	}
+
+	if err = eval.Rescale(x, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale][x,x]: %w", err)
+	}
+
+	if err = eval.DotVec(x, mask, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][DotVec][x,mask,x]: %w", err)
+	}
+
+	if err = eval.Rescale(x, x); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale][x,x]: %w", err)
+	}
+
+	// a*sum(exp(x - max(x))) + b in the first slot of each vector, 0 elsewhere
+	if x[0].Level() < 2+eval.MinimumInputLevel() {
+		if x, err = eval.BootstrapMany(x); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany][x]: %w", err)
+		}
+	}
+
+	norm := structs.Vector[rlwe.Ciphertext](x).Clone()
+
+	if err = eval.InnerSum(norm, 1, P, norm); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][InnerSum][norm]: %w", err)
+	}
+
+	if err = eval.DotVec(norm, maskInvA, norm); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][DotVec][norm,maskInvA,norm]: %w", err)
+	}
+
+	if err = eval.AddVec(norm, maskInvB, norm); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][AddVec][norm,maskInvB,norm]: %w", err)
+	}
+
+	if err = eval.Rescale(norm, norm); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale][norm,norm]: %w", err)
+	}
+
+	// 1/sum(exp(x - max(x))) replicated over each vector
+	if norm[0].Level() < eval.InvPoly.Depth()+1+eval.MinimumInputLevel() {
+		if norm, err = eval.BootstrapMany(norm); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany][norm]: %w", err)
+		}
+	}
+
+	if norm, err = eval.Polynomial(norm, eval.InvPoly); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Polynomial][norm,InvPoly]: %w", err)
+	}
+
+	if err = eval.Rescale(norm, norm); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale][norm,norm]: %w", err)
+	}
+
+	if err = eval.MaskAndReplicate(norm, 1, P, true); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][MaskAndReplicate][norm]: %w", err)
+	}
+
+	// exp(x - max(x)) / sum(exp(x - max(x)))
+	if norm[0].Level() < 1 {
+		if norm, err = eval.BootstrapMany(norm); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany][norm]: %w", err)
+		}
+	}
+
+	out = x
+
+	if err = eval.DotCt(out, norm, out); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][DotCt][out,norm,out]: %w", err)
+	}
+
+	if err = eval.Rescale(out, out); err != nil {
+		return nil, fmt.Errorf("[matrix.Evaluator][Rescale][out,out]: %w", err)
+	}
+
+	return
+}
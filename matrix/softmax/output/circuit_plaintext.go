@@ -0,0 +1,93 @@
+package output
+
+import (
+	"math"
+	"slices"
+
+	"app/matrix/softmax/innermax"
+	"app/utils"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// EvaluateExact sets each row of out to the softmax
+// of the corresponding row of in.
+func (eval *Evaluator) EvaluateExact(in, out []*mat.Dense) {
+
+	rows, cols := in[0].Dims()
+
+	for k := range in {
+		for i := range rows {
+			ini := in[k].RawRowView(i)
+			outi := out[k].RawRowView(i)
+			xmax := slices.Max(ini)
+			sum := 0.0
+			for j := range cols {
+				outi[j] = math.Exp(ini[j] - xmax)
+				sum += outi[j]
+			}
+			for j := range cols {
+				outi[j] /= sum
+			}
+		}
+	}
+}
+
+// EvaluateApproximate is the plaintext mirror of EvaluateEncrypted.
+// It returns the range of x - max(x) at the input of exp(x) and the
+// range of sum(exp(x - max(x))) at the input of 1/x.
+func (eval *Evaluator) EvaluateApproximate(in, out []*mat.Dense) (ExpMin, ExpMax, InvMin, InvMax float64) {
+
+	rows, cols := in[0].Dims()
+
+	P := eval.BlockSize()
+
+	coeffsExp := eval.ExpPoly.Float64()
+	coeffsInv := eval.InvPoly.Float64()
+
+	x := make([]*mat.Dense, len(in))
+	for k := range in {
+		x[k] = mat.NewDense(rows, P, nil)
+		for i := range rows {
+			row := x[k].RawRowView(i)
+			copy(row, in[k].RawRowView(i))
+			for j := cols; j < P; j++ {
+				row[j] = eval.Pad()
+			}
+		}
+	}
+
+	// x - max(x)
+	innermax.NewEvaluator(eval.MaxParameters, nil, nil).InnerMaxPlaintext(x)
+
+	ExpMin, ExpMax = 1e300, -1e300
+	InvMin, InvMax = 1e300, -1e300
+
+	for k := range x {
+		for i := range rows {
+
+			row := x[k].RawRowView(i)[:cols]
+
+			ExpMin = min(ExpMin, slices.Min(row))
+			ExpMax = max(ExpMax, slices.Max(row))
+
+			sum := 0.0
+			for j := range row {
+				row[j] = utils.ChebEval(coeffsExp, eval.ExpMin, eval.ExpMax, row[j])
+				sum += row[j]
+			}
+
+			InvMin = min(InvMin, sum)
+			InvMax = max(InvMax, sum)
+
+			inv := utils.ChebEval(coeffsInv, eval.InvMin, eval.InvMax, sum)
+
+			res := out[k].RawRowView(i)
+			for j := range row {
+				res[j] = row[j] * inv
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,100 @@
+// Package output implements an encrypted softmax over vectors of K values
+// packed every Stride slots, such as the logits of the classifier.
+package output
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+
+	"golang.org/x/exp/maps"
+
+	"app/matrix"
+	"app/matrix/softmax/innermax"
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+type Parameters struct {
+	K             int                 // Number of values per vector
+	Stride        int                 // Number of slots between the start of two consecutive vectors
+	ExpMin        float64             // Min x for exp(x), must be smaller than -(Max - Min)
+	ExpMax        float64             // Max x for exp(x)
+	ExpDeg        int                 // Polynomial approximation degree of exp(x)
+	InvMin        float64             // Min x for 1/x
+	InvMax        float64             // Max x for 1/x
+	InvDeg        int                 // Polynomial approximation degree of 1/x
+	Min           float64             // Lower bound of the values, assigned to the slots in [K, BlockSize)
+	Max           float64             // Upper bound of the values
+	MaxParameters innermax.Parameters // Comparison polynomial, AbsMax must upper bound Max - Min
+}
+
+// BlockSize returns the smallest power of two greater or equal to K,
+// which is the size of the blocks on which the max and the sum are computed.
+func (p Parameters) BlockSize() int {
+	return 1 << bits.Len64(uint64(p.K-1))
+}
+
+// Pad returns the value assigned to the slots in [K, BlockSize),
+// which is smaller than or equal to all values in [Min, Max].
+func (p Parameters) Pad() float64 {
+	return p.Min
+}
+
+// CheckRange returns an error if the comparison polynomial or exp(x)
+// do not cover the differences of the values in [Min, Max], padding included.
+func (p Parameters) CheckRange() error {
+	if p.Min > p.Max {
+		return fmt.Errorf("invalid range: Min=%f > Max=%f", p.Min, p.Max)
+	}
+	if p.Max-p.Min > float64(p.MaxParameters.AbsMax) {
+		return fmt.Errorf("invalid range: Max-Min=%f > AbsMax=%d", p.Max-p.Min, p.MaxParameters.AbsMax)
+	}
+	if p.ExpMin > p.Min-p.Max {
+		return fmt.Errorf("invalid range: ExpMin=%f > Min-Max=%f", p.ExpMin, p.Min-p.Max)
+	}
+	return nil
+}
+
+type Evaluator struct {
+	Parameters
+	ExpPoly *he.Polynomial
+	InvPoly *he.Polynomial
+	*matrix.Evaluator
+	he.Bootstrapper[rlwe.Ciphertext]
+}
+
+func NewEvaluator(p Parameters, eval *matrix.Evaluator, btp he.Bootstrapper[rlwe.Ciphertext]) *Evaluator {
+	return &Evaluator{
+		Parameters:   p,
+		ExpPoly:      utils.GetChebyshevPoly(p.ExpMin, p.ExpMax, p.ExpDeg, math.Exp),
+		InvPoly:      utils.GetChebyshevPoly(p.InvMin, p.InvMax, p.InvDeg, func(x float64) (y float64) { return 1 / x }),
+		Evaluator:    eval,
+		Bootstrapper: btp,
+	}
+}
+
+func GaloisElements(params hefloat.Parameters, p Parameters, numcts int) (galEls []uint64) {
+	m := map[uint64]bool{}
+
+	for _, galEl := range innermax.GaloisElements(params, p.BlockSize(), numcts) {
+		m[galEl] = true
+	}
+
+	for _, galEl := range rlwe.GaloisElementsForInnerSum(params, 1, p.BlockSize()) {
+		m[galEl] = true
+	}
+
+	for _, galEl := range rlwe.GaloisElementsForReplicate(params, 1, p.BlockSize()) {
+		m[galEl] = true
+	}
+
+	galEls = maps.Keys(m)
+	slices.Sort(galEls)
+
+	return
+}
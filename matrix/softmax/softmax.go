@@ -26,6 +26,7 @@ type Parameters struct {
 	InvMax        float64 // Max x for 1/x
 	InvDeg        int     // Polynomial approximation degree of 1/x
 	K             int     // Vector size
+	Valid         int     // Number of valid values of a vector, the others being padding (0 if all are valid)
 	ToTVecSize    int     // K * nbMatrices
 	MaxParameters innermax.Parameters
 	InvSqrtIter   int
@@ -66,22 +67,44 @@ func NewEvaluator(p Parameters, eval *matrix.Evaluator, btp he.Bootstrapper[rlwe
 	}
 }
 
-func GaloisElements(params hefloat.Parameters, k, numcts int) (galEls []uint64) {
-	m := map[uint64]bool{}
+// valid returns the number of valid values of a vector.
+func (p Parameters) valid() int {
+	if p.Valid == 0 {
+		return p.K
+	}
+	return p.Valid
+}
 
-	for _, galEl := range matrix.MaskAndCompressGaloisElements(params, k, numcts) {
-		m[galEl] = true
+// chunks returns the sizes of the chunks of at most K ciphertexts
+// in which EvaluateEncrypted splits numcts ciphertexts.
+func (p Parameters) chunks(numcts int) (sizes []int) {
+	for ; numcts > 0; numcts -= min(numcts, p.K) {
+		sizes = append(sizes, min(numcts, p.K))
 	}
+	return
+}
 
-	for _, galEl := range rlwe.GaloisElementsForInnerSum(params, 1, k) {
-		m[galEl] = true
+func GaloisElements(params hefloat.Parameters, p Parameters, numcts int) (galEls []uint64) {
+	m := map[uint64]bool{}
+
+	k := p.K
+
+	for _, d := range p.chunks(numcts) {
+
+		for _, galEl := range matrix.MaskAndCompressGaloisElements(params, k, d) {
+			m[galEl] = true
+		}
+
+		for _, galEl := range innermax.GaloisElements(params, k, d) {
+			m[galEl] = true
+		}
 	}
 
-	for _, galEl := range rlwe.GaloisElementsForReplicate(params, 1, k) {
+	for _, galEl := range rlwe.GaloisElementsForInnerSum(params, 1, p.valid()) {
 		m[galEl] = true
 	}
 
-	for _, galEl := range innermax.GaloisElements(params, k, numcts) {
+	for _, galEl := range rlwe.GaloisElementsForReplicate(params, 1, k) {
 		m[galEl] = true
 	}
 
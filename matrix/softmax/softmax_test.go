@@ -0,0 +1,120 @@
+package softmax
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"app/bootstrapping"
+	"app/matrix"
+	"app/matrix/minimax"
+	"app/matrix/softmax/innermax"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60, 60, 60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
+
+func TestSoftMax(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	// Vectors of K values whose last K - Valid values are
+	// padding, e.g. the zero columns of padded heads.
+	K := 8
+	valid := 5
+	vectors := params.MaxSlots() / K
+
+	// More ciphertexts than K, which are evaluated by chunks.
+	nbCts := K + 1
+
+	r := rand.New(rand.NewPCG(0, 0))
+
+	sign := minimax.MustLoad("sign_p512_a6_e15_d31-31")
+
+	p := Parameters{
+		ExpMin:     -50,
+		ExpMax:     2,
+		ExpDeg:     31,
+		InvMin:     1,
+		InvMax:     float64(valid),
+		InvDeg:     31,
+		K:          K,
+		Valid:      valid,
+		ToTVecSize: vectors * K,
+		MaxParameters: innermax.Parameters{
+			AbsMax:       64,
+			CoeffsString: sign.CoeffsString(),
+			CoeffsFloat:  sign.CoeffsFloat(),
+		},
+	}
+
+	// Values in [-10, 10]
+	in := make([]*mat.Dense, nbCts*vectors)
+	for i := range in {
+		row := make([]float64, valid)
+		for j := range row {
+			row[j] = 20*r.Float64() - 10
+		}
+		in[i] = mat.NewDense(1, valid, row)
+	}
+
+	eval := NewEvaluator(p, nil, nil)
+
+	want := make([]*mat.Dense, len(in))
+	for i := range want {
+		want[i] = mat.NewDense(1, valid, nil)
+	}
+
+	eval.EvaluateExact(in, want)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+	ecd := hefloat.NewEncoder(params)
+	enc := rlwe.NewEncryptor(params, sk)
+	dec := rlwe.NewDecryptor(params, sk)
+
+	rlk := kgen.GenRelinearizationKeyNew(sk)
+	evk := rlwe.NewMemEvaluationKeySet(rlk, kgen.GenGaloisKeysNew(GaloisElements(params, p, nbCts), sk)...)
+
+	eval.Evaluator = matrix.NewEvaluator(params, K, []*hefloat.Evaluator{hefloat.NewEvaluator(params, evk)})
+	eval.Bootstrapper = bootstrapping.NewDummyBootstrapper(1, params, sk)
+
+	cts := make([]rlwe.Ciphertext, nbCts)
+	for i := range cts {
+		values := make([]float64, params.MaxSlots())
+		for j := range vectors {
+			copy(values[j*K:], in[i*vectors+j].RawMatrix().Data)
+		}
+		pt := hefloat.NewPlaintext(params, params.MaxLevel())
+		require.NoError(t, ecd.Encode(values, pt))
+		ct := hefloat.NewCiphertext(params, 1, params.MaxLevel())
+		require.NoError(t, enc.Encrypt(pt, ct))
+		cts[i] = *ct
+	}
+
+	require.NoError(t, eval.EvaluateEncrypted(cts))
+
+	for i := range cts {
+		have := make([]float64, params.MaxSlots())
+		require.NoError(t, ecd.Decode(dec.DecryptNew(&cts[i]), have))
+		for j := range vectors {
+			require.InDeltaSlice(t, want[i*vectors+j].RawMatrix().Data, have[j*K:j*K+valid], 1e-3)
+			for _, c := range have[j*K+valid : (j+1)*K] {
+				require.InDelta(t, 0, c, 1e-3)
+			}
+		}
+	}
+}
@@ -4,42 +4,11 @@ import (
 	"fmt"
 
 	"gonum.org/v1/gonum/mat"
-
-	"github.com/Pro7ech/lattigo/he"
-	"github.com/Pro7ech/lattigo/he/hefloat"
 )
 
-func SplitDiagonals(params hefloat.Parameters, rows, cols, split, padd int, scaling float64) (diags he.Diagonals[float64]) {
-	return splitPermutation(params, rows, cols, split, padd, scaling).Diagonals(params.LogMaxDimensions())
-}
-
-func SplitGaloisElements(params hefloat.Parameters, rows, cols, split, padd int) (galEls []uint64) {
-	return splitPermutation(params, rows, cols, split, padd, 1).GaloisElements(params, params.LogMaxDimensions())
-}
-
-func splitPermutation(params hefloat.Parameters, rows, cols, split, padd int, scaling float64) (perm he.Permutation[float64]) {
-	flatten := split * rows * (cols/split + padd)
-
-	nbmatrices := params.MaxSlots() / flatten
-
-	perm = he.NewPermutation[float64](nbmatrices * rows * cols)
-
-	for d := range nbmatrices {
-		for i := range rows {
-			for j := range split {
-				for k := range cols / split {
-					idx := i*cols + j*(cols/split) + k + d*rows*cols
-					perm[idx].X = idx
-					perm[idx].Y = k + i*(cols/split+padd) + j*(rows*(cols/split+padd)) + d*flatten
-					perm[idx].C = scaling
-				}
-			}
-		}
-	}
-
-	return
-}
-
+// SplitHeads splits the columns of in into split matrices, i.e. into its
+// heads. The encrypted heads are split with the conversion between a Layout
+// and its Split.
 func SplitHeads(in *mat.Dense, split int) (out []*mat.Dense) {
 	m0 := in.RawMatrix().Data
 
@@ -0,0 +1,346 @@
+package matrix
+
+import (
+	"fmt"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// Layout describes how a batch of Rows x Cols matrices is packed in the slots
+// of a list of ciphertexts: the matrices are stored row-major with rows of
+// Cols+Padd slots, followed by PaddRows rows of padding, and each ciphertext
+// stores MatPerCt consecutive matrices.
+//
+// If Heads > 1, each sample is split into Heads consecutive matrices, one per
+// head, and MatPerCt is either a multiple of Heads or, if the heads of a sample
+// span several ciphertexts, a divisor of Heads. Samples[i] is the index of the
+// sample stored at the i-th position, i.e. in the matrices [i*Heads, (i+1)*Heads),
+// or -1 if it is empty.
+type Layout struct {
+	Rows, Cols int
+	Padd       int
+	PaddRows   int
+	MatPerCt   int
+	Heads      int
+	Samples    []int
+}
+
+// NewLayout returns the layout of n samples of rows x cols matrices,
+// stored in order with matPerCt matrices per ciphertext.
+func NewLayout(rows, cols, padd, matPerCt, n int) Layout {
+
+	samples := make([]int, n)
+	for i := range samples {
+		samples[i] = i
+	}
+
+	return Layout{
+		Rows:     rows,
+		Cols:     cols,
+		Padd:     padd,
+		MatPerCt: matPerCt,
+		Heads:    1,
+		Samples:  samples,
+	}
+}
+
+// Stride returns the number of slots occupied by a matrix.
+func (l Layout) Stride() int {
+	return (l.Rows + l.PaddRows) * (l.Cols + l.Padd)
+}
+
+// NumCts returns the number of ciphertexts of the layout.
+func (l Layout) NumCts() int {
+	return DivIntCeil(len(l.Samples)*l.Heads, l.MatPerCt)
+}
+
+// Validate returns an error if the layout is inconsistent or
+// does not fit in the slots of the parameters.
+func (l Layout) Validate(params hefloat.Parameters) (err error) {
+
+	if l.Rows < 1 || l.Cols < 1 || l.Padd < 0 || l.PaddRows < 0 || l.MatPerCt < 1 || l.Heads < 1 {
+		return fmt.Errorf("invalid layout: %s", l)
+	}
+
+	if l.MatPerCt%l.Heads != 0 && l.Heads%l.MatPerCt != 0 {
+		return fmt.Errorf("invalid layout: MatPerCt=%d is neither a multiple nor a divisor of Heads=%d", l.MatPerCt, l.Heads)
+	}
+
+	if tot := l.MatPerCt * l.Stride(); tot > params.MaxSlots() {
+		return fmt.Errorf("invalid layout: MatPerCt * (Rows + PaddRows) * (Cols + Padd) = %d > slots = %d", tot, params.MaxSlots())
+	}
+
+	return
+}
+
+// Expect returns an error if the shape and packing of l, i.e.
+// everything but the order of the samples, differ from want.
+func (l Layout) Expect(want Layout) (err error) {
+	if l.Rows != want.Rows || l.Cols != want.Cols || l.Padd != want.Padd || l.PaddRows != want.PaddRows || l.MatPerCt != want.MatPerCt || l.Heads != want.Heads {
+		return fmt.Errorf("invalid layout: expected %s but has %s", want, l)
+	}
+	return
+}
+
+func (l Layout) String() string {
+	return fmt.Sprintf("{%dx%d, Padd=%d, PaddRows=%d, MatPerCt=%d, Heads=%d, Samples=%d}", l.Rows, l.Cols, l.Padd, l.PaddRows, l.MatPerCt, l.Heads, len(l.Samples))
+}
+
+// Split returns the layout after splitting the columns of each matrix into
+// heads matrices of Cols/heads columns, with rows padded with padd zeros and
+// followed by paddRows zero rows, e.g. to pad heads wider than Rows to
+// squares. The conversion between both layouts is given by NewConversion.
+func (l Layout) Split(heads, padd, paddRows int) (out Layout, err error) {
+
+	if l.Heads != 1 {
+		return out, fmt.Errorf("invalid layout: already split in %d heads", l.Heads)
+	}
+
+	if heads < 1 || l.Cols%heads != 0 {
+		return out, fmt.Errorf("invalid heads: %d does not divide Cols=%d", heads, l.Cols)
+	}
+
+	if l.Padd != 0 || l.PaddRows != 0 {
+		return out, fmt.Errorf("invalid layout: cannot split padded matrices")
+	}
+
+	if padd < 0 || paddRows < 0 {
+		return out, fmt.Errorf("invalid padding: padd=%d and paddRows=%d must be non-negative", padd, paddRows)
+	}
+
+	return Layout{
+		Rows:     l.Rows,
+		Cols:     l.Cols / heads,
+		Padd:     padd,
+		PaddRows: paddRows,
+		MatPerCt: l.MatPerCt * heads,
+		Heads:    heads,
+		Samples:  slices.Clone(l.Samples),
+	}, nil
+}
+
+// Merge is the inverse of Split.
+func (l Layout) Merge() (out Layout, err error) {
+
+	if l.Heads == 1 {
+		return out, fmt.Errorf("invalid layout: not split")
+	}
+
+	if l.MatPerCt%l.Heads != 0 {
+		return out, fmt.Errorf("invalid layout: the heads of a sample span several ciphertexts")
+	}
+
+	return Layout{
+		Rows:     l.Rows,
+		Cols:     l.Cols * l.Heads,
+		Padd:     0,
+		MatPerCt: l.MatPerCt / l.Heads,
+		Heads:    1,
+		Samples:  slices.Clone(l.Samples),
+	}, nil
+}
+
+// Tile returns the layout of the matrices of l zero-padded to
+// tile x tile matrices, i.e. stored as a single block of [Tile],
+// e.g. to multiply them with [Evaluator.MulCtRect].
+func (l Layout) Tile(tile int) (out Layout, err error) {
+
+	if tile < l.Rows || tile < l.Cols {
+		return out, fmt.Errorf("invalid tile: %d is smaller than the %dx%d matrices", tile, l.Rows, l.Cols)
+	}
+
+	out = l
+	out.Padd, out.PaddRows = tile-l.Cols, tile-l.Rows
+	out.Samples = slices.Clone(l.Samples)
+
+	return
+}
+
+// Pool returns the layout after reducing each matrix to a single row
+// and packing the rows of Rows consecutive ciphertexts into one: the
+// j-th ciphertext of a group is stored in the j-th row of each matrix,
+// i.e. the i-th matrix of the j-th ciphertext becomes the (i*Rows + j)-th
+// row of the output ciphertext.
+func (l Layout) Pool() (out Layout, err error) {
+
+	if l.Heads != 1 {
+		return out, fmt.Errorf("invalid layout: cannot pool split matrices")
+	}
+
+	if l.PaddRows != 0 {
+		return out, fmt.Errorf("invalid layout: cannot pool matrices padded with zero rows")
+	}
+
+	numCts := l.NumCts()
+
+	out = Layout{
+		Rows:     1,
+		Cols:     l.Cols,
+		Padd:     l.Padd,
+		MatPerCt: l.MatPerCt * l.Rows,
+		Heads:    1,
+	}
+
+	out.Samples = make([]int, DivIntCeil(numCts, l.Rows)*out.MatPerCt)
+
+	for i := range out.Samples {
+
+		ct, pos := i/out.MatPerCt, i%out.MatPerCt
+		m, row := pos/l.Rows, pos%l.Rows
+
+		if idx := (ct*l.Rows+row)*l.MatPerCt + m; idx < len(l.Samples) {
+			out.Samples[i] = l.Samples[idx]
+		} else {
+			out.Samples[i] = -1
+		}
+	}
+
+	return
+}
+
+// Resize returns the layout after mapping each row of Cols+Padd
+// slots to a row of cols values followed by padd zeros.
+func (l Layout) Resize(cols, padd int) (out Layout, err error) {
+
+	if cols+padd != l.Cols+l.Padd {
+		return out, fmt.Errorf("invalid resize: cols + padd = %d != Cols + Padd = %d", cols+padd, l.Cols+l.Padd)
+	}
+
+	out = l
+	out.Cols = cols
+	out.Padd = padd
+	out.Samples = slices.Clone(l.Samples)
+
+	return
+}
+
+// Compact returns the layout storing the samples of l in the same order,
+// without the empty positions, with as many matrices per ciphertext as
+// the slots of the parameters can store. Underfilled ciphertexts, e.g.
+// after the pooling, are thus packed into fewer ciphertexts.
+func (l Layout) Compact(params hefloat.Parameters) (out Layout) {
+
+	out = l
+	// The heads of a sample spanning several ciphertexts are left as they are.
+	out.MatPerCt = max(params.MaxSlots()/(l.Stride()*l.Heads)*l.Heads, l.MatPerCt)
+	out.Samples = slices.DeleteFunc(slices.Clone(l.Samples), func(s int) bool { return s < 0 })
+
+	return
+}
+
+// Tensor is a batch of encrypted matrices with its Layout.
+type Tensor struct {
+	Layout
+	Cts []rlwe.Ciphertext
+}
+
+// NewTensor returns a Tensor, after checking that the number
+// of ciphertexts matches the layout.
+func NewTensor(layout Layout, cts []rlwe.Ciphertext) (t *Tensor, err error) {
+	if len(cts) != layout.NumCts() {
+		return nil, fmt.Errorf("invalid tensor: layout %s requires %d ciphertexts but has %d", layout, layout.NumCts(), len(cts))
+	}
+	return &Tensor{Layout: layout, Cts: cts}, nil
+}
+
+// Relayout replaces the layout of the tensor, after checking
+// that the number of ciphertexts matches the new layout.
+func (t *Tensor) Relayout(layout Layout) (err error) {
+	if len(t.Cts) != layout.NumCts() {
+		return fmt.Errorf("invalid tensor: layout %s requires %d ciphertexts but has %d", layout, layout.NumCts(), len(t.Cts))
+	}
+	t.Layout = layout
+	return
+}
+
+// Replace replaces the ciphertexts of the tensor, after checking
+// that their number matches the layout.
+func (t *Tensor) Replace(cts []rlwe.Ciphertext) (err error) {
+	if len(cts) != t.NumCts() {
+		return fmt.Errorf("invalid tensor: layout %s requires %d ciphertexts but has %d", t.Layout, t.NumCts(), len(cts))
+	}
+	t.Cts = cts
+	return
+}
+
+// EncryptTensorNew encrypts the matrices in order with the given padding
+// and number of matrices per ciphertext and returns them with their layout.
+func (enc *Encryptor) EncryptTensorNew(in []*mat.Dense, padd, matPerCt int) (t *Tensor, err error) {
+
+	rows, cols := in[0].Dims()
+
+	for i := range in {
+		if r, c := in[i].Dims(); r != rows || c != cols {
+			return nil, fmt.Errorf("invalid input: matrix %d is %dx%d but matrix 0 is %dx%d", i, r, c, rows, cols)
+		}
+	}
+
+	layout := NewLayout(rows, cols, padd, matPerCt, len(in))
+
+	if err = layout.Validate(enc.Parameters()); err != nil {
+		return
+	}
+
+	var cts []rlwe.Ciphertext
+	if cts, err = enc.EncryptNew(in, padd, matPerCt); err != nil {
+		return nil, fmt.Errorf("[matrix.Encryptor].EncryptNew: %w", err)
+	}
+
+	return NewTensor(layout, cts)
+}
+
+// DecryptTensorNew decrypts the tensor and returns the matrices in the
+// order of the samples. Split matrices are merged back.
+func (dec *Decryptor) DecryptTensorNew(t *Tensor) (out []*mat.Dense, err error) {
+
+	if err = t.Validate(dec.Parameters()); err != nil {
+		return
+	}
+
+	if len(t.Cts) != t.NumCts() {
+		return nil, fmt.Errorf("invalid tensor: layout %s requires %d ciphertexts but has %d", t.Layout, t.NumCts(), len(t.Cts))
+	}
+
+	var ms []*mat.Dense
+	if ms, err = dec.DecryptNew(t.Cts, t.Rows+t.PaddRows, t.Cols, t.Padd, t.MatPerCt); err != nil {
+		return nil, fmt.Errorf("[matrix.Decryptor].DecryptNew: %w", err)
+	}
+
+	if t.PaddRows != 0 {
+		for i := range ms {
+			ms[i] = mat.DenseCopyOf(ms[i].Slice(0, t.Rows, 0, t.Cols))
+		}
+	}
+
+	n := 0
+	for _, s := range t.Samples {
+		if s >= 0 {
+			n++
+		}
+	}
+
+	out = make([]*mat.Dense, n)
+
+	for i, s := range t.Samples {
+
+		if s < 0 {
+			continue
+		}
+
+		if s >= n || out[s] != nil {
+			return nil, fmt.Errorf("invalid layout: samples are not a permutation of [0, %d)", n)
+		}
+
+		if t.Heads == 1 {
+			out[s] = ms[i]
+		} else {
+			out[s] = MergeHeads(ms[i*t.Heads : (i+1)*t.Heads])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,148 @@
+package matrix
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTensor(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+	tc := newTestContext(params)
+
+	enc := NewEncryptor(params, tc.sk)
+	dec := NewDecryptor(params, tc.sk)
+
+	rows := 8
+	cols := 16
+	heads := 4
+	matPerCt := 2
+
+	r := sampling.NewSource([32]byte{})
+
+	in := make([]*mat.Dense, 5)
+	for i := range in {
+		m := make([]float64, rows*cols)
+		for j := range m {
+			m[j] = r.Float64(-1, 1)
+		}
+		in[i] = mat.NewDense(rows, cols, m)
+	}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+
+		ct, err := enc.EncryptTensorNew(in, 0, matPerCt)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(ct.Cts))
+
+		have, err := dec.DecryptTensorNew(ct)
+		require.NoError(t, err)
+		require.Equal(t, len(in), len(have))
+
+		for i := range in {
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, in[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+
+	t.Run("Split", func(t *testing.T) {
+
+		ct, err := enc.EncryptTensorNew(in, 0, matPerCt)
+		require.NoError(t, err)
+
+		layout, err := ct.Split(heads, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, layout.Validate(params))
+
+		c, err := NewConversion(params, ct.Layout, layout, 1)
+		require.NoError(t, err)
+
+		eval := NewEvaluator(params, rows, []*hefloat.Evaluator{tc.eval.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(c.GaloisElements(params), tc.sk)...))})
+
+		lts, err := eval.NewConversionLinearTransformations(params.MaxLevel(), params.DefaultScale(), params.DefaultScale(), c)
+		require.NoError(t, err)
+
+		out, err := eval.Convert(ct, c, lts)
+		require.NoError(t, err)
+		require.NoError(t, eval.Rescale(out.Cts, out.Cts))
+
+		ct = out
+
+		require.NoError(t, ct.Expect(layout))
+
+		// The heads are merged back by the decryptor.
+		have, err := dec.DecryptTensorNew(ct)
+		require.NoError(t, err)
+
+		for i := range in {
+			hefloat.VerifyTestVectors(params, tc.ecd, nil, have[i].RawMatrix().Data, in[i].RawMatrix().Data, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+
+		_, err = layout.Split(heads, 0, 0)
+		require.Error(t, err)
+
+		merged, err := layout.Merge()
+		require.NoError(t, err)
+		require.NoError(t, merged.Expect(NewLayout(rows, cols, 0, matPerCt, len(in))))
+	})
+
+	t.Run("Pool", func(t *testing.T) {
+
+		// 10 samples, 3 per ciphertext: 4 ciphertexts pooled into 2
+		// ciphertexts of 6 rows, the j-th input ciphertext of each
+		// pair being stored in the j-th row of each pooled matrix.
+		layout, err := NewLayout(2, cols, 0, 3, 10).Pool()
+		require.NoError(t, err)
+		require.Equal(t, 6, layout.MatPerCt)
+		require.Equal(t, 2, layout.NumCts())
+		require.Equal(t, []int{0, 3, 1, 4, 2, 5, 6, 9, 7, -1, 8, -1}, layout.Samples)
+	})
+
+	t.Run("Compact", func(t *testing.T) {
+
+		// The 2 pooled ciphertexts of 12 rows store 10 rows.
+		layout, err := NewLayout(2, cols, 0, 3, 10).Pool()
+		require.NoError(t, err)
+
+		compact := layout.Compact(params)
+		require.Equal(t, params.MaxSlots()/cols, compact.MatPerCt)
+		require.Equal(t, 1, compact.NumCts())
+		require.Equal(t, []int{0, 3, 1, 4, 2, 5, 6, 9, 7, 8}, compact.Samples)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+
+		layout := NewLayout(rows, cols, 0, matPerCt, len(in))
+
+		require.Error(t, layout.Expect(NewLayout(rows, cols/2, cols/2, matPerCt, len(in))))
+
+		_, err := layout.Resize(cols, 1)
+		require.Error(t, err)
+
+		_, err = layout.Split(3, 0, 0)
+		require.Error(t, err)
+
+		_, err = NewTensor(layout, make([]rlwe.Ciphertext, 2))
+		require.Error(t, err)
+
+		// 5 samples with 2 per ciphertext: the last ciphertext is underfilled.
+		tensor, err := NewTensor(layout, make([]rlwe.Ciphertext, 3))
+		require.NoError(t, err)
+		require.Error(t, tensor.Replace(make([]rlwe.Ciphertext, 2)))
+		require.Error(t, tensor.Relayout(NewLayout(rows, cols, 0, matPerCt, 2*len(in))))
+
+		ct, err := enc.EncryptTensorNew(in, 0, matPerCt)
+		require.NoError(t, err)
+
+		ct.Samples[1] = 0
+		_, err = dec.DecryptTensorNew(ct)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package multiparty
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/mhe/mhefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// Bootstrapper is an he.Bootstrapper[rlwe.Ciphertext] that refreshes
+// the ciphertexts with the interactive refresh protocol among the parties,
+// as an alternative to bootstrapping.Bootstrapper.
+type Bootstrapper struct {
+	hefloat.Parameters
+	Parties
+	MinLevel int
+	Count    int
+}
+
+func NewBootstrapper(params hefloat.Parameters, P Parties) (*Bootstrapper, error) {
+
+	minLevel, _, ok := mhefloat.GetMinimumLevelForRefresh(128, params.DefaultScale(), len(P), params.Q())
+	if !ok || minLevel > params.MaxLevel() {
+		return nil, fmt.Errorf("[mhefloat.GetMinimumLevelForRefresh]: parameters do not allow a secure refresh for %d parties", len(P))
+	}
+
+	return &Bootstrapper{
+		Parameters: params,
+		Parties:    P,
+		MinLevel:   minLevel,
+	}, nil
+}
+
+func (btp *Bootstrapper) Bootstrap(ct *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
+	cts, err := btp.BootstrapMany([]rlwe.Ciphertext{*ct})
+	if err != nil {
+		return nil, err
+	}
+	return &cts[0], nil
+}
+
+func (btp *Bootstrapper) BootstrapMany(cts []rlwe.Ciphertext) (out []rlwe.Ciphertext, err error) {
+
+	out = make([]rlwe.Ciphertext, len(cts))
+
+	for i := range cts {
+
+		if cts[i].Level() < btp.MinLevel {
+			return nil, fmt.Errorf("[Bootstrapper][BootstrapMany]: ciphertext %d at level %d < MinimumInputLevel=%d", i, cts[i].Level(), btp.MinLevel)
+		}
+
+		// Drops the ciphertext to the minimum level, which reduces the size of the shares.
+		ct := cts[i].Clone()
+		ct.ResizeQ(btp.MinLevel)
+
+		var ctRefreshed *rlwe.Ciphertext
+		if ctRefreshed, err = btp.RefreshNew(ct); err != nil {
+			return nil, fmt.Errorf("[Parties][RefreshNew]: %w", err)
+		}
+
+		out[i] = *ctRefreshed
+	}
+
+	btp.Count += len(cts)
+
+	return
+}
+
+func (btp *Bootstrapper) Depth() int {
+	return 0
+}
+
+func (btp *Bootstrapper) MinimumInputLevel() int {
+	return btp.MinLevel
+}
+
+func (btp *Bootstrapper) OutputLevel() int {
+	return btp.MaxLevel()
+}
@@ -0,0 +1,189 @@
+// Package multiparty implements the collective key generation, the interactive
+// refresh and the threshold decryption for several data owners (parties) that
+// pool their data under a jointly generated key, so that no single party can
+// decrypt. The ideal secret key, which no party knows, is the sum of the secret
+// keys of all the parties (N-out-of-N access structure).
+//
+// The parties generate shares (Party.Gen*), which are aggregated by a helper,
+// such as the server (Aggregate*). Parties simulates N in-process parties.
+package multiparty
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/mhe"
+	"github.com/Pro7ech/lattigo/mhe/mhefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// DefaultSmudging is the default standard deviation of the noise added by each
+// party to its decryption share, which hides its secret key from the others.
+const DefaultSmudging = 1 << 20
+
+// Party is a data owner holding a share of the ideal secret key.
+type Party struct {
+	hefloat.Parameters
+	Sk       *rlwe.SecretKey
+	Smudging float64
+}
+
+func NewParty(params hefloat.Parameters) *Party {
+	return &Party{
+		Parameters: params,
+		Sk:         rlwe.NewKeyGenerator(params).GenSecretKeyNew(),
+		Smudging:   DefaultSmudging,
+	}
+}
+
+// GenPublicKeyShare generates the share of the party for the collective public key.
+// The common random seed crs must be the same for all parties.
+func (p *Party) GenPublicKeyShare(crs [32]byte) (share *mhe.PublicKeyShare, err error) {
+	protocol := mhe.NewPublicKeyProtocol(p.Parameters)
+	share = protocol.Allocate()
+	if err = protocol.Gen(p.Sk, crs, share); err != nil {
+		return nil, fmt.Errorf("[mhe.PublicKeyProtocol][Gen]: %w", err)
+	}
+	return
+}
+
+// GenRelinearizationKeyShare generates the share of the party for the
+// collective relinearization key, given the collective public key.
+func (p *Party) GenRelinearizationKeyShare(pk *rlwe.PublicKey) (share *mhe.RelinearizationKeyShare, err error) {
+	protocol := mhe.NewRelinearizationKeyProtocol(p.Parameters)
+	share = protocol.Allocate()
+	if err = protocol.Gen(p.Sk, pk, share); err != nil {
+		return nil, fmt.Errorf("[mhe.RelinearizationKeyProtocol][Gen]: %w", err)
+	}
+	return
+}
+
+// GenGaloisKeyShare generates the share of the party for the collective Galois key of galEl.
+// The common random seed crs must be the same for all parties.
+func (p *Party) GenGaloisKeyShare(galEl uint64, crs [32]byte) (share *mhe.GaloisKeyShare, err error) {
+	protocol := mhe.NewGaloisKeyProtocol(p.Parameters)
+	share = protocol.Allocate()
+	if err = protocol.Gen(p.Sk, galEl, crs, share); err != nil {
+		return nil, fmt.Errorf("[mhe.GaloisKeyProtocol][Gen]: %w", err)
+	}
+	return
+}
+
+// GenRefreshShare generates the share of the party for the interactive refresh of ct
+// among nParties parties. The common random seed crs must be the same for all parties.
+func (p *Party) GenRefreshShare(ct *rlwe.Ciphertext, nParties int, crs [32]byte) (share *mhe.RefreshShare, err error) {
+
+	_, logBound, ok := mhefloat.GetMinimumLevelForRefresh(128, p.DefaultScale(), nParties, p.Q())
+	if !ok {
+		return nil, fmt.Errorf("[mhefloat.GetMinimumLevelForRefresh]: parameters do not allow a secure refresh for %d parties", nParties)
+	}
+
+	protocol := mhefloat.NewRefreshProtocol(p.Parameters, logBound)
+	share = protocol.Allocate(ct.Level(), p.MaxLevel())
+	if err = protocol.Gen(p.Sk, logBound, ct, crs, share); err != nil {
+		return nil, fmt.Errorf("[mhefloat.RefreshProtocol][Gen]: %w", err)
+	}
+	return
+}
+
+// GenDecryptionShare generates the share of the party for the threshold decryption of ct.
+func (p *Party) GenDecryptionShare(ct *rlwe.Ciphertext) (share *mhe.KeySwitchingShare, err error) {
+	protocol := mhe.NewKeySwitchingProtocol[rlwe.SecretKey](p.Parameters)
+	share = protocol.Allocate(ct.Level())
+	if err = protocol.Gen(p.Sk, rlwe.NewSecretKey(p.Parameters), p.Smudging, ct, share); err != nil {
+		return nil, fmt.Errorf("[mhe.KeySwitchingProtocol][Gen]: %w", err)
+	}
+	return
+}
+
+// AggregatePublicKey returns the collective public key from the shares of all
+// parties. As for all Aggregate functions, the first share is used as accumulator.
+func AggregatePublicKey(params hefloat.Parameters, shares []*mhe.PublicKeyShare) (pk *rlwe.PublicKey, err error) {
+	protocol := mhe.NewPublicKeyProtocol(params)
+	for _, share := range shares[1:] {
+		if err = protocol.Aggregate(shares[0], share, shares[0]); err != nil {
+			return nil, fmt.Errorf("[mhe.PublicKeyProtocol][Aggregate]: %w", err)
+		}
+	}
+	pk = rlwe.NewPublicKey(params)
+	if err = protocol.Finalize(shares[0], pk); err != nil {
+		return nil, fmt.Errorf("[mhe.PublicKeyProtocol][Finalize]: %w", err)
+	}
+	return
+}
+
+// AggregateRelinearizationKey returns the collective relinearization key from the shares of all parties.
+func AggregateRelinearizationKey(params hefloat.Parameters, shares []*mhe.RelinearizationKeyShare) (rlk *rlwe.RelinearizationKey, err error) {
+	protocol := mhe.NewRelinearizationKeyProtocol(params)
+	for _, share := range shares[1:] {
+		if err = protocol.Aggregate(shares[0], share, shares[0]); err != nil {
+			return nil, fmt.Errorf("[mhe.RelinearizationKeyProtocol][Aggregate]: %w", err)
+		}
+	}
+	rlk = rlwe.NewRelinearizationKey(params)
+	if err = protocol.Finalize(shares[0], rlk); err != nil {
+		return nil, fmt.Errorf("[mhe.RelinearizationKeyProtocol][Finalize]: %w", err)
+	}
+	return
+}
+
+// AggregateGaloisKey returns the collective Galois key from the shares of all parties.
+func AggregateGaloisKey(params hefloat.Parameters, shares []*mhe.GaloisKeyShare) (gk *rlwe.GaloisKey, err error) {
+	protocol := mhe.NewGaloisKeyProtocol(params)
+	for _, share := range shares[1:] {
+		if err = protocol.Aggregate(shares[0], share, shares[0]); err != nil {
+			return nil, fmt.Errorf("[mhe.GaloisKeyProtocol][Aggregate]: %w", err)
+		}
+	}
+	return protocol.FinalizeNew(shares[0]), nil
+}
+
+// AggregateRefresh returns ct refreshed at the maximum level from the shares of all parties.
+func AggregateRefresh(params hefloat.Parameters, ct *rlwe.Ciphertext, shares []*mhe.RefreshShare) (out *rlwe.Ciphertext, err error) {
+
+	_, logBound, ok := mhefloat.GetMinimumLevelForRefresh(128, params.DefaultScale(), len(shares), params.Q())
+	if !ok {
+		return nil, fmt.Errorf("[mhefloat.GetMinimumLevelForRefresh]: parameters do not allow a secure refresh for %d parties", len(shares))
+	}
+
+	protocol := mhefloat.NewRefreshProtocol(params, logBound)
+	for _, share := range shares[1:] {
+		if err = protocol.Aggregate(shares[0], share, shares[0]); err != nil {
+			return nil, fmt.Errorf("[mhefloat.RefreshProtocol][Aggregate]: %w", err)
+		}
+	}
+
+	out = hefloat.NewCiphertext(params, 1, params.MaxLevel())
+	if err = protocol.Finalize(ct, shares[0], out); err != nil {
+		return nil, fmt.Errorf("[mhefloat.RefreshProtocol][Finalize]: %w", err)
+	}
+	return
+}
+
+// AggregateDecryption returns the decryption of ct from the shares of all parties.
+func AggregateDecryption(params hefloat.Parameters, ct *rlwe.Ciphertext, shares []*mhe.KeySwitchingShare) (pt *rlwe.Plaintext, err error) {
+
+	var out *rlwe.Ciphertext
+	if out, err = AggregateKeySwitch(params, ct, shares); err != nil {
+		return
+	}
+
+	return rlwe.NewDecryptor(params, rlwe.NewSecretKey(params)).DecryptNew(out), nil
+}
+
+// AggregateKeySwitch returns ct switched to the zero secret key from the
+// decryption shares of all parties, which anyone can decrypt.
+func AggregateKeySwitch(params hefloat.Parameters, ct *rlwe.Ciphertext, shares []*mhe.KeySwitchingShare) (out *rlwe.Ciphertext, err error) {
+	protocol := mhe.NewKeySwitchingProtocol[rlwe.SecretKey](params)
+	for _, share := range shares[1:] {
+		if err = protocol.Aggregate(shares[0], share, shares[0]); err != nil {
+			return nil, fmt.Errorf("[mhe.KeySwitchingProtocol][Aggregate]: %w", err)
+		}
+	}
+
+	if out, err = protocol.FinalizeNew(ct, shares[0]); err != nil {
+		return nil, fmt.Errorf("[mhe.KeySwitchingProtocol][FinalizeNew]: %w", err)
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package multiparty
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/stretchr/testify/require"
+
+	"app/keys"
+)
+
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60, 60, 60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
+
+func TestMultiparty(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	N := 3
+	P := NewParties(params, N)
+
+	pk, err := P.GenPublicKeyNew()
+	require.NoError(t, err)
+
+	rlk, err := P.GenRelinearizationKeyNew(pk)
+	require.NoError(t, err)
+
+	rot := 5
+	gks, err := P.GenGaloisKeysNew([]uint64{params.GaloisElement(rot)})
+	require.NoError(t, err)
+
+	km := keys.NewManagerFromKeys(rlk, gks)
+	require.NoError(t, km.LoadGaloisKeys([]uint64{params.GaloisElement(rot)}))
+	require.Error(t, km.LoadGaloisKeys([]uint64{params.GaloisElement(rot + 1)}))
+
+	btp, err := NewBootstrapper(params, P)
+	require.NoError(t, err)
+
+	ecd := hefloat.NewEncoder(params)
+	enc := rlwe.NewEncryptor(params, pk)
+	eval := hefloat.NewEvaluator(params, km.AsMemEvaluationKeySet())
+
+	r := rand.New(rand.NewPCG(0, 0))
+
+	slots := params.MaxSlots()
+	values := make([]float64, slots)
+	for i := range values {
+		values[i] = 2*r.Float64() - 1
+	}
+
+	pt := hefloat.NewPlaintext(params, params.MaxLevel())
+	require.NoError(t, ecd.Encode(values, pt))
+	ct := hefloat.NewCiphertext(params, 1, pt.Level())
+	require.NoError(t, enc.Encrypt(pt, ct))
+
+	// x -> rot(x^2, 5)
+	require.NoError(t, eval.MulRelin(ct, ct, ct))
+	require.NoError(t, eval.Rescale(ct, ct))
+	require.NoError(t, eval.Rotate(ct, rot, ct))
+
+	want := make([]float64, slots)
+	for i := range want {
+		want[i] = values[(i+rot)%slots] * values[(i+rot)%slots]
+	}
+	values = want
+
+	// Refreshed at the minimum level
+	eval.DropLevel(ct, ct.Level()-btp.MinimumInputLevel())
+
+	ct, err = btp.Bootstrap(ct)
+	require.NoError(t, err)
+	require.Equal(t, params.MaxLevel(), ct.Level())
+
+	t.Run("ThresholdDecryption", func(t *testing.T) {
+		pt, err := P.DecryptNew(ct)
+		require.NoError(t, err)
+
+		have := make([]float64, slots)
+		require.NoError(t, ecd.Decode(pt, have))
+
+		for i := range have {
+			require.InDelta(t, values[i], have[i], math.Exp2(-15))
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package multiparty
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+
+	"app/matrix"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/mhe"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+)
+
+// Parties simulates N in-process parties, running each
+// protocol end to end (share generation and aggregation).
+type Parties []*Party
+
+func NewParties(params hefloat.Parameters, n int) (P Parties) {
+	P = make([]*Party, n)
+	for i := range P {
+		P[i] = NewParty(params)
+	}
+	return
+}
+
+func (P Parties) GenPublicKeyNew() (pk *rlwe.PublicKey, err error) {
+	crs := sampling.NewSeed()
+	shares := make([]*mhe.PublicKeyShare, len(P))
+	for i := range P {
+		if shares[i], err = P[i].GenPublicKeyShare(crs); err != nil {
+			return nil, fmt.Errorf("[Party][%d][GenPublicKeyShare]: %w", i, err)
+		}
+	}
+	return AggregatePublicKey(P[0].Parameters, shares)
+}
+
+func (P Parties) GenRelinearizationKeyNew(pk *rlwe.PublicKey) (rlk *rlwe.RelinearizationKey, err error) {
+	shares := make([]*mhe.RelinearizationKeyShare, len(P))
+	for i := range P {
+		if shares[i], err = P[i].GenRelinearizationKeyShare(pk); err != nil {
+			return nil, fmt.Errorf("[Party][%d][GenRelinearizationKeyShare]: %w", i, err)
+		}
+	}
+	return AggregateRelinearizationKey(P[0].Parameters, shares)
+}
+
+func (P Parties) GenGaloisKeysNew(galEls []uint64) (gks []*rlwe.GaloisKey, err error) {
+	gks = make([]*rlwe.GaloisKey, len(galEls))
+	shares := make([]*mhe.GaloisKeyShare, len(P))
+	for j, galEl := range galEls {
+		crs := sampling.NewSeed()
+		for i := range P {
+			if shares[i], err = P[i].GenGaloisKeyShare(galEl, crs); err != nil {
+				return nil, fmt.Errorf("[Party][%d][GenGaloisKeyShare][%d]: %w", i, galEl, err)
+			}
+		}
+		if gks[j], err = AggregateGaloisKey(P[0].Parameters, shares); err != nil {
+			return nil, fmt.Errorf("[AggregateGaloisKey][%d]: %w", galEl, err)
+		}
+	}
+	return
+}
+
+func (P Parties) RefreshNew(ct *rlwe.Ciphertext) (out *rlwe.Ciphertext, err error) {
+	crs := sampling.NewSeed()
+	shares := make([]*mhe.RefreshShare, len(P))
+	for i := range P {
+		if shares[i], err = P[i].GenRefreshShare(ct, len(P), crs); err != nil {
+			return nil, fmt.Errorf("[Party][%d][GenRefreshShare]: %w", i, err)
+		}
+	}
+	return AggregateRefresh(P[0].Parameters, ct, shares)
+}
+
+func (P Parties) DecryptNew(ct *rlwe.Ciphertext) (pt *rlwe.Plaintext, err error) {
+	var shares []*mhe.KeySwitchingShare
+	if shares, err = P.genDecryptionShares(ct); err != nil {
+		return
+	}
+	return AggregateDecryption(P[0].Parameters, ct, shares)
+}
+
+// DecryptTensorNew decrypts the tensor, e.g. the classifier output, with the
+// decryption shares of all parties, and returns the matrices in the order of
+// the samples (see matrix.Decryptor.DecryptTensorNew).
+func (P Parties) DecryptTensorNew(t *matrix.Tensor) (out []*mat.Dense, err error) {
+
+	params := P[0].Parameters
+
+	cts := make([]rlwe.Ciphertext, len(t.Cts))
+	for i := range t.Cts {
+
+		var shares []*mhe.KeySwitchingShare
+		if shares, err = P.genDecryptionShares(&t.Cts[i]); err != nil {
+			return
+		}
+
+		var ct *rlwe.Ciphertext
+		if ct, err = AggregateKeySwitch(params, &t.Cts[i], shares); err != nil {
+			return nil, fmt.Errorf("[AggregateKeySwitch][%d]: %w", i, err)
+		}
+
+		cts[i] = *ct
+	}
+
+	var switched *matrix.Tensor
+	if switched, err = matrix.NewTensor(t.Layout, cts); err != nil {
+		return nil, fmt.Errorf("[matrix].NewTensor: %w", err)
+	}
+
+	// The ciphertexts are under the zero secret key
+	return matrix.NewDecryptor(params, rlwe.NewSecretKey(params)).DecryptTensorNew(switched)
+}
+
+func (P Parties) genDecryptionShares(ct *rlwe.Ciphertext) (shares []*mhe.KeySwitchingShare, err error) {
+	shares = make([]*mhe.KeySwitchingShare, len(P))
+	for i := range P {
+		if shares[i], err = P[i].GenDecryptionShare(ct); err != nil {
+			return nil, fmt.Errorf("[Party][%d][GenDecryptionShare]: %w", i, err)
+		}
+	}
+	return
+}
@@ -0,0 +1,62 @@
+// Package oracle isolates the decryption of intermediate values for debugging
+// behind the Oracle interface. The oracle is supplied by the client (owner of
+// the secret key), so that the production server structs never hold the secret
+// key, and every invocation is written to an audit log.
+package oracle
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// Oracle decrypts and decodes ciphertexts on behalf of the server.
+// The reason identifies the caller in the audit log.
+type Oracle interface {
+	DecryptNew(reason string, ct *rlwe.Ciphertext) (values []float64, err error)
+}
+
+// SecretKeyOracle is the Oracle of the client. It is safe for concurrent use.
+type SecretKeyOracle struct {
+	sync.Mutex
+	dec   *rlwe.Decryptor
+	ecd   *hefloat.Encoder
+	audit *log.Logger
+	Calls int
+}
+
+// NewSecretKeyOracle returns an Oracle decrypting with sk, which logs
+// every invocation to audit. The audit log is mandatory.
+func NewSecretKeyOracle(params hefloat.Parameters, sk *rlwe.SecretKey, audit io.Writer) *SecretKeyOracle {
+
+	if audit == nil {
+		panic(fmt.Errorf("[oracle.NewSecretKeyOracle]: audit log is nil"))
+	}
+
+	return &SecretKeyOracle{
+		dec:   rlwe.NewDecryptor(params, sk),
+		ecd:   hefloat.NewEncoder(params),
+		audit: log.New(audit, "[oracle] ", log.LstdFlags|log.Lmicroseconds),
+	}
+}
+
+func (o *SecretKeyOracle) DecryptNew(reason string, ct *rlwe.Ciphertext) (values []float64, err error) {
+
+	o.Lock()
+	defer o.Unlock()
+
+	o.Calls++
+
+	o.audit.Printf("decrypt #%d %q: level=%d, logscale=%.2f, slots=%d", o.Calls, reason, ct.Level(), ct.LogScale(), ct.Slots())
+
+	values = make([]float64, ct.Slots())
+	if err = o.ecd.Decode(o.dec.DecryptNew(ct), values); err != nil {
+		return nil, fmt.Errorf("[hefloat.Encoder][Decode]: %w", err)
+	}
+
+	return
+}
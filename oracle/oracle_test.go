@@ -0,0 +1,55 @@
+package oracle
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracle(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+		LogN:            10,
+		LogQ:            []int{60, 45},
+		LogP:            []int{60},
+		LogDefaultScale: 45,
+		RingType:        ring.ConjugateInvariant,
+	})
+	require.NoError(t, err)
+
+	sk := rlwe.NewKeyGenerator(params).GenSecretKeyNew()
+
+	values := make([]float64, params.MaxSlots())
+	for i := range values {
+		values[i] = float64(i) / float64(len(values))
+	}
+
+	pt := hefloat.NewPlaintext(params, params.MaxLevel())
+	require.NoError(t, hefloat.NewEncoder(params).Encode(values, pt))
+	ct := hefloat.NewCiphertext(params, 1, pt.Level())
+	require.NoError(t, rlwe.NewEncryptor(params, sk).Encrypt(pt, ct))
+
+	audit := new(bytes.Buffer)
+	var o Oracle = NewSecretKeyOracle(params, sk, audit)
+
+	for range 2 {
+		have, err := o.DecryptNew("TestOracle", ct)
+		require.NoError(t, err)
+		for i := range values {
+			require.InDelta(t, values[i], have[i], 1e-9)
+		}
+	}
+
+	require.Equal(t, 2, o.(*SecretKeyOracle).Calls)
+
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[1], `decrypt #2 "TestOracle": level=1`)
+
+	require.Panics(t, func() { NewSecretKeyOracle(params, sk, nil) })
+}
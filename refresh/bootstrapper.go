@@ -0,0 +1,135 @@
+package refresh
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+)
+
+// Bootstrapper is an he.Bootstrapper[rlwe.Ciphertext] that refreshes
+// the ciphertexts with the help of the client through a Transport,
+// as an alternative to bootstrapping.Bootstrapper.
+type Bootstrapper struct {
+	Parameters
+	params hefloat.Parameters
+	Transport
+	MinLevel int
+	Count    int
+}
+
+func NewBootstrapper(params hefloat.Parameters, p Parameters, transport Transport) (*Bootstrapper, error) {
+
+	minLevel, err := p.MinimumLevel(params, params.DefaultScale())
+	if err != nil {
+		return nil, fmt.Errorf("[refresh.Parameters][MinimumLevel]: %w", err)
+	}
+
+	return &Bootstrapper{
+		Parameters: p,
+		params:     params,
+		Transport:  transport,
+		MinLevel:   minLevel,
+	}, nil
+}
+
+func (btp *Bootstrapper) Bootstrap(ct *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
+	cts, err := btp.BootstrapMany([]rlwe.Ciphertext{*ct})
+	if err != nil {
+		return nil, err
+	}
+	return &cts[0], nil
+}
+
+func (btp *Bootstrapper) BootstrapMany(cts []rlwe.Ciphertext) (out []rlwe.Ciphertext, err error) {
+
+	source := sampling.NewSource(sampling.NewSeed())
+
+	masked := make([]rlwe.Ciphertext, len(cts))
+	masks := make([]ring.RNSPoly, len(cts))
+
+	values := make([]big.Int, btp.params.N())
+
+	for i := range cts {
+
+		// Scale of the input not known in advance
+		var minLevel int
+		if minLevel, err = btp.MinimumLevel(btp.params, cts[i].Scale); err != nil {
+			return nil, fmt.Errorf("[refresh.Parameters][MinimumLevel]: %w", err)
+		}
+
+		if cts[i].Level() < minLevel {
+			return nil, fmt.Errorf("[refresh.Bootstrapper][BootstrapMany]: ciphertext %d at level %d < MinimumInputLevel=%d", i, cts[i].Level(), minLevel)
+		}
+
+		// Drops the ciphertext to the minimum level, which reduces the communication.
+		ct := cts[i].Clone()
+		ct.ResizeQ(minLevel)
+
+		// Mask uniform in [-2^(LogMask-1), 2^(LogMask-1))
+		bound := new(big.Int).Lsh(big.NewInt(1), uint(btp.LogMask(ct.Scale)))
+		boundHalf := new(big.Int).Rsh(bound, 1)
+		for j := range values {
+			values[j] = *bignum.RandInt(source, bound)
+			values[j].Sub(&values[j], boundHalf)
+		}
+
+		rQ := btp.params.RingQ().AtLevel(minLevel)
+		mask := rQ.NewRNSPoly()
+		rQ.SetCoefficientsBigint(values, mask)
+		if ct.IsNTT {
+			rQ.NTT(mask, mask)
+		}
+		rQ.Add(ct.Q[0], mask, ct.Q[0])
+
+		// Mask at the output level and default scale, as the re-encrypted message
+		Rescale(values, ratio(btp.params.DefaultScale(), ct.Scale))
+
+		rQ = btp.params.RingQ().AtLevel(btp.OutputLevel())
+		masks[i] = rQ.NewRNSPoly()
+		rQ.SetCoefficientsBigint(values, masks[i])
+		if ct.IsNTT {
+			rQ.NTT(masks[i], masks[i])
+		}
+
+		masked[i] = *ct
+	}
+
+	if out, err = btp.Refresh(masked, btp.OutputLevel()); err != nil {
+		return nil, fmt.Errorf("[refresh.Transport][Refresh]: %w", err)
+	}
+
+	if len(out) != len(cts) {
+		return nil, fmt.Errorf("[refresh.Transport][Refresh]: invalid response: %d ciphertexts, expected %d", len(out), len(cts))
+	}
+
+	for i := range out {
+
+		if out[i].Level() != btp.OutputLevel() {
+			return nil, fmt.Errorf("[refresh.Transport][Refresh]: invalid response: ciphertext %d at level %d, expected %d", i, out[i].Level(), btp.OutputLevel())
+		}
+
+		rQ := btp.params.RingQ().AtLevel(btp.OutputLevel())
+		rQ.Sub(out[i].Q[0], masks[i], out[i].Q[0])
+	}
+
+	btp.Count += len(cts)
+
+	return
+}
+
+func (btp *Bootstrapper) Depth() int {
+	return 0
+}
+
+func (btp *Bootstrapper) MinimumInputLevel() int {
+	return btp.MinLevel
+}
+
+func (btp *Bootstrapper) OutputLevel() int {
+	return btp.params.MaxLevel()
+}
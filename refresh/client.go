@@ -0,0 +1,99 @@
+package refresh
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// Client is the client side of the refresh: it decrypts the masked
+// ciphertexts, brings them to the default scale and re-encrypts them.
+// It implements Transport for an in-process client and is not safe
+// for concurrent use.
+type Client struct {
+	hefloat.Parameters
+	dec    *rlwe.Decryptor
+	enc    *rlwe.Encryptor
+	values []big.Int
+}
+
+func NewClient(params hefloat.Parameters, sk *rlwe.SecretKey) *Client {
+	return &Client{
+		Parameters: params,
+		dec:        rlwe.NewDecryptor(params, sk),
+		enc:        rlwe.NewEncryptor(params, sk),
+		values:     make([]big.Int, params.N()),
+	}
+}
+
+func (c *Client) Refresh(cts []rlwe.Ciphertext, levelOut int) (out []rlwe.Ciphertext, err error) {
+
+	if levelOut > c.MaxLevel() {
+		return nil, fmt.Errorf("[refresh.Client][Refresh]: levelOut=%d > MaxLevel=%d", levelOut, c.MaxLevel())
+	}
+
+	out = make([]rlwe.Ciphertext, len(cts))
+
+	for i := range cts {
+
+		pt := c.dec.DecryptNew(&cts[i])
+
+		rQ := c.RingQ().AtLevel(pt.Level())
+		if pt.IsNTT {
+			rQ.INTT(pt.Q, pt.Q)
+		}
+
+		rQ.PolyToBigintCentered(pt.Q, 1, c.values)
+
+		// Masked message from its scale to the default scale
+		ScaleOut := c.DefaultScale()
+		Rescale(c.values, ratio(ScaleOut, cts[i].Scale))
+
+		ptOut := hefloat.NewPlaintext(c.Parameters, levelOut)
+		*ptOut.MetaData = *cts[i].MetaData
+		ptOut.Scale = ScaleOut
+
+		rQ = c.RingQ().AtLevel(levelOut)
+		rQ.SetCoefficientsBigint(c.values, ptOut.Q)
+		if ptOut.IsNTT {
+			rQ.NTT(ptOut.Q, ptOut.Q)
+		}
+
+		ct := hefloat.NewCiphertext(c.Parameters, 1, levelOut)
+		if err = c.enc.Encrypt(ptOut, ct); err != nil {
+			return nil, fmt.Errorf("[rlwe.Encryptor][Encrypt]: %w", err)
+		}
+
+		out[i] = *ct
+	}
+
+	return
+}
+
+// ratio returns a/b.
+func ratio(a, b rlwe.Scale) *big.Float {
+	return new(big.Float).Quo(&a.Value, &b.Value)
+}
+
+// Rescale sets values[i] to round(values[i] * r).
+func Rescale(values []big.Int, r *big.Float) {
+
+	if r.Cmp(big.NewFloat(1)) == 0 {
+		return
+	}
+
+	half := big.NewFloat(0.5)
+	tmp := new(big.Float).SetPrec(r.Prec())
+	for i := range values {
+		tmp.SetInt(&values[i])
+		tmp.Mul(tmp, r)
+		if tmp.Sign() < 0 {
+			tmp.Sub(tmp, half)
+		} else {
+			tmp.Add(tmp, half)
+		}
+		tmp.Int(&values[i])
+	}
+}
@@ -0,0 +1,65 @@
+package refresh
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// Loopback is a local Transport which serializes the ciphertexts
+// exchanged with an in-process client, as they would be on the wire.
+// It is safe for concurrent use.
+type Loopback struct {
+	sync.Mutex
+	Client        Transport
+	BytesSent     int
+	BytesReceived int
+}
+
+func NewLoopback(client Transport) *Loopback {
+	return &Loopback{Client: client}
+}
+
+func (l *Loopback) Refresh(cts []rlwe.Ciphertext, levelOut int) (out []rlwe.Ciphertext, err error) {
+
+	l.Lock()
+	defer l.Unlock()
+
+	// Server -> Client
+	var sent []rlwe.Ciphertext
+	if sent, err = l.transfer(cts); err != nil {
+		return nil, fmt.Errorf("[send]: %w", err)
+	}
+	for i := range sent {
+		l.BytesSent += sent[i].BinarySize()
+	}
+
+	if out, err = l.Client.Refresh(sent, levelOut); err != nil {
+		return nil, fmt.Errorf("[refresh.Transport][Refresh]: %w", err)
+	}
+
+	// Client -> Server
+	if out, err = l.transfer(out); err != nil {
+		return nil, fmt.Errorf("[receive]: %w", err)
+	}
+	for i := range out {
+		l.BytesReceived += out[i].BinarySize()
+	}
+
+	return
+}
+
+func (l *Loopback) transfer(cts []rlwe.Ciphertext) (out []rlwe.Ciphertext, err error) {
+	out = make([]rlwe.Ciphertext, len(cts))
+	for i := range cts {
+		var data []byte
+		if data, err = cts[i].MarshalBinary(); err != nil {
+			return nil, fmt.Errorf("[rlwe.Ciphertext][MarshalBinary][%d]: %w", i, err)
+		}
+		if err = out[i].UnmarshalBinary(data); err != nil {
+			return nil, fmt.Errorf("[rlwe.Ciphertext][UnmarshalBinary][%d]: %w", i, err)
+		}
+	}
+	return
+}
@@ -0,0 +1,48 @@
+// Package refresh implements a client-aided refresh of the ciphertexts, as an
+// alternative to the bootstrapping for deployments where the client is online:
+// the server masks the ciphertexts with a random polynomial, the client (owner
+// of the secret key) decrypts and re-encrypts them at the output level, and the
+// server removes the mask.
+//
+// The mask is statistically hiding (2^Lambda times larger than the message)
+// and must not wrap around the modulus, which requires the ciphertexts to be
+// at a level of at least Parameters.MinimumLevel.
+package refresh
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+type Parameters struct {
+	Lambda     int // Statistical security parameter
+	LogMessage int // Log2 of an upper bound on the absolute value of the messages
+}
+
+// LogMask returns the bit-size of the masks for ciphertexts of the given scale.
+func (p Parameters) LogMask(scale rlwe.Scale) int {
+	return p.Lambda + p.LogMessage + int(math.Ceil(scale.Log2()))
+}
+
+// MinimumLevel returns the minimum level at which ciphertexts of the given
+// scale can be refreshed, i.e. at which the masked message does not wrap
+// around the modulus.
+func (p Parameters) MinimumLevel(params hefloat.Parameters, scale rlwe.Scale) (level int, err error) {
+	LogMask := float64(p.LogMask(scale))
+	var LogQ float64
+	for level, qi := range params.Q() {
+		if LogQ += math.Log2(float64(qi)); LogQ > LogMask+1 {
+			return level, nil
+		}
+	}
+	return 0, fmt.Errorf("log2(Q)=%f is too small for masks of %d bits", LogQ, int(LogMask))
+}
+
+// Transport sends the masked ciphertexts to the client and returns
+// their re-encryption at level levelOut with the default scale.
+type Transport interface {
+	Refresh(cts []rlwe.Ciphertext, levelOut int) ([]rlwe.Ciphertext, error)
+}
@@ -0,0 +1,109 @@
+package refresh
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/stretchr/testify/require"
+)
+
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60, 60, 60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
+
+// spy records the ciphertexts received by the client.
+type spy struct {
+	*Client
+	received []rlwe.Ciphertext
+}
+
+func (s *spy) Refresh(cts []rlwe.Ciphertext, levelOut int) ([]rlwe.Ciphertext, error) {
+	for i := range cts {
+		s.received = append(s.received, *cts[i].Clone())
+	}
+	return s.Client.Refresh(cts, levelOut)
+}
+
+func TestRefresh(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+	ecd := hefloat.NewEncoder(params)
+	enc := rlwe.NewEncryptor(params, sk)
+	dec := rlwe.NewDecryptor(params, sk)
+
+	p := Parameters{Lambda: 40, LogMessage: 4}
+
+	client := &spy{Client: NewClient(params, sk)}
+	transport := NewLoopback(client)
+
+	btp, err := NewBootstrapper(params, p, transport)
+	require.NoError(t, err)
+	require.Equal(t, 1, btp.MinimumInputLevel())
+
+	r := rand.New(rand.NewPCG(0, 0))
+
+	slots := params.MaxSlots()
+	values := make([]float64, slots)
+	for i := range values {
+		values[i] = 16*r.Float64() - 8
+	}
+
+	cts := make([]rlwe.Ciphertext, 2)
+	for i, LogScale := range []float64{45, 40} {
+		// The second ciphertext has a scale different from the default scale
+		pt := hefloat.NewPlaintext(params, 3)
+		pt.Scale = rlwe.NewScale(math.Exp2(LogScale))
+		require.NoError(t, ecd.Encode(values, pt))
+		cts[i] = *hefloat.NewCiphertext(params, 1, pt.Level())
+		require.NoError(t, enc.Encrypt(pt, &cts[i]))
+	}
+
+	out, err := btp.BootstrapMany(cts)
+	require.NoError(t, err)
+	require.Equal(t, 2, btp.Count)
+	require.Greater(t, transport.BytesSent, 0)
+	require.Greater(t, transport.BytesReceived, 0)
+
+	for i := range out {
+
+		require.Equal(t, params.MaxLevel(), out[i].Level())
+		require.Equal(t, params.DefaultScale().Float64(), out[i].Scale.Float64())
+
+		have := make([]float64, slots)
+		require.NoError(t, ecd.Decode(dec.DecryptNew(&out[i]), have))
+		for j := range have {
+			require.InDelta(t, values[j], have[j], math.Exp2(-20))
+		}
+
+		// The client only sees the masked message
+		seen := make([]float64, slots)
+		require.NoError(t, ecd.Decode(dec.DecryptNew(&client.received[i]), seen))
+		require.Equal(t, btp.MinimumInputLevel(), client.received[i].Level())
+		var far int
+		for j := range seen {
+			if math.Abs(seen[j]-values[j]) > math.Exp2(float64(p.LogMessage)) {
+				far++
+			}
+		}
+		require.Greater(t, far, slots*9/10)
+	}
+
+	t.Run("MinimumInputLevel", func(t *testing.T) {
+		ct := hefloat.NewCiphertext(params, 1, 0)
+		require.NoError(t, enc.Encrypt(hefloat.NewPlaintext(params, 0), ct))
+		_, err := btp.Bootstrap(ct)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,219 @@
+// Package sanitize implements the circuit-privacy sanitization of the
+// ciphertexts returned to the client: the noise of the ciphertexts,
+// which depends on the model weights, is flooded with a fresh Gaussian
+// noise 2^Lambda times larger. The statistical distance between the
+// sanitized ciphertexts of two circuits is then at most ~2^-Lambda, so
+// that the sanitization provides circuit privacy only for Lambda of at
+// least MinimumLambda. Each bit of Lambda costs a bit of precision, which
+// is affordable after a final bootstrap for binary values (see Binarize).
+package sanitize
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+)
+
+// MinimumLambda is the smallest statistical security parameter for which
+// the sanitization is considered to provide circuit privacy.
+const MinimumLambda = 40
+
+type Parameters struct {
+	Lambda        int     // Statistical security parameter
+	LogNoiseBound float64 // Log2 of an upper bound on the standard deviation of the noise of the ciphertexts relative to their scale (coefficient domain)
+	Level         int     // Level of the sanitized ciphertexts, whose modulus must hold the flooding noise
+	Rounds        int     // Rounds of 3x^2 - 2x^3 of Binarize before the last one, which is not rescaled
+}
+
+// CheckLambda returns an error if Lambda is smaller than MinimumLambda,
+// in which case the flooding only partially hides the noise of the circuit.
+func (p Parameters) CheckLambda() (err error) {
+	if p.Lambda < MinimumLambda {
+		return fmt.Errorf("Lambda=%d < %d: the sanitized ciphertexts are within a statistical distance of 2^-%d, which does not provide circuit privacy", p.Lambda, MinimumLambda, p.Lambda)
+	}
+	return
+}
+
+// LogFloodingStd returns the log2 of the standard deviation
+// of the flooding noise relative to the scale of the ciphertexts.
+func (p Parameters) LogFloodingStd() float64 {
+	return float64(p.Lambda) + p.LogNoiseBound
+}
+
+// LogPrecisionLoss returns an estimate of the log2 of the standard deviation
+// of the error added to each slot by the flooding, for the given parameters.
+func (p Parameters) LogPrecisionLoss(params hefloat.Parameters) float64 {
+	return p.LogFloodingStd() + 0.5*float64(params.LogN())
+}
+
+// Sanitizer sanitizes ciphertexts by:
+//   - dropping them to the level Level, so that the level does not reveal the depth of the circuit
+//   - adding an encryption of zero, if a public key is available, so that the ciphertexts are re-randomized
+//   - adding a Gaussian noise of standard deviation 2^(Lambda + LogNoiseBound) times their scale
+//
+// The noise of the circuit is ~2^-20 times the scale, so that a flooding
+// with Lambda >= MinimumLambda leaves no precision, unless the values are
+// binary: Binarize then reduces their noise below 2^LogNoiseBound.
+type Sanitizer struct {
+	Parameters
+	params hefloat.Parameters
+	enc    *rlwe.Encryptor
+}
+
+// NewSanitizer returns a new Sanitizer. The public key is optional.
+func NewSanitizer(params hefloat.Parameters, p Parameters, pk *rlwe.PublicKey) *Sanitizer {
+
+	var enc *rlwe.Encryptor
+	if pk != nil {
+		enc = rlwe.NewEncryptor(params, pk)
+	}
+
+	return &Sanitizer{
+		Parameters: p,
+		params:     params,
+		enc:        enc,
+	}
+}
+
+// Binarize bootstraps ciphertexts of values close to 0 or 1, e.g. a one-hot
+// argmax, and evaluates Rounds+1 times h(x) = 3x^2 - 2x^3 = x^2(3 - 2x), which
+// maps b + e to b + O(e^2) for b in {0, 1}, so that the error of the bootstrapping
+// and of the circuit is squared at each round. The rounds are rescaled, which
+// bounds the error by the rounding noise of the rescaling, ~2^(LogN/2 + 4) / scale,
+// except the last one, which is evaluated on the scale^3 and rescaled to the scale^2:
+// its error is the square of the error of the previous rounds, e.g. ~2^-53 for a
+// scale of 2^45 and LogN=15, and the ciphertexts are returned at the scale^2.
+func (s *Sanitizer) Binarize(in []rlwe.Ciphertext, eval *hefloat.Evaluator, btp he.Bootstrapper[rlwe.Ciphertext]) (out []rlwe.Ciphertext, err error) {
+
+	for i := range in {
+		if in[i].Level() < btp.MinimumInputLevel() {
+			return nil, fmt.Errorf("[Binarize]: ciphertext %d at level %d < MinimumInputLevel=%d", i, in[i].Level(), btp.MinimumInputLevel())
+		}
+	}
+
+	if out, err = btp.BootstrapMany(in); err != nil {
+		return nil, fmt.Errorf("[he.Bootstrapper][BootstrapMany]: %w", err)
+	}
+
+	// 2 levels per round, 1 for the last one and Level for Sanitize
+	if out[0].Level() < 2*s.Rounds+1+s.Level {
+		return nil, fmt.Errorf("[Binarize]: bootstrapped ciphertexts at level %d < 2*Rounds+1+Level=%d", out[0].Level(), 2*s.Rounds+1+s.Level)
+	}
+
+	scale := out[0].Scale
+
+	for i := range out {
+
+		ct := &out[i]
+
+		for j := range s.Rounds + 1 {
+
+			// The last round is evaluated on the scale^3 and rescaled to the scale^2
+			last := j == s.Rounds
+
+			if last {
+				// Rounds the scale to an integer, so that the constant 3 of the last
+				// round is exact: the relative error on x, below 2^-46, is squared as
+				// the one of the previous rounds since h'(0) = h'(1) = 0.
+				ct.Scale = rlwe.NewScale(math.Round(ct.Scale.Float64()))
+			}
+
+			// 3 - 2x, exact for an integer scale
+			var t *rlwe.Ciphertext
+			if t, err = eval.MulNew(ct, -2); err != nil {
+				return nil, fmt.Errorf("[hefloat.Evaluator][MulNew]: %w", err)
+			}
+
+			if err = eval.Add(t, 3, t); err != nil {
+				return nil, fmt.Errorf("[hefloat.Evaluator][Add]: %w", err)
+			}
+
+			var x2 *rlwe.Ciphertext
+			if x2, err = eval.MulRelinNew(ct, ct); err != nil {
+				return nil, fmt.Errorf("[hefloat.Evaluator][MulRelinNew]: %w", err)
+			}
+
+			if !last {
+				if err = eval.RescaleTo(x2, scale, x2); err != nil {
+					return nil, fmt.Errorf("[hefloat.Evaluator][RescaleTo]: %w", err)
+				}
+			}
+
+			if err = eval.MulRelin(x2, t, ct); err != nil {
+				return nil, fmt.Errorf("[hefloat.Evaluator][MulRelin]: %w", err)
+			}
+
+			target := scale
+			if last {
+				target = scale.Mul(scale)
+			}
+
+			if err = eval.RescaleTo(ct, target, ct); err != nil {
+				return nil, fmt.Errorf("[hefloat.Evaluator][RescaleTo]: %w", err)
+			}
+		}
+	}
+
+	return
+}
+
+// Sanitize sanitizes the ciphertexts in place.
+func (s *Sanitizer) Sanitize(cts []rlwe.Ciphertext) (err error) {
+
+	ringQ := s.params.RingQ().AtLevel(s.Level)
+
+	source := sampling.NewSource(sampling.NewSeed())
+
+	e := ringQ.NewRNSPoly()
+
+	for i := range cts {
+
+		ct := &cts[i]
+
+		if ct.Degree() != 1 {
+			return fmt.Errorf("[Sanitize]: ciphertext %d has degree %d but should have degree 1", i, ct.Degree())
+		}
+
+		if ct.Level() < s.Level {
+			return fmt.Errorf("[Sanitize]: ciphertext %d at level %d < Level=%d", i, ct.Level(), s.Level)
+		}
+
+		// The values, bounded by 2^3, and 6 standard deviations of the
+		// flooding noise must fit in the modulus of the level Level.
+		logScale := ct.LogScale()
+		if logQ := float64(s.params.LogQLvl(s.Level)); max(logScale+3, s.LogFloodingStd()+logScale+math.Log2(6)) >= logQ-1 {
+			return fmt.Errorf("[Sanitize]: ciphertext %d at scale 2^%.2f: the flooding noise of 2^%.2f exceeds the modulus 2^%.2f of the level %d", i, logScale, s.LogFloodingStd()+logScale, logQ, s.Level)
+		}
+
+		ct.ResizeQ(s.Level)
+
+		if s.enc != nil {
+
+			zero := rlwe.NewCiphertext(s.params, 1, s.Level, -1)
+
+			if err = s.enc.EncryptZero(zero); err != nil {
+				return fmt.Errorf("[rlwe.Encryptor][EncryptZero]: %w", err)
+			}
+
+			ringQ.Add(ct.Q[0], zero.Q[0], ct.Q[0])
+			ringQ.Add(ct.Q[1], zero.Q[1], ct.Q[1])
+		}
+
+		sigma := math.Exp2(s.LogFloodingStd() + logScale)
+
+		ring.NewGaussianSampler(source, ringQ.ModuliChain(), ring.DiscreteGaussian{Sigma: sigma, Bound: 6 * sigma}).Read(e)
+
+		if ct.IsNTT {
+			ringQ.NTT(e, e)
+		}
+
+		ringQ.Add(ct.Q[0], e, ct.Q[0])
+	}
+
+	return
+}
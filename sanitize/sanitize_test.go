@@ -0,0 +1,176 @@
+package sanitize
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"app/bootstrapping"
+	"app/utils"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+var paramsInsecure = hefloat.ParametersLiteral{
+	LogN:            10,
+	LogQ:            []int{60, 45, 45, 45, 45, 45, 45, 45, 45},
+	LogP:            []int{60},
+	LogDefaultScale: 45,
+	RingType:        ring.ConjugateInvariant,
+}
+
+func TestSanitize(t *testing.T) {
+
+	params, err := hefloat.NewParametersFromLiteral(paramsInsecure)
+	require.NoError(t, err)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk, pk := kgen.GenKeyPairNew()
+	ecd := hefloat.NewEncoder(params)
+	enc := rlwe.NewEncryptor(params, sk)
+	dec := rlwe.NewDecryptor(params, sk)
+
+	r := rand.New(rand.NewPCG(0, 0))
+
+	want := make([]float64, params.MaxSlots())
+	for i := range want {
+		want[i] = 2*r.Float64() - 1
+	}
+
+	encrypt := func() []rlwe.Ciphertext {
+		pt := hefloat.NewPlaintext(params, params.MaxLevel())
+		require.NoError(t, ecd.Encode(want, pt))
+		ct := hefloat.NewCiphertext(params, 1, params.MaxLevel())
+		require.NoError(t, enc.Encrypt(pt, ct))
+		return []rlwe.Ciphertext{*ct}
+	}
+
+	decrypt := func(ct *rlwe.Ciphertext) []float64 {
+		have := make([]float64, params.MaxSlots())
+		require.NoError(t, ecd.Decode(dec.DecryptNew(ct), have))
+		return have
+	}
+
+	for _, lambda := range []int{10, 20} {
+
+		p := Parameters{Lambda: lambda, LogNoiseBound: math.Log2(params.NoiseFreshSK()) - float64(params.LogDefaultScale())}
+
+		t.Run(fmt.Sprintf("Lambda=%d", lambda), func(t *testing.T) {
+
+			cts := encrypt()
+
+			require.NoError(t, NewSanitizer(params, p, pk).Sanitize(cts))
+
+			require.Equal(t, 0, cts[0].Level())
+
+			have := decrypt(&cts[0])
+
+			_, noise := utils.Precision([]*mat.Dense{mat.NewDense(1, len(have), have)}, []*mat.Dense{mat.NewDense(1, len(want), want)})
+
+			LogPrecisionLoss := p.LogPrecisionLoss(params)
+
+			t.Logf("Lambda=%d: avg |err| = 2^%.2f, estimate = 2^%.2f", lambda, math.Log2(noise), LogPrecisionLoss)
+
+			require.Less(t, math.Log2(noise), LogPrecisionLoss+1)
+
+			if lambda == 20 {
+				require.Greater(t, math.Log2(noise), LogPrecisionLoss-2)
+			}
+		})
+	}
+
+	t.Run("Binarize", func(t *testing.T) {
+
+		// Default of lib.SanitizeParameters
+		p := Parameters{Lambda: MinimumLambda, LogNoiseBound: -56, Level: 2, Rounds: 2}
+
+		// Noise of the worst bootstrapping profile
+		btp := bootstrapping.NewSimulatedBootstrapper(1, params, sk, bootstrapping.NoiseModel{LogN: params.LogN(), LogStd: -23, LogLinear: -25, LogCubic: -31}, [32]byte{})
+
+		rlk := kgen.GenRelinearizationKeyNew(sk)
+		eval := hefloat.NewEvaluator(params, rlwe.NewMemEvaluationKeySet(rlk))
+
+		// One-hot vectors
+		bits := make([]float64, params.MaxSlots())
+		for i := range bits {
+			bits[i] = float64(r.IntN(2))
+		}
+
+		pt := hefloat.NewPlaintext(params, 1)
+		require.NoError(t, ecd.Encode(bits, pt))
+		ct := hefloat.NewCiphertext(params, 1, 1)
+		require.NoError(t, enc.Encrypt(pt, ct))
+
+		sanitizer := NewSanitizer(params, p, pk)
+
+		cts, err := sanitizer.Binarize([]rlwe.Ciphertext{*ct}, eval, btp)
+		require.NoError(t, err)
+
+		wantM := []*mat.Dense{mat.NewDense(1, len(bits), bits)}
+
+		// The noise of the binarized ciphertexts is below the float64 precision
+		// of the slots: it is measured on the coefficients, with an encoder in
+		// 128-bit precision.
+		ptWant := hefloat.NewPlaintext(params, cts[0].Level())
+		ptWant.Scale = cts[0].Scale
+		require.NoError(t, hefloat.NewEncoder(params, 128).Encode(bits, ptWant))
+
+		diff := cts[0].Clone()
+		require.NoError(t, eval.Sub(diff, ptWant, diff))
+
+		logStd, _, _ := rlwe.Norm(diff, dec)
+
+		t.Logf("Binarize: std = 2^%.2f, LogNoiseBound = 2^%.2f", logStd-cts[0].LogScale(), p.LogNoiseBound)
+
+		require.Less(t, logStd-cts[0].LogScale(), p.LogNoiseBound)
+
+		require.NoError(t, sanitizer.Sanitize(cts))
+		require.Equal(t, p.Level, cts[0].Level())
+
+		have := decrypt(&cts[0])
+		_, noise := utils.Precision([]*mat.Dense{mat.NewDense(1, len(have), have)}, wantM)
+
+		LogPrecisionLoss := p.LogPrecisionLoss(params)
+
+		t.Logf("Lambda=%d: avg |err| = 2^%.2f, estimate = 2^%.2f", p.Lambda, math.Log2(noise), LogPrecisionLoss)
+
+		require.Less(t, math.Log2(noise), LogPrecisionLoss+1)
+		require.Greater(t, math.Log2(noise), LogPrecisionLoss-2)
+
+		for i := range have {
+			require.InDelta(t, bits[i], have[i], 0.25)
+		}
+	})
+
+	t.Run("Modulus", func(t *testing.T) {
+		cts := encrypt()
+		// 2^(40 + 0 + 45) does not fit in q0 = 2^60
+		require.Error(t, NewSanitizer(params, Parameters{Lambda: MinimumLambda}, pk).Sanitize(cts))
+	})
+
+	t.Run("ReRandomization", func(t *testing.T) {
+
+		p := Parameters{Lambda: 0, LogNoiseBound: 0}
+
+		cts0 := encrypt()
+		cts1 := []rlwe.Ciphertext{*cts0[0].Clone()}
+
+		sanitizer := NewSanitizer(params, p, pk)
+		require.NoError(t, sanitizer.Sanitize(cts0))
+		require.NoError(t, sanitizer.Sanitize(cts1))
+
+		require.False(t, cts0[0].Q[1].Equal(&cts1[0].Q[1]))
+	})
+
+	t.Run("CheckLambda", func(t *testing.T) {
+		require.Error(t, Parameters{Lambda: MinimumLambda - 1}.CheckLambda())
+		require.NoError(t, Parameters{Lambda: MinimumLambda}.CheckLambda())
+	})
+}
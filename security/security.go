@@ -0,0 +1,95 @@
+// Package security checks parameter sets against a table of known-secure
+// bounds on log2(QP), for a given ring degree, secret Hamming weight and
+// error standard deviation, so that a change of configuration that drops
+// below a target security level is detected at startup instead of
+// requiring a new offline run of the lattice estimator.
+package security
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// Tolerance is the slack, in bits, allowed on log2(QP) to account for the
+// primes not being exact powers of two.
+const Tolerance = 0.01
+
+// Bound is a known-secure bound: any parameter set with a ring degree of
+// at least 2^LogN, a secret of Hamming weight at least H, an error of
+// standard deviation at least Sigma and log2(QP) at most LogQP has an
+// estimated security of at least Lambda bits.
+type Bound struct {
+	LogN   int
+	H      int
+	Sigma  float64
+	LogQP  float64
+	Lambda float64
+	Source string
+}
+
+// Bounds is the table of known-secure bounds.
+var Bounds = []Bound{
+	// Homomorphic Encryption Standard (2018), uniform ternary secret, classical security
+	{LogN: 10, H: 683, Sigma: 3.2, LogQP: 27, Lambda: 128, Source: "HE Standard"},
+	{LogN: 11, H: 1366, Sigma: 3.2, LogQP: 54, Lambda: 128, Source: "HE Standard"},
+	{LogN: 12, H: 2731, Sigma: 3.2, LogQP: 109, Lambda: 128, Source: "HE Standard"},
+	{LogN: 13, H: 5462, Sigma: 3.2, LogQP: 218, Lambda: 128, Source: "HE Standard"},
+	{LogN: 14, H: 10923, Sigma: 3.2, LogQP: 438, Lambda: 128, Source: "HE Standard"},
+	{LogN: 15, H: 21846, Sigma: 3.2, LogQP: 881, Lambda: 128, Source: "HE Standard"},
+
+	// Lattice estimator, sparse ternary secret (see SECURITY.md)
+	{LogN: 15, H: 192, Sigma: 3.2, LogQP: 819, Lambda: 128, Source: "SECURITY.md (residual parameters)"},
+	{LogN: 16, H: 320, Sigma: 3.2, LogQP: 1726, Lambda: 128, Source: "SECURITY.md (bootstrapping parameters)"},
+}
+
+// Estimate returns the highest security level guaranteed by a bound of the
+// table for the given parameters, and the corresponding bound.
+// It returns an error if no bound applies.
+func Estimate(params rlwe.ParameterProvider) (lambda float64, bound Bound, err error) {
+
+	p := params.GetRLWEParameters()
+
+	var sigma float64
+	switch xe := p.Xe().(type) {
+	case *ring.DiscreteGaussian:
+		sigma = xe.Sigma
+	default:
+		return 0, bound, fmt.Errorf("unsupported error distribution %T", xe)
+	}
+
+	LogN := p.LogN()
+	H := p.XsHammingWeight()
+	LogQP := p.LogQP()
+
+	lambda = math.Inf(-1)
+	for _, b := range Bounds {
+		if LogN >= b.LogN && H >= b.H && sigma >= b.Sigma && LogQP <= b.LogQP+Tolerance && b.Lambda > lambda {
+			lambda, bound = b.Lambda, b
+		}
+	}
+
+	if math.IsInf(lambda, -1) {
+		return 0, bound, fmt.Errorf("no known-secure bound for LogN=%d, H=%d, sigma=%f, LogQP=%f", LogN, H, sigma, LogQP)
+	}
+
+	return
+}
+
+// Check returns an error if the security of the given parameters
+// cannot be guaranteed to be at least lambda bits.
+func Check(params rlwe.ParameterProvider, lambda float64) (err error) {
+
+	have, _, err := Estimate(params)
+	if err != nil {
+		return fmt.Errorf("[security.Estimate]: %w", err)
+	}
+
+	if have < lambda {
+		return fmt.Errorf("[security.Check]: %f < %f bits of security", have, lambda)
+	}
+
+	return
+}
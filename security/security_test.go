@@ -0,0 +1,51 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+
+	// Residual parameters of SECURITY.md
+	newParameters := func(levels, H int) hefloat.Parameters {
+		LogQ := []int{60}
+		for range levels {
+			LogQ = append(LogQ, 45)
+		}
+		params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+			LogN:            15,
+			LogQ:            LogQ,
+			LogP:            []int{58, 58, 58},
+			LogDefaultScale: 45,
+			RingType:        ring.ConjugateInvariant,
+			Xs:              &ring.Ternary{H: H},
+		})
+		require.NoError(t, err)
+		return params
+	}
+
+	t.Run("Secure", func(t *testing.T) {
+		lambda, bound, err := Estimate(newParameters(13, 192))
+		require.NoError(t, err)
+		require.Equal(t, 128.0, lambda)
+		require.Equal(t, 819.0, bound.LogQP)
+		require.NoError(t, Check(newParameters(13, 192), 128))
+		require.NoError(t, Check(newParameters(12, 256), 128))
+	})
+
+	t.Run("LogQP", func(t *testing.T) {
+		require.Error(t, Check(newParameters(14, 192), 128))
+	})
+
+	t.Run("HammingWeight", func(t *testing.T) {
+		require.Error(t, Check(newParameters(13, 128), 128))
+	})
+
+	t.Run("Target", func(t *testing.T) {
+		require.Error(t, Check(newParameters(13, 192), 192))
+	})
+}
@@ -3,10 +3,13 @@ package server
 import (
 	"fmt"
 	"math"
+	"os"
+	"strings"
 
 	"gonum.org/v1/gonum/mat"
 
 	"github.com/Pro7ech/lattigo/utils/concurrency"
+	"app/layers/embedding"
 	"app/lib"
 	"app/utils"
 	"app/weights"
@@ -41,9 +44,9 @@ func (s *Server) EmbedExact(in []*mat.Dense) (out []*mat.Dense) {
 
 func (s *Server) EmbedApproximate(in []*mat.Dense) (out []*mat.Dense) {
 
-	coeffs, err := utils.ReadFile(s.path+"/embedding_coefficients.csv", ',', 0, false, lib.NumCPU)
+	coeffs, err := GetEmbeddingCoefficients(s.path)
 	if err != nil {
-		panic(fmt.Errorf("utils.ReadFile: %w", err))
+		panic(fmt.Errorf("[GetEmbeddingCoefficients]: %w", err))
 	}
 
 	out = make([]*mat.Dense, len(in))
@@ -127,9 +130,9 @@ func GetEmbeddingPolynmials(path string, rows, slots int) (polyVec *he.Polynomia
 
 	cols := lib.Cols
 
-	coeffs, err := utils.ReadFile(path+"/embedding_coefficients.csv", ',', 0, false, lib.NumCPU)
+	coeffs, err := GetEmbeddingCoefficients(path)
 	if err != nil {
-		panic(fmt.Errorf("utils.ReadFile: %w", err))
+		return nil, fmt.Errorf("[GetEmbeddingCoefficients]: %w", err)
 	}
 
 	polys := map[int]*he.Polynomial{}
@@ -153,3 +156,80 @@ func GetEmbeddingPolynmials(path string, rows, slots int) (polyVec *he.Polynomia
 
 	return he.NewPolynomialVector(polys, mapping)
 }
+
+// GetEmbeddingCoefficients returns the Chebyshev coefficients of the embedding
+// polynomials, one row per column of the embedding table, read from
+// path/embedding_coefficients.csv. It returns an error if either file is
+// missing or if path/embedding_coefficients.sha256 does not hold the
+// embedding.Key of path/embedding.csv, of (lib.A, lib.B, lib.K) and of the
+// coefficients file, e.g. for a retrained model, whose coefficients must
+// first be fitted with WriteEmbeddingCoefficients (see server/embedding).
+func GetEmbeddingCoefficients(path string) (coeffs [][]float64, err error) {
+
+	if err = checkEmbeddingCoefficients(path, weights.LoadEmbeddingLUT(path)); err != nil {
+		return nil, fmt.Errorf("%w: run go run ./server/embedding -weights=%s", err, path)
+	}
+
+	if coeffs, err = utils.ReadFile(path+"/embedding_coefficients.csv", ',', 0, false, lib.NumCPU); err != nil {
+		return nil, fmt.Errorf("utils.ReadFile: %w", err)
+	}
+
+	if len(coeffs) != lib.Cols {
+		return nil, fmt.Errorf("invalid %s/embedding_coefficients.csv: #rows=%d != %d", path, len(coeffs), lib.Cols)
+	}
+
+	return
+}
+
+// checkEmbeddingCoefficients returns an error if path/embedding_coefficients.sha256
+// does not hold the key of lut and of path/embedding_coefficients.csv, or if
+// either file is missing.
+func checkEmbeddingCoefficients(path string, lut *mat.Dense) (err error) {
+
+	var csv, key []byte
+
+	if csv, err = os.ReadFile(path + "/embedding_coefficients.csv"); err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	if key, err = os.ReadFile(path + "/embedding_coefficients.sha256"); err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	if strings.TrimSpace(string(key)) != embedding.Key(lut, lib.A, lib.B, lib.K, csv) {
+		return fmt.Errorf("invalid %s/embedding_coefficients.sha256: the key does not match the embedding table and the coefficients", path)
+	}
+
+	return
+}
+
+// WriteEmbeddingCoefficients fits the embedding polynomials on path/embedding.csv
+// and writes their coefficients in path/embedding_coefficients.csv, followed by
+// their key in path/embedding_coefficients.sha256 (see GetEmbeddingCoefficients).
+// The fit takes several minutes per column and is thus run ahead of time by
+// server/embedding rather than by the circuit.
+func WriteEmbeddingCoefficients(path string) (err error) {
+
+	lut := weights.LoadEmbeddingLUT(path)
+
+	var coeffs [][]float64
+	if coeffs, err = embedding.Fit(lut, embedding.NewParameters(lib.A, lib.B, lib.K)); err != nil {
+		return fmt.Errorf("[embedding.Fit]: %w", err)
+	}
+
+	if err = embedding.Write(path+"/embedding_coefficients.csv", coeffs); err != nil {
+		return fmt.Errorf("[embedding.Write]: %w", err)
+	}
+
+	var csv []byte
+	if csv, err = os.ReadFile(path + "/embedding_coefficients.csv"); err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	// The key is written last, so that an interrupted write does not match.
+	if err = os.WriteFile(path+"/embedding_coefficients.sha256", []byte(embedding.Key(lut, lib.A, lib.B, lib.K, csv)+"\n"), 0644); err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+
+	return
+}
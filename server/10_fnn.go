@@ -3,14 +3,14 @@ package server
 import (
 	"app/lib"
 	"app/matrix"
-	"app/matrix/relu"
+	"app/matrix/activation"
 	"app/utils"
 	"app/weights"
 	"fmt"
+	"math"
 
 	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/rlwe"
-	"github.com/Pro7ech/lattigo/utils/structs"
 
 	"gonum.org/v1/gonum/mat"
 )
@@ -18,7 +18,9 @@ import (
 func (s *Server) FNNEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.FNNGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.FNNGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
@@ -27,67 +29,67 @@ func (s *Server) FNNEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Cip
 
 	fnn1W, fnn1B, fnn2W, fnn2B := weights.LoadTransformerBlockFNNWeights(s.path)
 
-	scale := max(lib.ReLUParameters.AbsMax)
+	act := lib.FNNActivationParameters()
+
+	scale := act.AbsMax
 
 	fnn1W.Scale(1/scale, fnn1W)
 	fnn2W.Scale(scale, fnn2W)
 
-	fnn1WSplit := matrix.SplitHeads(fnn1W, 2)
+	FNN1W := matrix.NewTiledWeights(fnn1W, lib.Cols)
+	FNN2W := matrix.NewTiledWeights(fnn2W, lib.Cols)
 
-	rowsFNN2, colsFNN2 := fnn2W.Dims()
+	_, hidden := fnn1W.Dims()
 
-	fnn2WSplit := [2]*mat.Dense{
-		mat.NewDense(rowsFNN2/2, colsFNN2, fnn2W.RawMatrix().Data[:(rowsFNN2/2)*colsFNN2]),
-		mat.NewDense(rowsFNN2/2, colsFNN2, fnn2W.RawMatrix().Data[(rowsFNN2/2)*colsFNN2:]),
+	if len(fnn1B) != hidden {
+		return fmt.Errorf("invalid FNN1 bias: has %d values but the hidden dimension is %d", len(fnn1B), hidden)
 	}
 
-	FNN1Bias := [2]*mat.Dense{weights.GetBias(lib.Rows, fnn1B[:lib.Cols]), weights.GetBias(lib.Rows, fnn1B[lib.Cols:])}
-	FNN2Bias := weights.GetBias(lib.Rows, fnn2B)
+	_, blocks := FNN1W.Dims()
 
-	FNN1Bias[0].Scale(1/scale, FNN1Bias[0])
-	FNN1Bias[1].Scale(1/scale, FNN1Bias[1])
+	// The last block is zero-padded if lib.Cols does not divide the hidden dimension,
+	// the padded columns being cancelled by the zero rows of FNN2W.
+	FNN1Bias := make([]*mat.Dense, blocks)
+	for i := range FNN1Bias {
+		b := make([]float64, lib.Cols)
+		copy(b, fnn1B[i*lib.Cols:min((i+1)*lib.Cols, hidden)])
+		FNN1Bias[i] = weights.GetBias(lib.Rows, b)
+		FNN1Bias[i].Scale(1/scale, FNN1Bias[i])
+	}
 
-	eval := relu.NewEvaluator(lib.ReLUParameters, s.Evaluator, btp)
+	FNN2Bias := weights.GetBias(lib.Rows, fnn2B)
 
-	acc := structs.Vector[rlwe.Ciphertext](in).Clone()
+	params := s.Evaluator.Evaluators[0].Parameters()
 
-	for i := range 2 {
+	eval := activation.NewEvaluator(act, s.Evaluator, btp)
 
-		var FNN1W *he.LinearTransformation
-		if err = utils.LoadWithBench(fmt.Sprintf("FNN: Load FNN1[%d]", i), func() (err error) {
-			params := s.Evaluator.Evaluators[0].Parameters()
-			FNN1W, err = s.NewLinearTransformation(
-				in[0].Level(),
-				in[0].Scale,
-				params.DefaultScale(),
-				false,
-				matrix.Diagonalize(fnn1WSplit[i], params.MaxSlots()/lib.Cols, params.MaxSlots()))
-			return
-		}); err != nil {
-			return
-		}
+	// The hidden blocks are evaluated and accumulated one at a time,
+	// so that a single block of the hidden layer is stored at any time.
+	var acc []rlwe.Ciphertext
 
-		fnn := structs.Vector[rlwe.Ciphertext](in).Clone()
+	for i := range blocks {
+
+		var fnn [][]rlwe.Ciphertext
 
 		if err = utils.RunWithBench(fmt.Sprintf("FNN: In x FNN1W[%d] + FNN1B[%d] -> fnn%d", i, i, i), func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
 			LevelIn = in[0].Level()
 			LogScaleIn = in[0].LogScale()
 
-			if err = s.EvaluateLinearTransformation(in, FNN1W, fnn); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[MulPt][fnn,FNN1W[%d],fnn]: %w", i, err)
+			if fnn, err = s.MulTiledPt([][]rlwe.Ciphertext{in}, FNN1W.OutBlock(i), params.DefaultScale()); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[MulTiledPt][in,FNN1W[%d]]: %w", i, err)
 			}
 
-			if err = s.Rescale(fnn, fnn); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][fnn,fnn]: %w", err)
+			if err = s.Rescale(fnn[0], fnn[0]); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][fnn%d,fnn%d]: %w", i, i, err)
 			}
 
-			if err = s.AddPt(fnn, FNN1Bias[i], fnn); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[AddPt][fnn,s.FNN1Bias[%d],fnn]: %w", i, err)
+			if err = s.AddPt(fnn[0], FNN1Bias[i], fnn[0]); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[AddPt][fnn%d,FNN1Bias[%d],fnn%d]: %w", i, i, i, err)
 			}
 
-			LevelOut = fnn[0].Level()
-			LogScaleOut = fnn[0].LogScale()
+			LevelOut = fnn[0][0].Level()
+			LogScaleOut = fnn[0][0].LogScale()
 
 			return
 
@@ -95,17 +97,17 @@ func (s *Server) FNNEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Cip
 			return
 		}
 
-		if err = utils.RunWithBench(fmt.Sprintf("FNN: ReLU(fnn%d) -> fnn%d", i, i), func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+		if err = utils.RunWithBench(fmt.Sprintf("FNN: Activation(fnn%d) -> fnn%d", i, i), func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
-			LevelIn = fnn[0].Level()
-			LogScaleIn = fnn[0].LogScale()
+			LevelIn = fnn[0][0].Level()
+			LogScaleIn = fnn[0][0].LogScale()
 
-			if err = eval.EvaluateEncrypted(fnn); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[relu.Evaluator][EvaluateEncrypted][fnn]: %w", err)
+			if err = eval.EvaluateEncrypted(fnn[0]); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[activation.Evaluator][EvaluateEncrypted][fnn%d]: %w", i, err)
 			}
 
-			LevelOut = fnn[0].Level()
-			LogScaleOut = fnn[0].LogScale()
+			LevelOut = fnn[0][0].Level()
+			LogScaleOut = fnn[0][0].LogScale()
 
 			return
 
@@ -113,36 +115,20 @@ func (s *Server) FNNEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Cip
 			return
 		}
 
-		var FNN2W *he.LinearTransformation
-		if err = utils.LoadWithBench(fmt.Sprintf("FNN: Load FNN2[%d]", i), func() (err error) {
-			params := s.Evaluator.Evaluators[0].Parameters()
-			FNN2W, err = eval.NewLinearTransformation(
-				fnn[0].Level(),
-				fnn[0].Scale,
-				params.DefaultScale(),
-				false,
-				matrix.Diagonalize(fnn2WSplit[i], params.MaxSlots()/lib.Cols, params.MaxSlots()))
-			return
-			return
-		}); err != nil {
-			return
-		}
+		if err = utils.RunWithBench(fmt.Sprintf("FNN: acc + fnn%d x FNN2W[%d] -> acc", i, i), func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
-		if err = utils.RunWithBench(fmt.Sprintf("FNN: fnn%d x FNN2[%d] -> acc", i, i), func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
-
-			LevelIn = fnn[0].Level()
-			LogScaleIn = fnn[0].LogScale()
-
-			if err = s.EvaluateLinearTransformation(fnn, FNN2W, fnn); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[MulPt][fnn,FNN2W[%d],fnn]: %w", i, err)
-			}
+			LevelIn = fnn[0][0].Level()
+			LogScaleIn = fnn[0][0].LogScale()
 
-			if err = s.Rescale(fnn, fnn); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][fnn,fnn]: %w", err)
+			var part [][]rlwe.Ciphertext
+			if part, err = s.MulTiledPt(fnn, FNN2W.InBlock(i), params.DefaultScale()); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[MulTiledPt][fnn%d,FNN2W[%d]]: %w", i, i, err)
 			}
 
-			if err = s.AddCt(acc, fnn, acc); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[AddCt][acc,fnn,acc]: %w", err)
+			if acc == nil {
+				acc = part[0]
+			} else if err = s.AddCt(acc, part[0], acc); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[AddCt][acc,fnn%d,acc]: %w", i, err)
 			}
 
 			LevelOut = acc[0].Level()
@@ -155,10 +141,32 @@ func (s *Server) FNNEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Cip
 		}
 	}
 
+	if err = utils.RunWithBench("FNN: acc + In -> acc", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = acc[0].Level()
+		LogScaleIn = acc[0].LogScale()
+
+		if err = s.Rescale(acc, acc); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][acc,acc]: %w", err)
+		}
+
+		if err = s.AddCt(acc, in, acc); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[AddCt][acc,in,acc]: %w", err)
+		}
+
+		LevelOut = acc[0].Level()
+		LogScaleOut = acc[0].LogScale()
+
+		return
+
+	}); err != nil {
+		return
+	}
+
 	if err = utils.RunWithBench("FNN: acc + FNN2B -> In", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
-		LevelIn = min(acc[0].Level(), in[0].Level())
-		LogScaleIn = (acc[0].LogScale() + in[0].LogScale()) / 2
+		LevelIn = acc[0].Level()
+		LogScaleIn = acc[0].LogScale()
 
 		if err = s.AddPt(acc, FNN2Bias, in); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[AddPt][in,FNN2Bias,in]: %w", err)
@@ -175,19 +183,30 @@ func (s *Server) FNNEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Cip
 	return
 }
 
-func (s *Server) FNNApproximate(in []*mat.Dense) {
-	eval := relu.NewEvaluator(lib.ReLUParameters, nil, nil)
-	s.fnn(in, eval.EvaluateApproximate)
+// FNNApproximate returns the range of the values at the input of the activation.
+func (s *Server) FNNApproximate(in []*mat.Dense) (Min, Max float64) {
+	p := lib.FNNActivationParameters()
+	eval := activation.NewEvaluator(p, nil, nil)
+	Min, Max = math.Inf(1), math.Inf(-1)
+	s.fnn(in, func(in, out []*mat.Dense) {
+		// The inputs of the activation are scaled by 1/AbsMax, see fnn.
+		for i := range in {
+			Min = min(Min, mat.Min(in[i])*p.AbsMax)
+			Max = max(Max, mat.Max(in[i])*p.AbsMax)
+		}
+		eval.EvaluateApproximate(in, out)
+	})
+	return
 }
 
 func (s *Server) FNNExact(in []*mat.Dense) {
-	eval := relu.NewEvaluator(lib.ReLUParameters, nil, nil)
+	eval := activation.NewEvaluator(lib.FNNActivationParameters(), nil, nil)
 	s.fnn(in, eval.EvaluateExact)
 }
 
 func (s *Server) fnn(in []*mat.Dense, f func(in, out []*mat.Dense)) {
 
-	scale := max(lib.ReLUParameters.AbsMax)
+	scale := lib.FNNActivationParameters().AbsMax
 
 	FNN1W, fnn1B, FNN2W, fnn2B := weights.LoadTransformerBlockFNNWeights(s.path)
 	FNN1B := utils.BiasToDense(lib.Rows, fnn1B)
@@ -196,56 +215,27 @@ func (s *Server) fnn(in []*mat.Dense, f func(in, out []*mat.Dense)) {
 	FNN1B.Scale(1/scale, FNN1B)
 	FNN2W.Scale(scale, FNN2W)
 
-	FNN1WSplit := matrix.SplitHeads(FNN1W, 2)
-	FNN1BSplit := matrix.SplitHeads(FNN1B, 2)
-
-	rowsFNN2, colsFNN2 := FNN2W.Dims()
-
-	FNN2WSplit := [2]*mat.Dense{
-		mat.NewDense(rowsFNN2/2, colsFNN2, FNN2W.RawMatrix().Data[:(rowsFNN2/2)*colsFNN2]),
-		mat.NewDense(rowsFNN2/2, colsFNN2, FNN2W.RawMatrix().Data[(rowsFNN2/2)*colsFNN2:]),
-	}
-
 	FNN2B := utils.BiasToDense(lib.Rows, fnn2B)
 
-	_, colsFNN1 := FNN1WSplit[0].Dims()
-	rowsFNN2, colsFNN2 = FNN2WSplit[0].Dims()
+	_, hidden := FNN1W.Dims()
+	_, cols := FNN2W.Dims()
 
 	rows, _ := in[0].Dims()
 
-	nn1 := mat.NewDense(rows, colsFNN2, make([]float64, rows*colsFNN2))
 	nn0 := make([]*mat.Dense, len(in))
 	for i := range nn0 {
-		nn0[i] = mat.NewDense(rows, colsFNN1, make([]float64, rows*colsFNN1))
-	}
-
-	acc := make([]*mat.Dense, len(in))
-	for i := range acc {
-		acc[i] = mat.NewDense(rows, colsFNN2, make([]float64, rows*colsFNN2))
+		nn0[i] = mat.NewDense(rows, hidden, make([]float64, rows*hidden))
+		nn0[i].Mul(in[i], FNN1W)
+		nn0[i].Add(nn0[i], FNN1B)
 	}
 
-	for i := range 2 {
-
-		fnn1W := FNN1WSplit[i]
-		fnn1B := FNN1BSplit[i]
+	f(nn0, nn0)
 
-		for j := range in {
-			nn0[j].Mul(in[j], fnn1W)
-			nn0[j].Add(nn0[j], fnn1B)
-		}
-
-		f(nn0, nn0)
-
-		fnn2W := FNN2WSplit[i]
-
-		for j := range in {
-			nn1.Mul(nn0[j], fnn2W)
-			acc[j].Add(acc[j], nn1)
-		}
-	}
+	nn1 := mat.NewDense(rows, cols, make([]float64, rows*cols))
 
 	for i := range in {
-		in[i].Add(in[i], acc[i])
+		nn1.Mul(nn0[i], FNN2W)
+		in[i].Add(in[i], nn1)
 		in[i].Add(in[i], FNN2B)
 	}
 
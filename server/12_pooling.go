@@ -4,88 +4,134 @@ import (
 	"fmt"
 
 	"app/lib"
+	"app/matrix"
 	"app/utils"
 
 	"gonum.org/v1/gonum/mat"
 
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
-func (s *Server) PoolingEncrypted(in []rlwe.Ciphertext) (out []rlwe.Ciphertext, err error) {
+// PoolingConversion returns the periodic conversion packing the first row
+// of the matrices of lib.Rows ciphertexts storing matPerCt samples each,
+// scaled by 1/lib.Rows, into one ciphertext as described by
+// [matrix.Layout].Pool. Each input ciphertext is thus moved by a single
+// rotation.
+func PoolingConversion(params hefloat.Parameters, matPerCt int) (c *matrix.Conversion, err error) {
 
-	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.PoolingGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
-		s.SetKeys(s.KeyManager)
-		return
-	}); err != nil {
-		return
+	in := packedLayout(matPerCt, lib.Rows*matPerCt)
+
+	var out matrix.Layout
+	if out, err = in.Pool(); err != nil {
+		return nil, fmt.Errorf("[matrix.Layout].Pool: %w", err)
 	}
 
-	if err = utils.RunWithBench("Pooling", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+	// Only the first row of each matrix, i.e. its inner sum, is moved.
+	in.Rows, in.PaddRows = 1, lib.Rows-1
 
-		eval := s.Evaluator.Evaluators[0]
+	if c, err = matrix.NewPeriodicConversion(params, in, out, 1/float64(lib.Rows)); err != nil {
+		return nil, fmt.Errorf("[matrix].NewPeriodicConversion: %w", err)
+	}
+
+	return
+}
 
-		params := eval.Parameters()
-		slots := params.MaxSlots()
+// PoolingEncrypted returns the mean of the rows of each matrix of in, which
+// must store lib.NbMatPerCtIn samples per ciphertext, packed as described by
+// [matrix.Layout].Pool (see PoolingTensor). The ciphertexts of in are modified.
+func (s *Server) PoolingEncrypted(in []rlwe.Ciphertext, minLevel int) (out []rlwe.Ciphertext, err error) {
 
-		mask := make([]float64, slots)
-		flatten := lib.Rows * lib.Cols
-		for i := range slots / flatten {
-			for j := range lib.Cols {
-				mask[i*flatten+j] = 1 / float64(lib.Rows)
-			}
-		}
+	var t *matrix.Tensor
+	if t, err = matrix.NewTensor(InputLayout(len(in)*lib.NbMatPerCtIn), in); err != nil {
+		return nil, fmt.Errorf("[matrix].NewTensor: %w", err)
+	}
 
-		LevelIn = in[0].Level()
-		LogScaleIn = in[0].LogScale()
+	if t, err = s.PoolingTensor(t, minLevel); err != nil {
+		return
+	}
+
+	return t.Cts, nil
+}
+
+// PoolingTensor returns the mean of the rows of each matrix of in, which must
+// be a batch of lib.Rows x lib.Cols matrices with any number of samples per
+// ciphertext, packed as described by [matrix.Layout].Pool. The pooling is
+// evaluated at 2+minLevel, or at the level of in if it is lower, so that the
+// pooled ciphertexts are at 1+minLevel, e.g. with a spare level for their
+// compaction (see CompactTensor). The ciphertexts of in are modified.
+func (s *Server) PoolingTensor(in *matrix.Tensor, minLevel int) (out *matrix.Tensor, err error) {
+
+	if err = in.Expect(packedLayout(in.MatPerCt, len(in.Samples))); err != nil {
+		return
+	}
 
-		out = make([]rlwe.Ciphertext, (len(in)+lib.Rows-1)/lib.Rows)
+	params := s.Evaluator.Evaluators[0].Parameters()
 
-		hoistingbuffer := eval.NewHoistingBuffer(LevelIn, params.MaxLevelP())
+	var c *matrix.Conversion
+	if c, err = PoolingConversion(params, in.MatPerCt); err != nil {
+		return
+	}
 
-		for i := range out {
+	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
+		if err = s.KeyManager.LoadGaloisKeys(poolingGaloisElements(params, in.MatPerCt)); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
+		s.SetKeys(s.KeyManager)
+		return
+	}); err != nil {
+		return
+	}
 
-			for j := range lib.Rows {
+	level := min(in.Cts[0].Level(), 2+minLevel)
 
-				if i*lib.Rows+j == len(in) {
-					break
-				}
+	var Pooling []*he.LinearTransformation
+	if err = utils.LoadWithBench("Load Pooling", func() (err error) {
+		Pooling, err = s.NewConversionLinearTransformations(level, in.Cts[0].Scale, in.Cts[0].Scale, c)
+		return
+	}); err != nil {
+		return
+	}
 
-				ct := &in[i*lib.Rows+j]
+	if err = utils.RunWithBench("Pooling", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
-				for ct.Level() > 2 {
-					eval.DropLevel(ct, 1)
-				}
+		eval := s.Evaluator.Evaluators[0]
 
-				if err = eval.InnerSum(ct, lib.Cols, lib.Rows, hoistingbuffer, ct); err != nil {
-					return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[rlwe.Evaluator][InnerSum]: %w", err)
-				}
+		LevelIn = in.Cts[0].Level()
+		LogScaleIn = in.Cts[0].LogScale()
 
-				if err = eval.Mul(ct, mask, ct); err != nil {
-					return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[rlwe.Evaluator][Mul][ct,mask,ct]: %w", err)
-				}
+		hoistingbuffer := eval.NewHoistingBuffer(level, params.MaxLevelP())
 
-				if j == 0 {
-					out[i] = *ct
-				} else {
-					if err = eval.Rotate(ct, -j*lib.Cols, ct); err != nil {
-						return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[hefloat.Evaluator][Rotate][in,%d,in]: %w", -i*lib.Cols, err)
-					}
+		for i := range in.Cts {
 
-					if err = eval.Add(&out[i], ct, &out[i]); err != nil {
-						return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[hefloat.Evaluator][Add][out,in[%d],out]: %w", i*lib.Rows+j, err)
-					}
-				}
+			for in.Cts[i].Level() > level {
+				eval.DropLevel(&in.Cts[i], 1)
 			}
 
-			if err = eval.Rescale(&out[i], &out[i]); err != nil {
-				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[hefloat.Evaluator][Rescale][out,out]: %w", err)
+			if err = eval.InnerSum(&in.Cts[i], lib.Cols, lib.Rows, hoistingbuffer, &in.Cts[i]); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[rlwe.Evaluator][InnerSum]: %w", err)
 			}
+		}
+
+		layout := c.In
+		layout.Samples = in.Samples
+
+		var ct *matrix.Tensor
+		if ct, err = matrix.NewTensor(layout, in.Cts); err != nil {
+			return
+		}
+
+		if out, err = s.Convert(ct, c, Pooling); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][Convert]: %w", err)
+		}
 
+		if err = s.Rescale(out.Cts, out.Cts); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][out,out]: %w", err)
 		}
 
-		LevelOut = out[0].Level()
-		LogScaleOut = out[0].LogScale()
+		LevelOut = out.Cts[0].Level()
+		LogScaleOut = out.Cts[0].LogScale()
 
 		return
 
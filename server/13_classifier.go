@@ -15,10 +15,16 @@ import (
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
-func (s *Server) ClassifierEncrypted(in []rlwe.Ciphertext) (err error) {
+// ClassifierEncrypted maps the pooled rows to the logits. The weights are
+// encoded minLevel levels above lib.LevelClassifier, so that the logits end
+// at minLevel, e.g. at the MinimumInputLevel of the bootstrapping of the
+// argmax or of the output softmax.
+func (s *Server) ClassifierEncrypted(in []rlwe.Ciphertext, minLevel int) (err error) {
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.ClassifierGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.ClassifierGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
@@ -33,7 +39,7 @@ func (s *Server) ClassifierEncrypted(in []rlwe.Ciphertext) (err error) {
 		classifierWPadded := mat.NewDense(lib.Cols, lib.Cols, make([]float64, lib.Cols*lib.Cols))
 		paddingMat := mat.NewDense(lib.Cols, lib.Cols-lib.Classes, make([]float64, lib.Cols*(lib.Cols-lib.Classes)))
 		classifierWPadded.Augment(classifierW, paddingMat)
-		ClassifierWeights, err = s.EncodeMulNew(classifierWPadded, lib.LevelClassifier)
+		ClassifierWeights, err = s.EncodeMulNew(classifierWPadded, lib.LevelClassifier+minLevel)
 		return
 	}); err != nil {
 		return
@@ -90,6 +96,6 @@ func (s *Server) ClassifierExact(in []*mat.Dense) (out []*mat.Dense) {
 }
 
 func (s *Server) SoftmaxExact(in []*mat.Dense) {
-	sf := softmax.NewEvaluator(lib.SoftMaxParameters, nil, nil)
+	sf := softmax.NewEvaluator(s.Attention.SoftMaxParameters(), nil, nil)
 	sf.EvaluateExact(in, in)
 }
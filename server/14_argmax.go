@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+
+	"app/lib"
+	"app/matrix/argmax"
+	"app/utils"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+func (s *Server) ArgmaxEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (out []rlwe.Ciphertext, err error) {
+
+	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
+		if err = s.KeyManager.LoadGaloisKeys(argmax.GaloisElements(s.Evaluator.Evaluators[0].Parameters(), lib.ArgmaxParameters, len(in))); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
+		s.SetKeys(s.KeyManager)
+		return
+	}); err != nil {
+		return
+	}
+
+	if err = utils.RunWithBench("Argmax", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = in[0].Level()
+		LogScaleIn = in[0].LogScale()
+
+		eval := argmax.NewEvaluator(lib.ArgmaxParameters, s.Evaluator, btp)
+
+		if out, err = eval.EvaluateEncrypted(in); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[argmax.Evaluator][EvaluateEncrypted]: %w", err)
+		}
+
+		LevelOut = out[0].Level()
+		LogScaleOut = out[0].LogScale()
+
+		return
+
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Server) ArgmaxApproximate(in []*mat.Dense) {
+	eval := argmax.NewEvaluator(lib.ArgmaxParameters, nil, nil)
+	eval.EvaluateApproximate(in, in)
+}
+
+func (s *Server) ArgmaxExact(in []*mat.Dense) {
+	eval := argmax.NewEvaluator(lib.ArgmaxParameters, nil, nil)
+	eval.EvaluateExact(in, in)
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+
+	"app/lib"
+	"app/matrix/softmax/output"
+	"app/utils"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+func (s *Server) OutputSoftMaxEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (out []rlwe.Ciphertext, err error) {
+
+	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
+		if err = s.KeyManager.LoadGaloisKeys(output.GaloisElements(s.Evaluator.Evaluators[0].Parameters(), lib.OutputSoftMaxParameters, len(in))); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
+		s.SetKeys(s.KeyManager)
+		return
+	}); err != nil {
+		return
+	}
+
+	if err = utils.RunWithBench("OutputSoftMax", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = in[0].Level()
+		LogScaleIn = in[0].LogScale()
+
+		eval := output.NewEvaluator(lib.OutputSoftMaxParameters, s.Evaluator, btp)
+
+		if out, err = eval.EvaluateEncrypted(in); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[output.Evaluator][EvaluateEncrypted]: %w", err)
+		}
+
+		LevelOut = out[0].Level()
+		LogScaleOut = out[0].LogScale()
+
+		return
+
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+func (s *Server) OutputSoftMaxApproximate(in []*mat.Dense) (ExpMin, ExpMax, InvMin, InvMax float64) {
+	eval := output.NewEvaluator(lib.OutputSoftMaxParameters, nil, nil)
+	return eval.EvaluateApproximate(in, in)
+}
+
+func (s *Server) OutputSoftMaxExact(in []*mat.Dense) {
+	eval := output.NewEvaluator(lib.OutputSoftMaxParameters, nil, nil)
+	eval.EvaluateExact(in, in)
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"fmt"
+
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// SanitizeEncrypted binarizes the one-hot output of the argmax with btp and
+// floods its noise (see sanitize.Sanitizer).
+func (s *Server) SanitizeEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (out []rlwe.Ciphertext, err error) {
+
+	if err = utils.RunWithBench("Binarize", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = in[0].Level()
+		LogScaleIn = in[0].LogScale()
+
+		if out, err = s.Sanitizer.Binarize(in, s.Evaluator.Evaluators[0], btp); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[sanitize.Sanitizer][Binarize]: %w", err)
+		}
+
+		LevelOut = out[0].Level()
+		LogScaleOut = out[0].LogScale()
+
+		return
+	}); err != nil {
+		return
+	}
+
+	if err = utils.RunWithBench("Sanitize", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = out[0].Level()
+		LogScaleIn = out[0].LogScale()
+
+		if err = s.Sanitizer.Sanitize(out); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[sanitize.Sanitizer][Sanitize]: %w", err)
+		}
+
+		LevelOut = out[0].Level()
+		LogScaleOut = out[0].LogScale()
+
+		return
+	}); err != nil {
+		return
+	}
+
+	return
+}
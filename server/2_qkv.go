@@ -15,10 +15,17 @@ import (
 	"github.com/Pro7ech/lattigo/utils/structs"
 )
 
-func (s *Server) QKVEncrypted(in []rlwe.Ciphertext) (Q, K, V []rlwe.Ciphertext, err error) {
+// QKVEncrypted returns the queries, keys and values of in. The queries and
+// the keys are computed minLevel levels above lib.LevelQuery and lib.LevelKey,
+// so that Q x K^T ends at minLevel instead of 0, e.g. at the MinimumInputLevel
+// of the bootstrapping that follows it. The values are already computed at
+// the output level of the embedding.
+func (s *Server) QKVEncrypted(in []rlwe.Ciphertext, minLevel int) (Q, K, V []rlwe.Ciphertext, err error) {
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.QKVGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.QKVGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
@@ -30,7 +37,7 @@ func (s *Server) QKVEncrypted(in []rlwe.Ciphertext) (Q, K, V []rlwe.Ciphertext,
 	if err = utils.LoadWithBench("Load Key Matrix", func() (err error) {
 		var keyW *mat.Dense
 		keyW, keyB = weights.LoadTransformerBlockKeyWeights(s.path)
-		KeyWeights, err = s.EncodeMulNew(keyW, lib.LevelKey)
+		KeyWeights, err = s.EncodeMulNew(keyW, lib.LevelKey+minLevel)
 		return
 	}); err != nil {
 		return
@@ -69,7 +76,7 @@ func (s *Server) QKVEncrypted(in []rlwe.Ciphertext) (Q, K, V []rlwe.Ciphertext,
 	if err = utils.LoadWithBench("Load Query Matrix", func() (err error) {
 		var queryW *mat.Dense
 		queryW, queryB = weights.LoadTransformerBlockQueryWeights(s.path)
-		QueryWeights, err = s.EncodeMulNew(queryW, lib.LevelQuery)
+		QueryWeights, err = s.EncodeMulNew(queryW, lib.LevelQuery+minLevel)
 		return
 	}); err != nil {
 		return
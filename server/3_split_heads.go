@@ -4,104 +4,126 @@ import (
 	"fmt"
 	"slices"
 
-	"app/lib"
 	"app/matrix"
 	"app/utils"
 
 	"gonum.org/v1/gonum/mat"
 
 	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
-func (s *Server) SplitHeadsEncrypted(Q, K, V []rlwe.Ciphertext) (err error) {
+// SplitHeadsConversion returns the periodic conversion of the merged layout
+// to its heads (see MergedLayout and SplitLayout), which changes the number
+// of samples per ciphertext if s.Packing does, within the same level.
+func (s *Server) SplitHeadsConversion(params hefloat.Parameters) (c *matrix.Conversion, err error) {
 
-	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.SplitHeadsGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
-		s.SetKeys(s.KeyManager)
-		return
-	}); err != nil {
-		return
-	}
+	in := s.MergedLayout(0)
 
-	var SplitHeads *he.LinearTransformation
-	if err = utils.LoadWithBench("Load Split Heads", func() (err error) {
-		params := s.Evaluator.Evaluators[0].Parameters()
-		SplitHeads, err = s.NewLinearTransformation(
-			slices.Max([]int{Q[0].Level(), K[0].Level(), V[0].Level()}),
-			params.DefaultScale(),
-			params.DefaultScale(),
-			false,
-			matrix.SplitDiagonals(params, lib.Rows, lib.Cols, lib.Split, lib.Padding, 1.0))
-		return
-	}); err != nil {
+	var out matrix.Layout
+	if out, err = s.SplitLayout(in); err != nil {
 		return
 	}
 
-	if err = utils.RunWithBench("Split Heads Q", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+	if c, err = matrix.NewPeriodicConversion(params, in, out, 1); err != nil {
+		return nil, fmt.Errorf("[matrix].NewPeriodicConversion: %w", err)
+	}
 
-		LevelIn = Q[0].Level()
-		LogScaleIn = Q[0].LogScale()
+	return
+}
 
-		if err = s.EvaluateLinearTransformation(Q, SplitHeads, Q); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[SplitHeads][Q]: %w", err)
-		}
+// SplitHeadsEncrypted returns the s.Attention.Heads heads of Q, K and V, which must
+// store the samples per ciphertext of MergedLayout (see SplitHeadsTensor).
+func (s *Server) SplitHeadsEncrypted(Q, K, V []rlwe.Ciphertext) (QSplit, KSplit, VSplit []rlwe.Ciphertext, err error) {
 
-		if err = s.Rescale(Q, Q); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][Q]: %w", err)
+	t := make([]*matrix.Tensor, 3)
+	for i, cts := range [][]rlwe.Ciphertext{Q, K, V} {
+		if t[i], err = matrix.NewTensor(s.MergedLayout(len(cts)*s.SamplesPerCt("Embed")), cts); err != nil {
+			return nil, nil, nil, fmt.Errorf("[matrix].NewTensor: %w", err)
 		}
+	}
 
-		LevelOut = Q[0].Level()
-		LogScaleOut = Q[0].LogScale()
-
-		return
-
-	}); err != nil {
+	if err = s.SplitHeadsTensor(t[0], t[1], t[2]); err != nil {
 		return
 	}
 
-	if err = utils.RunWithBench("Split Heads K", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+	return t[0].Cts, t[1].Cts, t[2].Cts, nil
+}
 
-		LevelIn = K[0].Level()
-		LogScaleIn = K[0].LogScale()
+// SplitHeadsTensor splits Q, K and V, which must be batches of lib.Rows x lib.Cols
+// matrices with the same samples (see MergedLayout), in s.Attention.Heads heads
+// (see SplitLayout).
+func (s *Server) SplitHeadsTensor(Q, K, V *matrix.Tensor) (err error) {
 
-		if err = s.EvaluateLinearTransformation(K, SplitHeads, K); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[SplitHeads][K]: %w", err)
+	for _, t := range []*matrix.Tensor{Q, K, V} {
+		if err = t.Expect(s.MergedLayout(len(t.Samples))); err != nil {
+			return
 		}
+	}
 
-		if err = s.Rescale(K, K); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][K]: %w", err)
-		}
+	if !slices.Equal(Q.Samples, K.Samples) || !slices.Equal(Q.Samples, V.Samples) {
+		return fmt.Errorf("invalid layout: Q, K and V do not store the same samples")
+	}
 
-		LevelOut = K[0].Level()
-		LogScaleOut = K[0].LogScale()
+	params := s.Evaluator.Evaluators[0].Parameters()
 
+	var c *matrix.Conversion
+	if c, err = s.SplitHeadsConversion(params); err != nil {
 		return
+	}
 
+	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
+		if err = s.KeyManager.LoadGaloisKeys(s.SplitHeadsGaloisElements(params)); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
+		s.SetKeys(s.KeyManager)
+		return
+	}); err != nil {
+		return
+	}
+
+	var SplitHeads []*he.LinearTransformation
+	if err = utils.LoadWithBench("Load Split Heads", func() (err error) {
+		SplitHeads, err = s.NewConversionLinearTransformations(
+			slices.Max([]int{Q.Cts[0].Level(), K.Cts[0].Level(), V.Cts[0].Level()}),
+			params.DefaultScale(),
+			params.DefaultScale(),
+			c)
+		return
 	}); err != nil {
 		return
 	}
 
-	if err = utils.RunWithBench("Split Heads V", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+	for _, t := range []struct {
+		name string
+		*matrix.Tensor
+	}{{"Q", Q}, {"K", K}, {"V", V}} {
 
-		LevelIn = V[0].Level()
-		LogScaleIn = V[0].LogScale()
+		if err = utils.RunWithBench("Split Heads "+t.name, func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
-		if err = s.EvaluateLinearTransformation(V, SplitHeads, V); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[SplitHeads][V]: %w", err)
-		}
+			LevelIn = t.Cts[0].Level()
+			LogScaleIn = t.Cts[0].LogScale()
 
-		if err = s.Rescale(V, V); err != nil {
-			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][V]: %w", err)
-		}
+			var out *matrix.Tensor
+			if out, err = s.Convert(t.Tensor, c, SplitHeads); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[SplitHeads][%s]: %w", t.name, err)
+			}
 
-		LevelOut = V[0].Level()
-		LogScaleOut = V[0].LogScale()
+			if err = s.Rescale(out.Cts, out.Cts); err != nil {
+				return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][%s]: %w", t.name, err)
+			}
 
-		return
+			*t.Tensor = *out
 
-	}); err != nil {
-		return
+			LevelOut = t.Cts[0].Level()
+			LogScaleOut = t.Cts[0].LogScale()
+
+			return
+
+		}); err != nil {
+			return
+		}
 	}
 
 	return
@@ -114,17 +136,17 @@ func (s *Server) SplitHeadsApproximate(Q, K, V []*mat.Dense) (QSplit, KSPlit, VS
 func (s *Server) SplitHeadsExact(Q, K, V []*mat.Dense) (QSplit, KSPlit, VSplit [][]*mat.Dense) {
 	QSplit = make([][]*mat.Dense, len(Q))
 	for i := range QSplit {
-		QSplit[i] = matrix.SplitHeads(Q[i], lib.Split)
+		QSplit[i] = matrix.SplitHeads(Q[i], s.Attention.Heads)
 	}
 
 	KSPlit = make([][]*mat.Dense, len(K))
 	for i := range KSPlit {
-		KSPlit[i] = matrix.SplitHeads(K[i], lib.Split)
+		KSPlit[i] = matrix.SplitHeads(K[i], s.Attention.Heads)
 	}
 
 	VSplit = make([][]*mat.Dense, len(V))
 	for i := range VSplit {
-		VSplit[i] = matrix.SplitHeads(V[i], lib.Split)
+		VSplit[i] = matrix.SplitHeads(V[i], s.Attention.Heads)
 	}
 
 	return
@@ -16,7 +16,9 @@ import (
 func (s *Server) QMulKTEncrypted(Q, K, QMulKT []rlwe.Ciphertext) (err error) {
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.TransposeGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.TransposeGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
@@ -27,7 +29,7 @@ func (s *Server) QMulKTEncrypted(Q, K, QMulKT []rlwe.Ciphertext) (err error) {
 	if err = utils.LoadWithBench("Load Transpose", func() (err error) {
 		Scaling := 1.0
 		params := s.Evaluator.Evaluators[0].Parameters()
-		Transpose, err = s.NewTranspose(K[0].Level(), lib.Rows, Scaling, K[0].Scale, params.DefaultScale())
+		Transpose, err = s.NewTranspose(K[0].Level(), s.Attention.HeadDims, Scaling, K[0].Scale, params.DefaultScale())
 		if err != nil {
 			panic(fmt.Errorf("[matrix.NewTranspose]: %w", err))
 		}
@@ -59,27 +61,29 @@ func (s *Server) QMulKTEncrypted(Q, K, QMulKT []rlwe.Ciphertext) (err error) {
 	}
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.QMulKTGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.QMulKTGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
 		return
 	}
 
-	var MulParamsQKT *matrix.MulParameters
+	var MulParamsQKT *matrix.RectMulParameters
 	if err = utils.LoadWithBench("Load MulParameters", func() (err error) {
-		TransposeL := false
-		TransposeR := false
-		Scaling := lib.KTScaling
-		MulParamsQKT, err = s.NewMulParameters(
+		Scaling := s.Attention.KTScaling
+		MulParamsQKT, err = s.NewRectMulParameters(
 			min(Q[0].Level(), K[0].Level()),
+			lib.Rows,
+			s.Attention.HeadCols,
+			lib.Rows,
+			s.Attention.HeadDims,
 			Scaling,
-			TransposeL,
-			TransposeR,
 			Q[0].Scale,
 			K[0].Scale)
 		if err != nil {
-			panic(fmt.Errorf("[NewServer][matrix.NewMulParameters]: %w", err))
+			panic(fmt.Errorf("[NewServer][matrix.NewRectMulParameters]: %w", err))
 		}
 		return
 	}); err != nil {
@@ -91,10 +95,15 @@ func (s *Server) QMulKTEncrypted(Q, K, QMulKT []rlwe.Ciphertext) (err error) {
 		LevelIn = min(Q[0].Level(), K[0].Level())
 		LogScaleIn = (K[0].LogScale() + Q[0].LogScale()) / 2
 
-		if err = s.MulCt(Q, K, MulParamsQKT, QMulKT); err != nil {
+		// Each head is a single HeadDims x HeadDims tile, rather than the tiles
+		// of matrix.TileDims, so that the softmax sees whole rows (see lib.Attention).
+		var QKT matrix.Tiled
+		if QKT, err = s.MulCtRect(matrix.Tiled{{Q}}, matrix.Tiled{{K}}, MulParamsQKT); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Mul][Q,KT]: %w", err)
 		}
 
+		copy(QMulKT, QKT[0][0])
+
 		if err = s.Rescale(QMulKT, QMulKT); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][QMulKT]: %w", err)
 		}
@@ -117,8 +126,8 @@ func (s *Server) QMulKTApproximate(Q, K [][]*mat.Dense) (QKT [][]*mat.Dense) {
 
 func (s *Server) QMulKTExact(Q, K [][]*mat.Dense) (QKT [][]*mat.Dense) {
 	rows, _ := Q[0][0].Dims()
-	split := lib.Split
-	scaling := lib.KTScaling
+	split := s.Attention.Heads
+	scaling := s.Attention.KTScaling
 	QKT = make([][]*mat.Dense, len(Q))
 	for i := range Q {
 		QKT[i] = make([]*mat.Dense, split)
@@ -3,7 +3,6 @@ package server
 import (
 	"fmt"
 
-	"app/lib"
 	"app/matrix/softmax"
 	"app/utils"
 
@@ -16,14 +15,16 @@ import (
 func (s *Server) SoftMaxEncrypted(QKT []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.SoftMaxGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.SoftMaxGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
 		return
 	}
 
-	eval := softmax.NewEvaluator(lib.SoftMaxParameters, s.Evaluator, btp)
+	eval := softmax.NewEvaluator(s.HeadParameters().SoftMaxParameters(), s.Evaluator, btp)
 	if err = eval.EvaluateEncrypted(QKT); err != nil {
 		return fmt.Errorf("[softmax.Evaluator][EvaluateEncrypted]: %w", err)
 	}
@@ -31,13 +32,13 @@ func (s *Server) SoftMaxEncrypted(QKT []rlwe.Ciphertext, btp he.Bootstrapper[rlw
 }
 
 func (s *Server) SoftMaxExact(QKT [][]*mat.Dense) {
-	eval := softmax.NewEvaluator(lib.SoftMaxParameters, nil, nil)
+	eval := softmax.NewEvaluator(s.Attention.SoftMaxParameters(), nil, nil)
 	m := utils.Flatten(QKT)
 	eval.EvaluateExact(m, m)
 }
 
 func (s *Server) SoftMaxApproximate(QKT [][]*mat.Dense) (StatsIn, StatsExp, StatsNorm utils.Stats) {
-	eval := softmax.NewEvaluator(lib.SoftMaxParameters, nil, nil)
+	eval := softmax.NewEvaluator(s.Attention.SoftMaxParameters(), nil, nil)
 	m := utils.Flatten(QKT)
 	return eval.EvaluateApproximate(m, m)
 }
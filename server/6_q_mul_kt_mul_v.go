@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 
+	"app/lib"
 	"app/matrix"
 	"app/utils"
 
@@ -15,27 +16,29 @@ import (
 func (s *Server) QKTMulVEncrypted(QKT, V, QKTMulV []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.QMulKTMulVGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.QMulKTMulVGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
 		return
 	}
 
-	var MulParamsQKTV *matrix.MulParameters
+	var MulParamsQKTV *matrix.RectMulParameters
 	if err = utils.LoadWithBench("Load MulParameters", func() (err error) {
-		TransposeL := false
-		TransposeR := false
 		Scaling := 1.0
-		MulParamsQKTV, err = s.NewMulParameters(
+		MulParamsQKTV, err = s.NewRectMulParameters(
 			min(QKT[0].Level(), V[0].Level()),
+			lib.Rows,
+			lib.Rows,
+			s.Attention.HeadCols,
+			s.Attention.HeadDims,
 			Scaling,
-			TransposeL,
-			TransposeR,
 			QKT[0].Scale,
 			V[0].Scale)
 		if err != nil {
-			panic(fmt.Errorf("[NewServer][matrix.NewMulParameters]: %w", err))
+			panic(fmt.Errorf("[NewServer][matrix.NewRectMulParameters]: %w", err))
 		}
 		return
 	}); err != nil {
@@ -47,10 +50,15 @@ func (s *Server) QKTMulVEncrypted(QKT, V, QKTMulV []rlwe.Ciphertext, btp he.Boot
 		LevelIn = min(QKT[0].Level(), V[0].Level())
 		LogScaleIn = (QKT[0].LogScale() + V[0].LogScale()) / 2
 
-		if err = s.MulCt(QKT, V, MulParamsQKTV, QKTMulV); err != nil {
+		// Each head is a single HeadDims x HeadDims tile, rather than the tiles
+		// of matrix.TileDims, so that the softmax sees whole rows (see lib.Attention).
+		var QKTV matrix.Tiled
+		if QKTV, err = s.MulCtRect(matrix.Tiled{{QKT}}, matrix.Tiled{{V}}, MulParamsQKTV); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Mul][QKT,V]: %w", err)
 		}
 
+		copy(QKTMulV, QKTV[0][0])
+
 		if err = s.Rescale(QKTMulV, QKTMulV); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][QKTMulV]: %w", err)
 		}
@@ -3,35 +3,87 @@ package server
 import (
 	"fmt"
 
-	"app/lib"
 	"app/matrix"
 	"app/utils"
 
 	"gonum.org/v1/gonum/mat"
 
 	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
-func (s *Server) MergeHeadsEncrypted(QKTMulVSplit []rlwe.Ciphertext) (err error) {
+// MergeHeadsConversion returns the periodic conversion of the heads back
+// to the merged layout of the residual (see SplitLayout and MergedLayout).
+func (s *Server) MergeHeadsConversion(params hefloat.Parameters) (c *matrix.Conversion, err error) {
+
+	out := s.MergedLayout(0)
+
+	var in matrix.Layout
+	if in, err = s.SplitLayout(out); err != nil {
+		return
+	}
+
+	if c, err = matrix.NewPeriodicConversion(params, in, out, 1); err != nil {
+		return nil, fmt.Errorf("[matrix].NewPeriodicConversion: %w", err)
+	}
+
+	return
+}
+
+// MergeHeadsEncrypted returns the merged s.Attention.Heads heads of HeadMatPerCt
+// heads per ciphertext (see SplitHeadsEncrypted and MergeHeadsTensor).
+func (s *Server) MergeHeadsEncrypted(QKTMulVSplit []rlwe.Ciphertext) (QKTMulV []rlwe.Ciphertext, err error) {
+
+	var layout matrix.Layout
+	if layout, err = s.SplitLayout(s.MergedLayout(len(QKTMulVSplit) * s.HeadMatPerCt() / s.Attention.Heads)); err != nil {
+		return
+	}
+
+	var t *matrix.Tensor
+	if t, err = matrix.NewTensor(layout, QKTMulVSplit); err != nil {
+		return nil, fmt.Errorf("[matrix].NewTensor: %w", err)
+	}
+
+	if err = s.MergeHeadsTensor(t); err != nil {
+		return
+	}
+
+	return t.Cts, nil
+}
+
+// MergeHeadsTensor merges the split matrices in to MergedLayout (see SplitHeadsTensor).
+// Only the first lib.Rows rows of the heads are read, the others being padding.
+func (s *Server) MergeHeadsTensor(in *matrix.Tensor) (err error) {
+
+	if err = s.checkSplit(in, in); err != nil {
+		return
+	}
+
+	params := s.Evaluator.Evaluators[0].Parameters()
+
+	var c *matrix.Conversion
+	if c, err = s.MergeHeadsConversion(params); err != nil {
+		return
+	}
 
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.MergeHeadsGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.MergeHeadsGaloisElements(params)); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
 		return
 	}
 
-	var MergeHeads *he.LinearTransformation
+	var MergeHeads []*he.LinearTransformation
 	if err = utils.LoadWithBench("Load Merge Heads", func() (err error) {
-		params := s.Evaluator.Evaluators[0].Parameters()
-		MergeHeads, err = s.NewLinearTransformation(
-			QKTMulVSplit[0].Level(),
-			QKTMulVSplit[0].Scale,
+		MergeHeads, err = s.NewConversionLinearTransformations(
+			in.Cts[0].Level(),
+			in.Cts[0].Scale,
 			params.DefaultScale(),
-			false,
-			matrix.MergeDiagonals(params, lib.Rows, lib.Cols, lib.Split, lib.Padding, 1.0))
+			c)
 		return
 	}); err != nil {
 		return
@@ -39,19 +91,22 @@ func (s *Server) MergeHeadsEncrypted(QKTMulVSplit []rlwe.Ciphertext) (err error)
 
 	if err = utils.RunWithBench("Merge Heads QKTV", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
 
-		LevelIn = QKTMulVSplit[0].Level()
-		LogScaleIn = QKTMulVSplit[0].LogScale()
+		LevelIn = in.Cts[0].Level()
+		LogScaleIn = in.Cts[0].LogScale()
 
-		if err = s.EvaluateLinearTransformation(QKTMulVSplit, MergeHeads, QKTMulVSplit); err != nil {
+		var out *matrix.Tensor
+		if out, err = s.Convert(in, c, MergeHeads); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[MergeHeads][Q]: %w", err)
 		}
 
-		if err = s.Rescale(QKTMulVSplit, QKTMulVSplit); err != nil {
+		if err = s.Rescale(out.Cts, out.Cts); err != nil {
 			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][Q]: %w", err)
 		}
 
-		LevelOut = QKTMulVSplit[0].Level()
-		LogScaleOut = QKTMulVSplit[0].LogScale()
+		*in = *out
+
+		LevelOut = in.Cts[0].Level()
+		LogScaleOut = in.Cts[0].LogScale()
 
 		return
 
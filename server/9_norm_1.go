@@ -1,7 +1,10 @@
 package server
 
 import (
+	"fmt"
+
 	"app/lib"
+	"app/matrix"
 	"app/matrix/normalization"
 	"app/utils"
 	"app/weights"
@@ -12,10 +15,32 @@ import (
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
+// Norm1Encrypted normalizes the rows of in, which must store
+// lib.NbMatPerCtIn samples per ciphertext (see Norm1Tensor).
 func (s *Server) Norm1Encrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
 
+	var t *matrix.Tensor
+	if t, err = matrix.NewTensor(InputLayout(len(in)*lib.NbMatPerCtIn), in); err != nil {
+		return fmt.Errorf("[matrix].NewTensor: %w", err)
+	}
+
+	return s.Norm1Tensor(t, btp)
+}
+
+// Norm1Tensor normalizes the rows of in, which must be a batch of
+// lib.Rows x lib.Cols matrices with any number of samples per ciphertext:
+// the ToTVecSize of lib.Norm1Parameters is replaced by the number of
+// slots of the matrices of a ciphertext of in.
+func (s *Server) Norm1Tensor(in *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	if err = in.Expect(packedLayout(in.MatPerCt, len(in.Samples))); err != nil {
+		return
+	}
+
 	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
-		s.KeyManager.LoadGaloisKeys(s.NormalizationGaloisElements(s.Evaluator.Evaluators[0].Parameters()))
+		if err = s.KeyManager.LoadGaloisKeys(s.NormalizationGaloisElements(s.Evaluator.Evaluators[0].Parameters())); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
 		s.SetKeys(s.KeyManager)
 		return
 	}); err != nil {
@@ -26,8 +51,9 @@ func (s *Server) Norm1Encrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.C
 	params := lib.Norm1Parameters
 	params.Gamma = gamma
 	params.Beta = beta
+	params.ToTVecSize = in.MatPerCt * lib.Rows * lib.Cols
 	eval := normalization.NewEvaluator(params, s.Evaluator, btp)
-	return eval.EvaluateEncrypted(in, lib.Cols)
+	return eval.EvaluateEncrypted(in.Cts, lib.Cols)
 }
 
 func (s *Server) Norm1Approximate(in []*mat.Dense) (Min, Max float64) {
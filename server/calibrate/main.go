@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"app/client"
+	"app/lib"
+	"app/server"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	weights   = flag.String("weights", "./weights", "weights path")
+	samples   = flag.String("i", "./data/example_AA_sequences.list", "input path of the real samples")
+	synthetic = flag.Int("synthetic", 100, "number of synthetic samples (0 to disable)")
+	fuzzy     = flag.Int("fuzzy", 100, "number of fuzzed samples (0 to disable)")
+	margin    = flag.Float64("margin", 0.1, "relative safety margin applied to the observed ranges")
+)
+
+func main() {
+
+	flag.Parse()
+
+	s := server.NewServer(*weights, lib.NumCPU)
+
+	// The output softmax records the ranges of its exp(x) and 1/x.
+	s.Probabilities = true
+	c := new(client.Client)
+
+	sources := []struct {
+		name string
+		load func() ([]*mat.Dense, error)
+	}{
+		{"Samples", func() (X []*mat.Dense, err error) {
+			X, _, err = c.Load(*samples, lib.SamplesStart, lib.SamplesEnd)
+			return
+		}},
+		{"Synthetic", func() ([]*mat.Dense, error) {
+			if *synthetic == 0 {
+				return nil, nil
+			}
+			return c.LoadSynthetic(*samples, *synthetic)
+		}},
+		{"Fuzzing", func() ([]*mat.Dense, error) {
+			if *fuzzy == 0 {
+				return nil, nil
+			}
+			return c.LoadFuzzy(*fuzzy)
+		}},
+	}
+
+	ranges := server.NewRanges()
+
+	for _, src := range sources {
+
+		X, err := src.load()
+		if err != nil {
+			panic(fmt.Errorf("%s: %w", src.name, err))
+		}
+
+		if len(X) == 0 {
+			continue
+		}
+
+		r := server.NewRanges()
+		s.Ranges = &r
+		s.RunApproximate(X)
+
+		fmt.Printf("======== %s\n", src.name)
+		fmt.Print(r)
+
+		ranges.Merge(r)
+	}
+
+	fmt.Println()
+
+	ranges.Print(*margin)
+}
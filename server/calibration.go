@@ -0,0 +1,293 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"app/lib"
+	"app/matrix/activation"
+	"app/matrix/argmax"
+	"app/matrix/normalization"
+	"app/matrix/softmax"
+	"app/matrix/softmax/output"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Range is the observed range of the values at the input of an approximated function.
+type Range struct {
+	Min, Max float64
+}
+
+func NewRange() Range {
+	return Range{Min: 1e300, Max: -1e300}
+}
+
+func (r *Range) Update(Min, Max float64) {
+	r.Min = min(r.Min, Min)
+	r.Max = max(r.Max, Max)
+}
+
+func (r *Range) Merge(other Range) {
+	r.Update(other.Min, other.Max)
+}
+
+// AbsMax returns max(|Min|, |Max|).
+func (r Range) AbsMax() float64 {
+	return max(math.Abs(r.Min), math.Abs(r.Max))
+}
+
+// rowSpread returns the range of max(x) - min(x) over the rows of the matrices.
+func rowSpread(in []*mat.Dense) (r Range) {
+	r = NewRange()
+	for _, m := range in {
+		rows, _ := m.Dims()
+		for i := range rows {
+			row := m.RawRowView(i)
+			spread := slices.Max(row) - slices.Min(row)
+			r.Update(spread, spread)
+		}
+	}
+	return
+}
+
+// Ranges are the observed ranges at the input of each
+// approximated function of the circuit, recorded by
+// Server.RunApproximate if Server.Ranges is not nil.
+type Ranges struct {
+	SoftMaxMax   Range // Row-wise max(x) - min(x) at the input of the softmax (inner max)
+	SoftMaxExp   Range // x - max(x) at the input of exp(x)
+	SoftMaxNorm  Range // sum(exp(x - max(x))) at the input of 1/x
+	Norm1        Range // Var[x] + epsilon at the input of 1/sqrt(x)
+	FNN          Range // x at the input of the activation
+	Norm2        Range // Var[x] + epsilon at the input of 1/sqrt(x)
+	Logits       Range // x at the input of the argmax and of the output softmax
+	LogitsSpread Range // Row-wise max(x) - min(x) of the logits (inner max)
+	OutputExp    Range // x - max(x) at the input of exp(x) of the output softmax, if Server.Probabilities
+	OutputNorm   Range // sum(exp(x - max(x))) at the input of 1/x of the output softmax, if Server.Probabilities
+}
+
+func NewRanges() Ranges {
+	return Ranges{
+		SoftMaxMax:   NewRange(),
+		SoftMaxExp:   NewRange(),
+		SoftMaxNorm:  NewRange(),
+		Norm1:        NewRange(),
+		FNN:          NewRange(),
+		Norm2:        NewRange(),
+		Logits:       NewRange(),
+		LogitsSpread: NewRange(),
+		OutputExp:    NewRange(),
+		OutputNorm:   NewRange(),
+	}
+}
+
+func (r *Ranges) Merge(other Ranges) {
+	r.SoftMaxMax.Merge(other.SoftMaxMax)
+	r.SoftMaxExp.Merge(other.SoftMaxExp)
+	r.SoftMaxNorm.Merge(other.SoftMaxNorm)
+	r.Norm1.Merge(other.Norm1)
+	r.FNN.Merge(other.FNN)
+	r.Norm2.Merge(other.Norm2)
+	r.Logits.Merge(other.Logits)
+	r.LogitsSpread.Merge(other.LogitsSpread)
+	r.OutputExp.Merge(other.OutputExp)
+	r.OutputNorm.Merge(other.OutputNorm)
+}
+
+// String returns the ranges, one per line.
+func (r Ranges) String() string {
+	var sb strings.Builder
+	for _, rg := range []struct {
+		name string
+		Range
+	}{
+		{"SoftMaxMax", r.SoftMaxMax},
+		{"SoftMaxExp", r.SoftMaxExp},
+		{"SoftMaxNorm", r.SoftMaxNorm},
+		{"Norm1", r.Norm1},
+		{"FNN", r.FNN},
+		{"Norm2", r.Norm2},
+		{"Logits", r.Logits},
+		{"LogitsSpread", r.LogitsSpread},
+		{"OutputSoftMaxExp", r.OutputExp},
+		{"OutputSoftMaxNorm", r.OutputNorm},
+	} {
+		fmt.Fprintf(&sb, "%s: %f %f\n", rg.name, rg.Min, rg.Max)
+	}
+	return sb.String()
+}
+
+// Print prints the parameter blocks of lib/parameters.go whose intervals
+// are derived from the ranges widened by the given relative margin (see
+// Parameters), preceded by the ranges in a comment, such that the output
+// can be pasted in lib/parameters.go.
+func (r Ranges) Print(margin float64) {
+
+	fmt.Printf("/*\n")
+	fmt.Printf("======== Calibration (margin=%.2f)\n", margin)
+	fmt.Print(r)
+	fmt.Printf("*/\n")
+
+	p := r.Parameters(margin)
+
+	sm := p.SoftMax
+
+	fmt.Printf("var SoftMaxParameters = softmax.Parameters{\n")
+	fmt.Printf("\tExpMin:      %v,\n", sm.ExpMin)
+	fmt.Printf("\tExpMax:      %v,\n", sm.ExpMax)
+	fmt.Printf("\tExpDeg:      %d,\n", sm.ExpDeg)
+	fmt.Printf("\tInvMin:      %v,\n", sm.InvMin)
+	fmt.Printf("\tInvMax:      %v,\n", sm.InvMax)
+	fmt.Printf("\tInvDeg:      %d,\n", sm.InvDeg)
+	fmt.Printf("\tInvSqrtIter: %d,\n", sm.InvSqrtIter)
+	fmt.Printf("\tMaxParameters: innermax.Parameters{\n")
+	fmt.Printf("\t\tAbsMax:       %d,\n", sm.MaxParameters.AbsMax)
+	fmt.Printf("\t\tCoeffsString: MaxSign.CoeffsString(),\n")
+	fmt.Printf("\t\tCoeffsFloat:  MaxSign.CoeffsFloat(),\n")
+	fmt.Printf("\t},\n")
+	fmt.Printf("}\n\n")
+
+	for _, norm := range []struct {
+		name string
+		p    normalization.Parameters
+	}{
+		{"Norm1Parameters", p.Norm1},
+		{"Norm2Parameters", p.Norm2},
+	} {
+		fmt.Printf("var %s = normalization.Parameters{\n", norm.name)
+		fmt.Printf("\tInvSqrtMin:      %v,\n", norm.p.InvSqrtMin)
+		fmt.Printf("\tInvSqrtMax:      %v,\n", norm.p.InvSqrtMax)
+		fmt.Printf("\tInvSqrtDeg:      %d,\n", norm.p.InvSqrtDeg)
+		fmt.Printf("\tInvSqrtIter:     %d,\n", norm.p.InvSqrtIter)
+		fmt.Printf("\tBootstrapBefore: %t,\n", norm.p.BootstrapBefore)
+		fmt.Printf("\tBootstrapAfter:  %t,\n", norm.p.BootstrapAfter)
+		fmt.Printf("\tToTVecSize:      NbMatPerCtIn * Rows * Cols,\n")
+		fmt.Printf("\tEpsilon:         %v,\n", norm.p.Epsilon)
+		fmt.Printf("\tRMSNorm:         %t,\n", norm.p.RMSNorm)
+		fmt.Printf("}\n\n")
+	}
+
+	if lib.FNNActivation.Type == activation.ReLU {
+		fmt.Printf("var ReLUParameters = relu.Parameters{\n")
+		fmt.Printf("\tCoeffsFloat:  ReLUSign.CoeffsFloat(),\n")
+		fmt.Printf("\tCoeffsString: ReLUSign.CoeffsString(),\n")
+		fmt.Printf("\tAbsMax:       %v,\n", p.FNNAbsMax)
+		fmt.Printf("}\n\n")
+	} else {
+		fmt.Printf("var FNNActivation = activation.Parameters{\n")
+		fmt.Printf("\tType:   activation.Type(%q),\n", lib.FNNActivation.Type)
+		fmt.Printf("\tAbsMax: %v,\n", p.FNNAbsMax)
+		fmt.Printf("\tDeg:    %d,\n", lib.FNNActivation.Deg)
+		fmt.Printf("}\n\n")
+	}
+
+	out := p.OutputSoftMax
+
+	fmt.Printf("var OutputSoftMaxParameters = output.Parameters{\n")
+	fmt.Printf("\tK:      Classes,\n")
+	fmt.Printf("\tStride: Cols,\n")
+	fmt.Printf("\tExpMin: %v,\n", out.ExpMin)
+	fmt.Printf("\tExpMax: %v,\n", out.ExpMax)
+	fmt.Printf("\tExpDeg: %d,\n", out.ExpDeg)
+	fmt.Printf("\tInvMin: %v,\n", out.InvMin)
+	fmt.Printf("\tInvMax: %v,\n", out.InvMax)
+	fmt.Printf("\tInvDeg: %d,\n", out.InvDeg)
+	fmt.Printf("\tMin:    %v,\n", out.Min)
+	fmt.Printf("\tMax:    %v,\n", out.Max)
+	fmt.Printf("\tMaxParameters: innermax.Parameters{\n")
+	fmt.Printf("\t\tAbsMax:       %d,\n", out.MaxParameters.AbsMax)
+	fmt.Printf("\t\tCoeffsString: MaxSign.CoeffsString(),\n")
+	fmt.Printf("\t\tCoeffsFloat:  MaxSign.CoeffsFloat(),\n")
+	fmt.Printf("\t},\n")
+	fmt.Printf("}\n\n")
+
+	am := p.Argmax
+
+	fmt.Printf("var ArgmaxParameters = argmax.Parameters{\n")
+	fmt.Printf("\tK:         Classes,\n")
+	fmt.Printf("\tStride:    Cols,\n")
+	fmt.Printf("\tTopK:      %d,\n", am.TopK)
+	fmt.Printf("\tThreshold: %v,\n", am.Threshold)
+	fmt.Printf("\tMin:       %v,\n", am.Min)
+	fmt.Printf("\tMax:       %v,\n", am.Max)
+	fmt.Printf("\tMaxParameters: innermax.Parameters{\n")
+	fmt.Printf("\t\tAbsMax:       %d,\n", am.MaxParameters.AbsMax)
+	fmt.Printf("\t\tCoeffsString: ReLUSign.CoeffsString(),\n")
+	fmt.Printf("\t\tCoeffsFloat:  ReLUSign.CoeffsFloat(),\n")
+	fmt.Printf("\t},\n")
+	fmt.Printf("}\n")
+}
+
+// CalibratedParameters are the parameters of the approximated functions of
+// the circuit whose intervals are derived from calibrated Ranges.
+type CalibratedParameters struct {
+	SoftMax       softmax.Parameters
+	Norm1         normalization.Parameters
+	Norm2         normalization.Parameters
+	OutputSoftMax output.Parameters
+	Argmax        argmax.Parameters
+	FNNAbsMax     float64 // ReLUParameters.AbsMax for ReLU, FNNActivation.AbsMax otherwise
+}
+
+// Parameters returns the parameters of lib whose intervals are replaced by
+// the ranges widened by the given relative margin: the intervals of exp(x)
+// by margin times their width on each side, the other ones by a factor
+// (1 + margin).
+func (r Ranges) Parameters(margin float64) (p CalibratedParameters) {
+
+	p.SoftMax = lib.SoftMaxParameters
+
+	expWidth := r.SoftMaxExp.Max - r.SoftMaxExp.Min
+
+	p.SoftMax.ExpMin = math.Floor(r.SoftMaxExp.Min - margin*expWidth)
+	p.SoftMax.ExpMax = math.Ceil(r.SoftMaxExp.Max + margin*expWidth)
+	p.SoftMax.InvMin = floorPositive(r.SoftMaxNorm.Min / (1 + margin))
+	p.SoftMax.InvMax = math.Ceil(r.SoftMaxNorm.Max * (1 + margin))
+	p.SoftMax.MaxParameters.AbsMax = int(math.Ceil(r.SoftMaxMax.Max * (1 + margin)))
+
+	p.Norm1 = lib.Norm1Parameters
+	p.Norm1.InvSqrtMin = floorPositive(r.Norm1.Min / (1 + margin))
+	p.Norm1.InvSqrtMax = math.Ceil(r.Norm1.Max * (1 + margin))
+
+	p.Norm2 = lib.Norm2Parameters
+	p.Norm2.InvSqrtMin = floorPositive(r.Norm2.Min / (1 + margin))
+	p.Norm2.InvSqrtMax = math.Ceil(r.Norm2.Max * (1 + margin))
+
+	p.OutputSoftMax = lib.OutputSoftMaxParameters
+
+	outExpWidth := r.OutputExp.Max - r.OutputExp.Min
+
+	// The padding of the output softmax is the lower bound of the logits, so that
+	// its comparisons and exp(x) span the whole range of the logits.
+	logitsWidth := r.Logits.Max - r.Logits.Min
+
+	p.OutputSoftMax.Min = math.Floor(r.Logits.Min - margin*logitsWidth)
+	p.OutputSoftMax.Max = math.Ceil(r.Logits.Max + margin*logitsWidth)
+	p.OutputSoftMax.MaxParameters.AbsMax = int(p.OutputSoftMax.Max - p.OutputSoftMax.Min)
+	p.OutputSoftMax.ExpMin = min(math.Floor(r.OutputExp.Min-margin*outExpWidth), -float64(p.OutputSoftMax.MaxParameters.AbsMax))
+	p.OutputSoftMax.ExpMax = math.Ceil(r.OutputExp.Max + margin*outExpWidth)
+	p.OutputSoftMax.InvMin = floorPositive(r.OutputNorm.Min / (1 + margin))
+	p.OutputSoftMax.InvMax = math.Ceil(r.OutputNorm.Max * (1 + margin))
+
+	// Same for the argmax.
+	p.Argmax = lib.ArgmaxParameters
+	p.Argmax.Min = p.OutputSoftMax.Min
+	p.Argmax.Max = p.OutputSoftMax.Max
+	p.Argmax.MaxParameters.AbsMax = int(p.Argmax.Max - p.Argmax.Min)
+
+	p.FNNAbsMax = math.Ceil(r.FNN.AbsMax() * (1 + margin))
+
+	return
+}
+
+// floorPositive rounds x down to an integer if x >= 1,
+// since 1/x and 1/sqrt(x) are not defined at zero.
+func floorPositive(x float64) float64 {
+	if x >= 1 {
+		return math.Floor(x)
+	}
+	return x
+}
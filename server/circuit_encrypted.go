@@ -3,89 +3,193 @@ package server
 import (
 	"fmt"
 
+	"app/matrix"
+
 	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
-func (s *Server) RunEncrypted(in []rlwe.Ciphertext, btp he.Bootstrapper[rlwe.Ciphertext]) (out []rlwe.Ciphertext, err error) {
+// RunEncrypted evaluates the encrypted circuit on the given number of
+// samples packed in order with [Server.MergedLayout], the last ciphertext being
+// possibly underfilled, and returns the encrypted logits with their layout,
+// whose order of the samples is not the input order (see RunEncryptedTensor).
+func (s *Server) RunEncrypted(in []rlwe.Ciphertext, samples int, btp he.Bootstrapper[rlwe.Ciphertext]) (out *matrix.Tensor, err error) {
+
+	var t *matrix.Tensor
+	if t, err = matrix.NewTensor(s.MergedLayout(samples), in); err != nil {
+		return nil, fmt.Errorf("[matrix].NewTensor: %w", err)
+	}
+
+	return s.RunEncryptedTensor(t, btp)
+}
+
+// RunEncryptedTensor evaluates the encrypted circuit on a batch of
+// lib.Rows x lib.Cols samples and returns the encrypted logits with
+// their layout (see [OutputLayout]), which can be decrypted in the
+// order of the samples with [matrix.Decryptor.DecryptTensorNew].
+// If s.Packing is set, the input must have its packing at Embed (see
+// MergedLayout), the split and the merge of the heads convert to its
+// packing of the heads and back, and the samples are repacked as planned
+// before Norm1, after the bootstrapping that follows the attention.
+func (s *Server) RunEncryptedTensor(in *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (out *matrix.Tensor, err error) {
+
+	if err = s.CheckPacking(s.Evaluator.Evaluators[0].Parameters()); err != nil {
+		return nil, fmt.Errorf("[Packing]: %w", err)
+	}
+
+	if err = in.Expect(s.MergedLayout(len(in.Samples))); err != nil {
+		return nil, fmt.Errorf("[Input]: %w", err)
+	}
+
+	// The levels are counted above btp.MinimumInputLevel(), e.g. 1 for the
+	// client-aided refresh, so that the ciphertexts are never bootstrapped
+	// below it: Q x K^T ends at m instead of 0 and the stages which
+	// bootstrap at the end of their levels keep m spare levels.
+	m := btp.MinimumInputLevel()
+
+	// The flooding of the sanitization leaves no precision to the logits
+	if s.Sanitizer != nil && !s.Argmax {
+		return nil, fmt.Errorf("[Sanitize]: the sanitization requires the argmax")
+	}
 
-	if out, err = s.EmbedEncrypted(in); err != nil {
+	if out, err = s.EmbedTensor(in); err != nil {
 		return nil, fmt.Errorf("[Embed]: %w", err)
 	}
 
-	if err = s.PositionalEncodingEncrypted(out, out); err != nil {
+	if err = s.PositionalEncodingTensor(out); err != nil {
 		return nil, fmt.Errorf("[PositionalEncoding]: %w", err)
 	}
 
-	var Q, K, V []rlwe.Ciphertext
+	var QT, KT, VT *matrix.Tensor
 
-	if Q, K, V, err = s.QKVEncrypted(out); err != nil {
+	if QT, KT, VT, err = s.QKVTensor(out, m); err != nil {
 		return nil, fmt.Errorf("[QKV]: %w", err)
 	}
 
-	if err = s.SplitHeadsEncrypted(Q, K, V); err != nil {
+	if err = s.SplitHeadsTensor(QT, KT, VT); err != nil {
 		return nil, fmt.Errorf("[SplitHeads]: %w", err)
 	}
 
-	if err = s.QMulKTEncrypted(Q, K, Q); err != nil {
+	if err = s.QMulKTTensor(QT, KT); err != nil {
 		return nil, fmt.Errorf("[QMulKT]: %w", err)
 	}
 
-	if Q, err = btp.BootstrapMany(Q); err != nil {
+	if QT.Cts, err = btp.BootstrapMany(QT.Cts); err != nil {
 		return nil, fmt.Errorf("[BootstrapMany]: %w", err)
 	}
 
-	if err = s.SoftMaxEncrypted(Q, btp); err != nil {
+	if err = s.SoftMaxTensor(QT, btp); err != nil {
 		return nil, fmt.Errorf("[SoftMax]: %w", err)
 	}
 
-	if err = s.QKTMulVEncrypted(Q, V, Q, btp); err != nil {
+	if err = s.QKTMulVTensor(QT, VT, btp); err != nil {
 		return nil, fmt.Errorf("[QKTMulV]: %w", err)
 	}
 
-	if err = s.MergeHeadsEncrypted(Q); err != nil {
+	if err = s.MergeHeadsTensor(QT); err != nil {
 		return nil, fmt.Errorf("[MergeHeads]: %w", err)
 	}
 
-	if err = s.CombineEncrypted(out, Q); err != nil {
+	// The values leave no spare level, so that Combine ends at 0: with
+	// m > 0, QKTMulV is also bootstrapped before it
+	if QT.Cts[0].Level() < 1+m {
+		if QT.Cts, err = btp.BootstrapMany(QT.Cts); err != nil {
+			return nil, fmt.Errorf("[BootstrapMany]: %w", err)
+		}
+	}
+
+	if err = s.CombineTensor(out, QT); err != nil {
 		return nil, fmt.Errorf("[Combine]: %w", err)
 	}
 
-	if out, err = btp.BootstrapMany(out); err != nil {
+	if out.Cts, err = btp.BootstrapMany(out.Cts); err != nil {
 		return nil, fmt.Errorf("[BootstrapMany]: %w", err)
 	}
 
-	if err = s.Norm1Encrypted(out, btp); err != nil {
+	if err = s.RepackTensor(out, "Norm1"); err != nil {
+		return nil, fmt.Errorf("[Repack]: %w", err)
+	}
+
+	if err = s.Norm1Tensor(out, btp); err != nil {
 		return nil, fmt.Errorf("[Norm1]: %w", err)
 	}
 
-	if err = s.FNNEncrypted(out, btp); err != nil {
+	if err = s.FNNTensor(out, btp); err != nil {
 		return nil, fmt.Errorf("[FNN]: %w", err)
 	}
 
-	if out[0].Level() < 3 {
-		if out, err = btp.BootstrapMany(out); err != nil {
+	// The argmax and the softmax bootstrap the logits: the pooled
+	// ciphertexts, which are underfilled if the samples do not fill
+	// lib.Rows input ciphertexts per pooled ciphertext, are first
+	// compacted so that fewer ciphertexts are bootstrapped. The pooled
+	// ciphertexts keep a level for the compaction, so that it runs before
+	// any bootstrapping of the pooled tensor, but only if it saves a
+	// ciphertext.
+	var compaction int
+	if s.Argmax || s.Probabilities {
+
+		var pooled, logits matrix.Layout
+
+		if pooled, err = s.PooledLayout(len(out.Samples)); err != nil {
+			return nil, fmt.Errorf("[PooledLayout]: %w", err)
+		}
+
+		if logits, err = s.LogitsLayout(s.Evaluator.Evaluators[0].Parameters(), len(out.Samples)); err != nil {
+			return nil, fmt.Errorf("[LogitsLayout]: %w", err)
+		}
+
+		if logits.NumCts() < pooled.NumCts() {
+			compaction = 1
+		}
+	}
+
+	// Norm2 consumes 3 levels and the pooling 1, so that the pooled
+	// ciphertexts are at 1+m+compaction for the classifier
+	if out.Cts[0].Level() < 5+m+compaction {
+		if out.Cts, err = btp.BootstrapMany(out.Cts); err != nil {
 			return nil, fmt.Errorf("[BootstrapMany]: %w", err)
 		}
 	}
 
-	if err = s.Norm2Encrypted(out, btp); err != nil {
-		return nil, fmt.Errorf("[Norm1]: %w", err)
+	if err = s.Norm2Tensor(out, btp); err != nil {
+		return nil, fmt.Errorf("[Norm2]: %w", err)
 	}
 
-	if out, err = s.PoolingEncrypted(out); err != nil {
+	if out, err = s.PoolingTensor(out, m+compaction); err != nil {
 		return nil, fmt.Errorf("[Pooling]: %w", err)
 	}
 
-	if out[0].Level() < 1 {
-		if out, err = btp.BootstrapMany(out); err != nil {
+	if compaction > 0 {
+		if _, err = s.CompactTensor(out); err != nil {
+			return nil, fmt.Errorf("[Compact]: %w", err)
+		}
+	}
+
+	if out.Cts[0].Level() < 1+m {
+		if out.Cts, err = btp.BootstrapMany(out.Cts); err != nil {
 			return nil, fmt.Errorf("[BootstrapMany]: %w", err)
 		}
 	}
 
-	if err = s.ClassifierEncrypted(out); err != nil {
+	if err = s.ClassifierTensor(out, m); err != nil {
 		return nil, fmt.Errorf("[Classifier]: %w", err)
 	}
 
+	if s.Argmax {
+		if err = s.ArgmaxTensor(out, btp); err != nil {
+			return nil, fmt.Errorf("[Argmax]: %w", err)
+		}
+	} else if s.Probabilities {
+		if err = s.OutputSoftMaxTensor(out, btp); err != nil {
+			return nil, fmt.Errorf("[OutputSoftMax]: %w", err)
+		}
+	}
+
+	if s.Sanitizer != nil {
+		if err = s.SanitizeTensor(out, btp); err != nil {
+			return nil, fmt.Errorf("[Sanitize]: %w", err)
+		}
+	}
+
 	return
 }
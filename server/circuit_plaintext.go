@@ -3,9 +3,15 @@ package server
 import (
 	"fmt"
 
+	"app/lib"
+	"app/utils"
+
 	"gonum.org/v1/gonum/mat"
 )
 
+// RunApproximate evaluates the plaintext approximate circuit and, if
+// s.Ranges is not nil, records in it the ranges at the input of each
+// approximated function (see server/calibrate).
 func (s *Server) RunApproximate(in []*mat.Dense) (out []*mat.Dense) {
 	out = s.EmbedApproximate(in)
 	s.PositionalEncodingApproximate(out, out)
@@ -13,26 +19,57 @@ func (s *Server) RunApproximate(in []*mat.Dense) (out []*mat.Dense) {
 	QSplit, KSplit, VSplit := s.SplitHeadsApproximate(Q, K, V)
 	QKTSplit := s.QMulKTApproximate(QSplit, KSplit)
 
+	if s.Ranges != nil {
+		s.Ranges.SoftMaxMax.Merge(rowSpread(utils.Flatten(QKTSplit)))
+	}
+
+	statsIn, statsExp, statsNorm := s.SoftMaxApproximate(QKTSplit)
+
 	if s.Debug {
-		statsIn, statsExp, statsNorm := s.SoftMaxApproximate(QKTSplit)
 		fmt.Println("SOFTMAX INPUT")
 		statsIn.Print()
 		fmt.Println("SOFTMAX EXP")
 		statsExp.Print()
 		fmt.Println("SOFTMAX Norm")
 		statsNorm.Print()
-	} else {
-		s.SoftMaxApproximate(QKTSplit)
+	}
+
+	if s.Ranges != nil {
+		for i := range statsExp.Min {
+			s.Ranges.SoftMaxExp.Update(statsExp.Min[i]+lib.SoftMaxParameters.ExpOffset, statsExp.Max[i]+lib.SoftMaxParameters.ExpOffset)
+			s.Ranges.SoftMaxNorm.Update(statsNorm.Min[i], statsNorm.Max[i])
+		}
 	}
 
 	QKTVSplit := s.QKTMulVApproximate(QKTSplit, VSplit)
 	QKTV := s.MergeHeadsApproximate(QKTVSplit)
 	s.CombineApproximate(out, QKTV)
-	s.Norm1Approximate(out)
-	s.FNNApproximate(out)
-	s.Norm2Approximate(out)
+	norm1Min, norm1Max := s.Norm1Approximate(out)
+	fnnMin, fnnMax := s.FNNApproximate(out)
+	norm2Min, norm2Max := s.Norm2Approximate(out)
 	out = s.PoolingApproximate(out)
-	return s.ClassifierApproximate(out)
+	out = s.ClassifierApproximate(out)
+
+	if s.Ranges != nil {
+		s.Ranges.Norm1.Update(norm1Min, norm1Max)
+		s.Ranges.FNN.Update(fnnMin, fnnMax)
+		s.Ranges.Norm2.Update(norm2Min, norm2Max)
+		for i := range out {
+			s.Ranges.Logits.Update(mat.Min(out[i]), mat.Max(out[i]))
+		}
+		s.Ranges.LogitsSpread.Merge(rowSpread(out))
+	}
+
+	if s.Argmax {
+		s.ArgmaxApproximate(out)
+	} else if s.Probabilities {
+		ExpMin, ExpMax, InvMin, InvMax := s.OutputSoftMaxApproximate(out)
+		if s.Ranges != nil {
+			s.Ranges.OutputExp.Update(ExpMin, ExpMax)
+			s.Ranges.OutputNorm.Update(InvMin, InvMax)
+		}
+	}
+	return
 }
 
 func (s *Server) RunExact(in []*mat.Dense) (out []*mat.Dense) {
@@ -49,7 +86,13 @@ func (s *Server) RunExact(in []*mat.Dense) (out []*mat.Dense) {
 	s.FNNExact(out)
 	s.Norm2Exact(out)
 	out = s.PoolingExact(out)
-	return s.ClassifierExact(out)
+	out = s.ClassifierExact(out)
+	if s.Argmax {
+		s.ArgmaxExact(out)
+	} else if s.Probabilities {
+		s.OutputSoftMaxExact(out)
+	}
+	return
 }
 
 func (s *Server) UpToEmbed(in []*mat.Dense) (out []*mat.Dense) {
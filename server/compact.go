@@ -0,0 +1,134 @@
+package server
+
+import (
+	"fmt"
+
+	"app/matrix"
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+)
+
+// CompactTensor packs the samples of the underfilled ciphertexts of in, e.g.
+// those of the pooling, into as few ciphertexts as possible (see
+// matrix.Layout.Compact), so that the next bootstrappings run on fewer
+// ciphertexts. It consumes a level and returns false without evaluating
+// anything if in is at level 0 or if the compaction saves no ciphertext.
+func (s *Server) CompactTensor(in *matrix.Tensor) (compacted bool, err error) {
+
+	params := s.Evaluator.Evaluators[0].Parameters()
+
+	if in.Cts[0].Level() < 1 {
+		return false, nil
+	}
+
+	var c *matrix.Conversion
+	if c, err = CompactConversion(params, in.Layout); err != nil || c == nil {
+		return false, err
+	}
+
+	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
+		if err = s.KeyManager.LoadGaloisKeys(c.GaloisElements(params)); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
+		s.SetKeys(s.KeyManager)
+		return
+	}); err != nil {
+		return
+	}
+
+	var lts []*he.LinearTransformation
+	if err = utils.LoadWithBench("Encode Compaction", func() (err error) {
+		lts, err = s.NewConversionLinearTransformations(in.Cts[0].Level(), in.Cts[0].Scale, in.Cts[0].Scale, c)
+		return
+	}); err != nil {
+		return
+	}
+
+	if err = utils.RunWithBench("Compact", func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = in.Cts[0].Level()
+		LogScaleIn = in.Cts[0].LogScale()
+
+		var out *matrix.Tensor
+		if out, err = s.Convert(in, c, lts); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][Convert]: %w", err)
+		}
+
+		if err = s.Rescale(out.Cts, out.Cts); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][out,out]: %w", err)
+		}
+
+		*in = *out
+
+		LevelOut = in.Cts[0].Level()
+		LogScaleOut = in.Cts[0].LogScale()
+
+		return
+
+	}); err != nil {
+		return
+	}
+
+	return true, nil
+}
+
+// CompactConversion returns the conversion of CompactTensor on a tensor
+// of the given layout, or nil if the compaction saves no ciphertext.
+func CompactConversion(params hefloat.Parameters, in matrix.Layout) (c *matrix.Conversion, err error) {
+
+	layout := in.Compact(params)
+
+	if layout.NumCts() >= in.NumCts() {
+		return nil, nil
+	}
+
+	if c, err = matrix.NewConversion(params, in, layout, 1); err != nil {
+		return nil, fmt.Errorf("[matrix].NewConversion: %w", err)
+	}
+
+	return
+}
+
+// PooledLayout returns the layout of the output of the pooling of n samples,
+// with the number of samples per ciphertext of s.Packing at the pooling.
+func (s *Server) PooledLayout(n int) (out matrix.Layout, err error) {
+	if out, err = packedLayout(s.SamplesPerCt("Pooling"), n).Pool(); err != nil {
+		return out, fmt.Errorf("[matrix.Layout].Pool: %w", err)
+	}
+	return
+}
+
+// LogitsLayout returns the layout of the logits of n samples before the
+// classifier when RunEncryptedTensor compacts them, i.e. with Argmax or
+// Probabilities and a level left after the pooling.
+func (s *Server) LogitsLayout(params hefloat.Parameters, n int) (out matrix.Layout, err error) {
+
+	if out, err = s.PooledLayout(n); err != nil {
+		return
+	}
+
+	if compact := out.Compact(params); compact.NumCts() < out.NumCts() {
+		out = compact
+	}
+
+	return
+}
+
+// CompactGaloisElements returns the Galois elements of the compaction of a
+// tensor of the given layout (see CompactTensor), none if it saves no
+// ciphertext, e.g. the layout returned by PooledLayout.
+func (s *Server) CompactGaloisElements(params hefloat.Parameters, in matrix.Layout) (galEls []uint64) {
+
+	c, err := CompactConversion(params, in)
+	if err != nil {
+		panic(err)
+	}
+
+	if c == nil {
+		return
+	}
+
+	return c.GaloisElements(params)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"app/server"
+)
+
+var weights = flag.String("weights", "./weights", "weights path")
+
+// Fits the embedding polynomials on weights/embedding.csv and writes their
+// coefficients and key in the weights path (see server.GetEmbeddingCoefficients).
+func main() {
+
+	flag.Parse()
+
+	now := time.Now()
+
+	if err := server.WriteEmbeddingCoefficients(*weights); err != nil {
+		panic(fmt.Errorf("[server.WriteEmbeddingCoefficients]: %w", err))
+	}
+
+	fmt.Printf("%s/embedding_coefficients.csv: %s\n", *weights, time.Since(now))
+}
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+
+	"app/lib"
+	"app/matrix"
+	"app/utils"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/he/hefloat"
+)
+
+// PackingBootstrapCost is the approximate cost of a bootstrapping,
+// in key-switches, used to price the stages and the repackings.
+const PackingBootstrapCost = 128.0
+
+// PackingWeights are the costs, in key-switches, of the operations of
+// a repacking. A consumed level is priced as the fraction of a
+// bootstrapping that restores it.
+var PackingWeights = matrix.PackingWeights{
+	Rotation: 1,
+	PtMul:    1.0 / 32,
+	Add:      1.0 / 64,
+	Level:    PackingBootstrapCost / lib.LevelBootstrapping,
+}
+
+// PackingStages returns the stages of RunEncryptedTensor up to the pooling,
+// which packs lib.Rows ciphertexts together and thus ends the chain.
+// The cost of a stage is estimated, per ciphertext, as one key-switch per
+// Galois element of the stage plus one for the remaining operations, or
+// PackingBootstrapCost for the bootstrappings.
+//
+// The packing of the input is chosen by the optimizer. The split of the
+// heads converts it to any packing of the heads within its level, and the
+// merge back to the packing of the residual, which Combine adds: both are
+// priced as their conversion. Norm1 can moreover be repacked, since the
+// bootstrapping before it leaves a spare level, whereas the other stages
+// use all the levels of the schedule or, as SoftMax, are followed by a
+// product with a tensor of the same packing.
+func (s *Server) PackingStages(params hefloat.Parameters) (stages []matrix.PackingStage, err error) {
+
+	merged := matrix.NewLayout(lib.Rows, lib.Cols, 0, 1, 1)
+
+	var split, qkt matrix.Layout
+
+	if split, err = s.SplitLayout(merged); err != nil {
+		return
+	}
+
+	if qkt, err = split.Resize(s.Attention.HeadDims, 0); err != nil {
+		return nil, fmt.Errorf("[matrix.Layout].Resize: %w", err)
+	}
+
+	cost := func(galEls []uint64) float64 {
+		return float64(1 + len(galEls))
+	}
+
+	return []matrix.PackingStage{
+		{Name: "Embed", In: merged, Out: merged, Cost: 1},
+		{Name: "PositionalEnc", In: merged, Out: merged, Cost: 1},
+		{Name: "QKV", In: merged, Out: merged, Cost: cost(s.QKVGaloisElements(params)) + 2},
+		// Q, K and V are split together.
+		{Name: "SplitHeads", In: merged, Out: split, Tensors: 3, Converts: true},
+		{Name: "QMulKT", In: split, Out: qkt, Cost: cost(s.TransposeGaloisElements(params)) + cost(s.QMulKTGaloisElements(params))},
+		{Name: "Bootstrap", In: qkt, Out: qkt, Cost: PackingBootstrapCost},
+		{Name: "SoftMax", In: qkt, Out: qkt, Cost: cost(s.SoftMaxGaloisElements(params))},
+		{Name: "QKTMulV", In: qkt, Out: split, Cost: cost(s.QMulKTMulVGaloisElements(params))},
+		{Name: "MergeHeads", In: split, Out: merged, Converts: true},
+		// Set by OptimizePacking to the packing of the residual, i.e. of Embed.
+		{Name: "Combine", In: merged, Out: merged, Cost: cost(s.CombineGaloisElements(params))},
+		{Name: "Bootstrap", In: merged, Out: merged, Cost: PackingBootstrapCost},
+		{Name: "Norm1", In: merged, Out: merged, Cost: cost(s.NormalizationGaloisElements(params)), Levels: 1},
+		{Name: "FNN", In: merged, Out: merged, Cost: cost(s.FNNGaloisElements(params))},
+		{Name: "Norm2", In: merged, Out: merged, Cost: cost(s.NormalizationGaloisElements(params))},
+		{Name: "Pooling", In: merged, Out: merged, Cost: cost(s.PoolingGaloisElements(params))},
+	}, nil
+}
+
+// OptimizePacking returns the packing of each stage of RunEncryptedTensor
+// that minimizes the estimated cost on lib.NbSamples samples, among those
+// where Combine has the packing of Embed, since it adds the residual.
+// It returns an error if the heads of a sample do not fit in a ciphertext.
+func (s *Server) OptimizePacking(params hefloat.Parameters) (plan *matrix.PackingPlan, err error) {
+
+	if s.Attention.HeadMatPerCt < s.Attention.Heads {
+		return nil, fmt.Errorf("invalid heads: the %d heads of a sample span several ciphertexts", s.Attention.Heads)
+	}
+
+	var stages []matrix.PackingStage
+	if stages, err = s.PackingStages(params); err != nil {
+		return
+	}
+
+	embed := slices.IndexFunc(stages, func(st matrix.PackingStage) bool { return st.Name == "Embed" })
+	combine := slices.IndexFunc(stages, func(st matrix.PackingStage) bool { return st.Name == "Combine" })
+
+	for p := 1; p <= min(matrix.MaxSamplesPerCt(params, stages[embed].In), lib.NbSamples); p++ {
+
+		stages[embed].SamplesPerCt = p
+		stages[combine].SamplesPerCt = p
+
+		var candidate *matrix.PackingPlan
+		if candidate, err = matrix.OptimizePacking(params, stages, lib.NbSamples, PackingWeights); err != nil {
+			return nil, fmt.Errorf("[matrix].OptimizePacking: %w", err)
+		}
+
+		if plan == nil || candidate.Cost < plan.Cost {
+			plan = candidate
+		}
+	}
+
+	return
+}
+
+// CheckPacking returns an error if s.Packing, when set, is not a plan of the
+// stages of PackingStages that only repacks Norm1 and where Combine has the
+// packing of Embed, as applied by RunEncryptedTensor.
+func (s *Server) CheckPacking(params hefloat.Parameters) (err error) {
+
+	if s.Packing == nil {
+		return
+	}
+
+	var stages []matrix.PackingStage
+	if stages, err = s.PackingStages(params); err != nil {
+		return
+	}
+
+	if len(s.Packing.Stages) != len(stages) {
+		return fmt.Errorf("invalid packing: %d stages but RunEncryptedTensor has %d", len(s.Packing.Stages), len(stages))
+	}
+
+	for k := range stages {
+
+		if s.Packing.Stages[k].Name != stages[k].Name || s.Packing.Stages[k].Converts != stages[k].Converts {
+			return fmt.Errorf("invalid packing: stage %d is %s but should be %s", k, s.Packing.Stages[k].Name, stages[k].Name)
+		}
+
+		if s.Packing.Repack[k] && stages[k].Name != "Norm1" {
+			return fmt.Errorf("invalid packing: stage %s cannot be repacked", stages[k].Name)
+		}
+	}
+
+	if p, q := s.SamplesPerCt("Embed"), s.SamplesPerCt("Combine"); p != q {
+		return fmt.Errorf("invalid packing: Combine has %d samples per ciphertext but its residual %d", q, p)
+	}
+
+	return
+}
+
+// SamplesPerCt returns the number of samples per ciphertext at the input of
+// the first stage with the given name of s.Packing, or lib.NbMatPerCtIn if
+// s.Packing is nil or has no such stage.
+func (s *Server) SamplesPerCt(stage string) int {
+	if k := s.packingStage(stage); k >= 0 {
+		return s.Packing.SamplesPerCt(k)
+	}
+	return lib.NbMatPerCtIn
+}
+
+// packingStage returns the index of the first stage with
+// the given name of s.Packing, or -1 if there is none.
+func (s *Server) packingStage(stage string) int {
+	if s.Packing == nil {
+		return -1
+	}
+	return slices.IndexFunc(s.Packing.Stages, func(st matrix.PackingStage) bool {
+		return st.Name == stage
+	})
+}
+
+// RepackTensor converts in to the packing of s.Packing at the input of the
+// given stage if this stage is repacked, and leaves it unchanged otherwise.
+// A repacking consumes a level.
+func (s *Server) RepackTensor(in *matrix.Tensor, stage string) (err error) {
+
+	k := s.packingStage(stage)
+
+	if k < 0 || !s.Packing.Repack[k] {
+		return
+	}
+
+	params := s.Evaluator.Evaluators[0].Parameters()
+
+	var c *matrix.Conversion
+	if c, err = s.Packing.Conversion(params, k); err != nil {
+		return fmt.Errorf("[matrix.PackingPlan].Conversion: %w", err)
+	}
+
+	if err = utils.LoadWithBench("Load GaloisKeys", func() (err error) {
+		if err = s.KeyManager.LoadGaloisKeys(c.GaloisElements(params)); err != nil {
+			return fmt.Errorf("[keys.Manager].LoadGaloisKeys: %w", err)
+		}
+		s.SetKeys(s.KeyManager)
+		return
+	}); err != nil {
+		return
+	}
+
+	var lts []*he.LinearTransformation
+	if err = utils.LoadWithBench("Load Repack "+stage, func() (err error) {
+		lts, err = s.NewConversionLinearTransformations(in.Cts[0].Level(), in.Cts[0].Scale, in.Cts[0].Scale, c)
+		return
+	}); err != nil {
+		return
+	}
+
+	return utils.RunWithBench("Repack "+stage, func() (LevelIn, LevelOut int, LogScaleIn, LogScaleOut float64, err error) {
+
+		LevelIn = in.Cts[0].Level()
+		LogScaleIn = in.Cts[0].LogScale()
+
+		var out *matrix.Tensor
+		if out, err = s.Convert(in, c, lts); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[matrix.Evaluator][Convert]: %w", err)
+		}
+
+		if err = s.Rescale(out.Cts, out.Cts); err != nil {
+			return LevelIn, LevelOut, LogScaleIn, LogScaleOut, fmt.Errorf("[Rescale][out,out]: %w", err)
+		}
+
+		*in = *out
+
+		LevelOut = in.Cts[0].Level()
+		LogScaleOut = in.Cts[0].LogScale()
+
+		return
+	})
+}
+
+// PackingGaloisElements returns the Galois elements of the repackings of s.Packing.
+func (s *Server) PackingGaloisElements(params hefloat.Parameters) (galEls []uint64) {
+
+	if s.Packing == nil {
+		return
+	}
+
+	m := map[uint64]bool{}
+	for k := range s.Packing.Stages {
+		if s.Packing.Repack[k] {
+			c, err := s.Packing.Conversion(params, k)
+			if err != nil {
+				panic(err)
+			}
+			for _, galEl := range c.GaloisElements(params) {
+				m[galEl] = true
+			}
+		}
+	}
+	galEls = maps.Keys(m)
+	slices.Sort(galEls)
+	return
+}
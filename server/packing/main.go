@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"app/lib"
+	"app/server"
+)
+
+var heads = flag.Int("heads", lib.DefaultHeads, "number of attention heads of the model")
+
+// Prints the packing of each stage of the encrypted circuit that
+// minimizes the estimated cost on lib.NbSamples samples.
+func main() {
+
+	flag.Parse()
+
+	params := lib.NewParameters()
+
+	plan, err := server.NewServerWithParameters("./weights", 1, params, *heads).OptimizePacking(params)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Samples: %d, default packing: %d samples/ct\n", lib.NbSamples, lib.NbMatPerCtIn)
+	fmt.Println(plan)
+}
@@ -0,0 +1,426 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"app/bootstrapping"
+	"app/client"
+	"app/lib"
+	"app/matrix"
+	"app/security"
+	"app/server"
+	"app/utils"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+var (
+	weights  = flag.String("weights", "./weights", "weights path")
+	input    = flag.String("i", "./data/example_AA_sequences.list", "input path")
+	samples  = flag.Int("samples", lib.NbMatPerCtIn, "number of samples of the encrypted evaluations")
+	realBtp  = flag.Bool("btp", true, "runs the encrypted evaluation with the real bootstrapper after the dry run")
+	logN     = flag.String("logn", "15", "candidate ring degrees")
+	logScale = flag.String("logscale", "40,45,50", "candidate scales")
+	logQ0    = flag.String("logq0", "60", "candidate sizes of the first prime")
+	logP     = flag.String("logp", "58x3,58x4", "candidate key-switching moduli, as bits x count")
+	hamming  = flag.String("h", "192", "candidate Hamming weights of the secret")
+	levels   = flag.String("levels", "13/12,14/13", "candidate encryption/bootstrapping levels")
+	ratio    = flag.String("ratio", "8,9,10", "candidate bootstrapping LogMessageRatio")
+	profiles = flag.String("profiles", lib.BootstrappingProfileName, "candidate bootstrapping profiles, among "+strings.Join(lib.BootstrappingProfileNames(), ", "))
+	heads    = flag.Int("heads", lib.DefaultHeads, "number of attention heads of the model")
+)
+
+// Enumerates candidate parameter sets, rejects those below lib.MinimumSecurity
+// or whose slots cannot store the input or the attention heads, evaluates the
+// remaining ones on a few samples, first with the dummy bootstrapper (dry run)
+// and then with the real bootstrapper, and prints the Pareto front of latency
+// vs. precision vs. memory.
+func main() {
+
+	flag.Parse()
+
+	candidates, err := enumerate()
+	if err != nil {
+		panic(err)
+	}
+
+	data, _, err := new(client.Client).Load(*input, lib.SamplesStart, lib.SamplesStart+*samples)
+	if err != nil {
+		panic(err)
+	}
+
+	want := server.NewServerWithParameters(*weights, lib.NumCPU, lib.NewParameters(), *heads).RunExact(data)
+
+	results := make([]*result, len(candidates))
+
+	for i, c := range candidates {
+
+		fmt.Printf("[%d/%d] %s\n", i+1, len(candidates), c)
+
+		r := &result{Configuration: c}
+		results[i] = r
+
+		if r.Security, err = check(c); err != nil {
+			r.Status = err.Error()
+			continue
+		}
+
+		if r.DryRun, err = evaluate(c, data, want, false); err != nil {
+			r.Status = fmt.Sprintf("dry run: %s", err)
+			continue
+		}
+
+		if *realBtp {
+			if r.Bootstrapped, err = evaluate(c, data, want, true); err != nil {
+				r.Status = fmt.Sprintf("bootstrapped: %s", err)
+				continue
+			}
+		}
+
+		r.Status = "ok"
+	}
+
+	pareto(results)
+
+	printTable(results)
+}
+
+// result is the outcome of the search for a candidate.
+type result struct {
+	lib.Configuration
+	Security     float64
+	Status       string
+	DryRun       evaluation
+	Bootstrapped evaluation
+	Pareto       bool
+}
+
+// evaluation is the outcome of the encrypted evaluation of the samples.
+type evaluation struct {
+	Latency  time.Duration // per sample
+	Accuracy float64       // agreement of the predicted classes with the plaintext circuit
+	LogPrec  float64       // -log2 of the mean absolute error of the logits
+	Memory   uint64        // peak heap, in bytes, from the key generation to the decryption
+}
+
+// metrics returns the evaluation with the real bootstrapper if it was run, else the dry run.
+func (r *result) metrics() evaluation {
+	if *realBtp {
+		return r.Bootstrapped
+	}
+	return r.DryRun
+}
+
+func enumerate() (candidates []lib.Configuration, err error) {
+
+	var LogN, LogScale, LogQ0, H, ratios []int
+	var LogP [][]int
+	var levelsPairs [][2]int
+
+	for _, f := range []struct {
+		name string
+		s    string
+		v    *[]int
+	}{
+		{"logn", *logN, &LogN},
+		{"logscale", *logScale, &LogScale},
+		{"logq0", *logQ0, &LogQ0},
+		{"h", *hamming, &H},
+		{"ratio", *ratio, &ratios},
+	} {
+		if *f.v, err = parseInts(f.s, ","); err != nil {
+			return nil, fmt.Errorf("invalid -%s: %w", f.name, err)
+		}
+	}
+
+	for _, s := range strings.Split(*logP, ",") {
+		var v []int
+		if v, err = parseInts(s, "x"); err != nil || len(v) != 2 {
+			return nil, fmt.Errorf("invalid -logp: %q is not bits x count", s)
+		}
+		LogP = append(LogP, slices.Repeat([]int{v[0]}, v[1]))
+	}
+
+	for _, s := range strings.Split(*levels, ",") {
+		var v []int
+		if v, err = parseInts(s, "/"); err != nil || len(v) != 2 {
+			return nil, fmt.Errorf("invalid -levels: %q is not encryption/bootstrapping", s)
+		}
+		levelsPairs = append(levelsPairs, [2]int{v[0], v[1]})
+	}
+
+	c := lib.DefaultConfiguration()
+	c.Heads = *heads
+
+	for _, c.LogN = range LogN {
+		for _, c.LogScale = range LogScale {
+			for _, c.LogQ0 = range LogQ0 {
+				for _, c.LogP = range LogP {
+					for _, c.H = range H {
+						for _, l := range levelsPairs {
+							c.LevelEncryption, c.LevelBootstrapping = l[0], l[1]
+							for _, c.LogMessageRatio = range ratios {
+								for _, c.Bootstrapping = range strings.Split(*profiles, ",") {
+									candidates = append(candidates, c)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return
+}
+
+func parseInts(s, sep string) (v []int, err error) {
+	for _, f := range strings.Split(s, sep) {
+		var i int
+		if i, err = strconv.Atoi(strings.TrimSpace(f)); err != nil {
+			return nil, err
+		}
+		v = append(v, i)
+	}
+	return
+}
+
+// check returns the estimated security of the candidate, or an error if it
+// is below lib.MinimumSecurity or if the slots are too few for the circuit.
+func check(c lib.Configuration) (lambda float64, err error) {
+
+	params, err := c.Parameters(c.LevelEncryption)
+	if err != nil {
+		return
+	}
+
+	btpParams, err := c.BootstrappingParameters()
+	if err != nil {
+		return
+	}
+
+	lambda = math.Inf(1)
+
+	for _, p := range []struct {
+		name   string
+		params rlwe.ParameterProvider
+	}{
+		{"residual", params},
+		{"bootstrapping", btpParams.BootstrappingParameters},
+	} {
+		have, _, err := security.Estimate(p.params)
+		if err != nil {
+			return 0, fmt.Errorf("%s parameters: %w", p.name, err)
+		}
+		lambda = min(lambda, have)
+	}
+
+	if lambda < lib.MinimumSecurity {
+		return lambda, fmt.Errorf("insecure: %.0f < %.0f bits", lambda, lib.MinimumSecurity)
+	}
+
+	if _, err = c.Attention(params); err != nil {
+		return
+	}
+
+	if err = lib.CheckBootstrapping(params, btpParams, c.LevelBootstrapping); err != nil {
+		return
+	}
+
+	if tot := lib.NbMatPerCtIn * lib.Rows * lib.Cols; tot > params.MaxSlots() {
+		return lambda, fmt.Errorf("invalid slots: NbMatPerCtIn * Rows * Cols = %d > slots = %d", tot, params.MaxSlots())
+	}
+
+	return
+}
+
+// evaluate runs the encrypted circuit of the candidate on data and compares
+// the decrypted logits with want. Panics of the circuit, e.g. on an
+// exhausted level budget, are returned as errors.
+func evaluate(c lib.Configuration, data, want []*mat.Dense, bootstrapped bool) (ev evaluation, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	var elapsed time.Duration
+	var have []*mat.Dense
+
+	ev.Memory, err = peakHeap(func() (err error) {
+
+		params, err := c.Parameters(c.LevelEncryption)
+		if err != nil {
+			return
+		}
+
+		sk := rlwe.NewKeyGenerator(params).GenSecretKeyNew()
+
+		var btp *bootstrapping.Bootstrapper
+
+		if bootstrapped {
+			btpParams, err := c.BootstrappingParameters()
+			if err != nil {
+				return err
+			}
+			if btp, err = bootstrapping.NewBootstrapper(lib.NumCPU, btpParams, sk); err != nil {
+				return err
+			}
+		} else {
+			paramsBtp, err := c.Parameters(c.LevelBootstrapping)
+			if err != nil {
+				return err
+			}
+			btp = bootstrapping.NewDummyBootstrapper(lib.NumCPU, paramsBtp, sk)
+		}
+
+		cl := client.NewClient(params, sk)
+
+		s := server.NewServerWithParameters(*weights, lib.NumCPU, params, c.Heads)
+		s.SetKeyManager(cl.GetKeyManager(lib.MaxConcurrentGaloisKeys, sk))
+
+		var ct *matrix.Tensor
+		if ct, err = cl.EncryptTensorNew(data, 0, lib.NbMatPerCtIn); err != nil {
+			return
+		}
+
+		now := time.Now()
+
+		if ct, err = s.RunEncryptedTensor(ct, btp); err != nil {
+			return
+		}
+
+		elapsed = time.Since(now)
+
+		have, err = cl.DecryptTensorNew(ct)
+
+		return
+	})
+
+	if err != nil {
+		return
+	}
+
+	accuracy, noise := utils.Precision(have, want)
+
+	ev.Latency = elapsed / time.Duration(len(data))
+	ev.Accuracy = accuracy
+	ev.LogPrec = -math.Log2(noise)
+
+	return
+}
+
+// peakHeap returns the peak heap allocated while f runs, above the heap allocated before.
+func peakHeap(f func() error) (peak uint64, err error) {
+
+	var m runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&m)
+	base := m.HeapAlloc
+
+	var high uint64
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		var m runtime.MemStats
+		for {
+			runtime.ReadMemStats(&m)
+			high = max(high, m.HeapAlloc)
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	// Also stops the monitoring if f panics.
+	defer func() {
+		close(done)
+		wg.Wait()
+		peak = max(high, base) - base
+	}()
+
+	return 0, f()
+}
+
+// pareto marks the successful results that are not dominated by another
+// one, i.e. that no other result is at least as fast, as precise and as
+// small, and strictly better on one of the three.
+func pareto(results []*result) {
+
+	dominates := func(a, b evaluation) bool {
+		if a.Latency > b.Latency || a.LogPrec < b.LogPrec || a.Memory > b.Memory {
+			return false
+		}
+		return a.Latency < b.Latency || a.LogPrec > b.LogPrec || a.Memory < b.Memory
+	}
+
+	for _, r := range results {
+
+		if r.Status != "ok" {
+			continue
+		}
+
+		r.Pareto = true
+
+		for _, q := range results {
+			if q.Status == "ok" && dominates(q.metrics(), r.metrics()) {
+				r.Pareto = false
+				break
+			}
+		}
+	}
+}
+
+func printTable(results []*result) {
+
+	ok := slices.DeleteFunc(slices.Clone(results), func(r *result) bool { return r.Status != "ok" })
+
+	slices.SortFunc(ok, func(a, b *result) int {
+		return cmp.Compare(a.metrics().Latency, b.metrics().Latency)
+	})
+
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Pareto\tConfiguration\tSecurity\tDry run (acc, log2 prec)\tLatency/sample\tAccuracy\tLog2 prec\tPeak heap (MB)")
+	for _, r := range ok {
+		m := r.metrics()
+		mark := ""
+		if r.Pareto {
+			mark = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%.0f\t%.2f, %.1f\t%s\t%.2f\t%.1f\t%d\n", mark, r.Configuration, r.Security, r.DryRun.Accuracy, r.DryRun.LogPrec, m.Latency.Round(time.Millisecond), m.Accuracy, m.LogPrec, m.Memory>>20)
+	}
+	w.Flush()
+
+	fmt.Println()
+
+	for _, r := range results {
+		if r.Status != "ok" {
+			fmt.Printf("Rejected %s: %s\n", r.Configuration, r.Status)
+		}
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"app/keys"
 	"app/lib"
 	"app/matrix"
+	"app/matrix/argmax"
 	"app/matrix/normalization"
 	"app/matrix/softmax"
+	"app/matrix/softmax/output"
+	"app/sanitize"
 
 	"golang.org/x/exp/maps"
 
@@ -21,12 +24,45 @@ type Server struct {
 	*matrix.Evaluator
 	*matrix.MulParameters
 	path  string
-	Sk    *rlwe.SecretKey
 	Debug bool
+
+	// Attention is the shape of the heads of the attention.
+	Attention lib.Attention
+
+	// Argmax replaces the logits by the one-hot
+	// indicator of the predicted class(es).
+	Argmax bool
+
+	// Probabilities replaces the logits by the class
+	// probabilities, ignored if Argmax is set.
+	Probabilities bool
+
+	// Sanitizer, if not nil, floods the noise of the returned
+	// ciphertexts (circuit privacy). It requires Argmax.
+	Sanitizer *sanitize.Sanitizer
+
+	// Packing, if not nil, is the number of samples per
+	// ciphertext of each stage (see OptimizePacking).
+	Packing *matrix.PackingPlan
+
+	// Ranges, if not nil, records the ranges at the input
+	// of the approximated functions of RunApproximate.
+	Ranges *Ranges
 }
 
 func NewServer(path string, threads int) *Server {
-	params := lib.NewParameters()
+	return NewServerWithParameters(path, threads, lib.NewParameters(), lib.DefaultHeads)
+}
+
+// NewServerWithParameters returns a Server evaluating the circuit with the
+// given number of attention heads on the given residual parameters, e.g.
+// those of a lib.Configuration.
+func NewServerWithParameters(path string, threads int, params hefloat.Parameters, heads int) *Server {
+
+	attention, err := lib.NewAttention(params, heads)
+	if err != nil {
+		panic(err)
+	}
 
 	evaluators := make([]*hefloat.Evaluator, threads)
 	evaluators[0] = hefloat.NewEvaluator(params, nil)
@@ -35,8 +71,9 @@ func NewServer(path string, threads int) *Server {
 	}
 
 	return &Server{
-		Evaluator: matrix.NewEvaluator(params, lib.Rows, evaluators),
+		Evaluator: matrix.NewEvaluator(params, attention.HeadDims, evaluators),
 		path:      path,
+		Attention: attention,
 	}
 }
 
@@ -44,13 +81,13 @@ func (s *Server) GaloisElements(params hefloat.Parameters) (galEls []uint64, max
 
 	m := map[uint64]bool{}
 
-	galEls = matrix.MulParametersGaloisElements(params, lib.Rows, false, false)
+	galEls = matrix.RectMulParametersGaloisElements(params, s.Attention.HeadDims)
 	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
 	for _, galEl := range galEls {
 		m[galEl] = true
 	}
 
-	galEls = matrix.TransposeGaloisElements(params, lib.Rows)
+	galEls = matrix.TransposeGaloisElements(params, s.Attention.HeadDims)
 	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
 	for _, galEl := range galEls {
 		m[galEl] = true
@@ -62,13 +99,13 @@ func (s *Server) GaloisElements(params hefloat.Parameters) (galEls []uint64, max
 		m[galEl] = true
 	}
 
-	galEls = matrix.MergeGaloisElements(params, lib.Rows, lib.Cols, lib.Split, lib.Padding)
+	galEls = s.MergeHeadsGaloisElements(params)
 	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
 	for _, galEl := range galEls {
 		m[galEl] = true
 	}
 
-	galEls = matrix.SplitGaloisElements(params, lib.Rows, lib.Cols, lib.Split, lib.Padding)
+	galEls = s.SplitHeadsGaloisElements(params)
 	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
 	for _, galEl := range galEls {
 		m[galEl] = true
@@ -80,20 +117,50 @@ func (s *Server) GaloisElements(params hefloat.Parameters) (galEls []uint64, max
 		m[galEl] = true
 	}
 
-	galEls = softmax.GaloisElements(params, lib.Rows, lib.NumCts)
+	galEls = softmax.GaloisElements(params, s.HeadParameters().SoftMaxParameters(), s.HeadParameters().NumCts(lib.NbSamples))
 	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
 	for _, galEL := range galEls {
 		m[galEL] = true
 	}
 
-	galEls = rlwe.GaloisElementsForInnerSum(params, lib.Cols, lib.Rows)
+	galEls = s.PoolingGaloisElements(params)
 	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
 	for _, galEL := range galEls {
 		m[galEL] = true
 	}
 
-	for i := 1; i < (lib.NbSamples+lib.NbMatPerCtIn-1)/lib.NbMatPerCtIn; i++ {
-		m[params.GaloisElement(-i*lib.Cols)] = true
+	galEls = s.PackingGaloisElements(params)
+	maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
+	for _, galEl := range galEls {
+		m[galEl] = true
+	}
+
+	if s.Argmax || s.Probabilities {
+
+		pooled, err := s.PooledLayout(lib.NbSamples)
+		if err != nil {
+			panic(err)
+		}
+
+		galEls = s.CompactGaloisElements(params, pooled)
+		maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
+		for _, galEl := range galEls {
+			m[galEl] = true
+		}
+	}
+
+	if s.Argmax {
+		galEls = s.ArgmaxGaloisElements(params)
+		maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
+		for _, galEl := range galEls {
+			m[galEl] = true
+		}
+	} else if s.Probabilities {
+		galEls = s.OutputSoftMaxGaloisElements(params)
+		maxconcurrentkeys = max(maxconcurrentkeys, len(galEls))
+		for _, galEl := range galEls {
+			m[galEl] = true
+		}
 	}
 
 	galEls = maps.Keys(m)
@@ -119,7 +186,11 @@ func (s *Server) QKVGaloisElements(params hefloat.Parameters) (galEls []uint64)
 
 func (s *Server) SplitHeadsGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range matrix.SplitGaloisElements(params, lib.Rows, lib.Cols, lib.Split, lib.Padding) {
+	c, err := s.SplitHeadsConversion(params)
+	if err != nil {
+		panic(err)
+	}
+	for _, galEl := range c.GaloisElements(params) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -129,7 +200,7 @@ func (s *Server) SplitHeadsGaloisElements(params hefloat.Parameters) (galEls []u
 
 func (s *Server) TransposeGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range matrix.TransposeGaloisElements(params, lib.Rows) {
+	for _, galEl := range matrix.TransposeGaloisElements(params, s.Attention.HeadDims) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -139,7 +210,7 @@ func (s *Server) TransposeGaloisElements(params hefloat.Parameters) (galEls []ui
 
 func (s *Server) QMulKTGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range matrix.MulParametersGaloisElements(params, lib.Rows, false, false) {
+	for _, galEl := range matrix.RectMulParametersGaloisElements(params, s.Attention.HeadDims) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -149,7 +220,7 @@ func (s *Server) QMulKTGaloisElements(params hefloat.Parameters) (galEls []uint6
 
 func (s *Server) SoftMaxGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range softmax.GaloisElements(params, lib.Rows, lib.NumCts) {
+	for _, galEl := range softmax.GaloisElements(params, s.HeadParameters().SoftMaxParameters(), s.HeadParameters().NumCts(lib.NbSamples)) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -159,7 +230,7 @@ func (s *Server) SoftMaxGaloisElements(params hefloat.Parameters) (galEls []uint
 
 func (s *Server) QMulKTMulVGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range matrix.MulParametersGaloisElements(params, lib.Rows, false, false) {
+	for _, galEl := range matrix.RectMulParametersGaloisElements(params, s.Attention.HeadDims) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -169,7 +240,11 @@ func (s *Server) QMulKTMulVGaloisElements(params hefloat.Parameters) (galEls []u
 
 func (s *Server) MergeHeadsGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range matrix.MergeGaloisElements(params, lib.Rows, lib.Cols, lib.Split, lib.Padding) {
+	c, err := s.MergeHeadsConversion(params)
+	if err != nil {
+		panic(err)
+	}
+	for _, galEl := range c.GaloisElements(params) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -199,7 +274,7 @@ func (s *Server) NormalizationGaloisElements(params hefloat.Parameters) (galEls
 
 func (s *Server) FNNGaloisElements(params hefloat.Parameters) (galEls []uint64) {
 	m := map[uint64]bool{}
-	for _, galEl := range matrix.DiagonalizeGaloisElements(params, lib.Cols) {
+	for _, galEl := range matrix.TiledWeightsGaloisElements(params, lib.Cols) {
 		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
@@ -208,12 +283,22 @@ func (s *Server) FNNGaloisElements(params hefloat.Parameters) (galEls []uint64)
 }
 
 func (s *Server) PoolingGaloisElements(params hefloat.Parameters) (galEls []uint64) {
+	return poolingGaloisElements(params, s.SamplesPerCt("Pooling"))
+}
+
+// poolingGaloisElements returns the Galois elements of the
+// pooling of matPerCt samples per ciphertext.
+func poolingGaloisElements(params hefloat.Parameters, matPerCt int) (galEls []uint64) {
 	m := map[uint64]bool{}
 	for _, galEL := range rlwe.GaloisElementsForInnerSum(params, lib.Cols, lib.Rows) {
 		m[galEL] = true
 	}
-	for i := 1; i < (lib.NbSamples+lib.NbMatPerCtIn-1)/lib.NbMatPerCtIn; i++ {
-		m[params.GaloisElement(-i*lib.Cols)] = true
+	c, err := PoolingConversion(params, matPerCt)
+	if err != nil {
+		panic(err)
+	}
+	for _, galEl := range c.GaloisElements(params) {
+		m[galEl] = true
 	}
 	galEls = maps.Keys(m)
 	slices.Sort(galEls)
@@ -229,3 +314,31 @@ func (s *Server) ClassifierGaloisElements(params hefloat.Parameters) (galEls []u
 	slices.Sort(galEls)
 	return
 }
+
+func (s *Server) ArgmaxGaloisElements(params hefloat.Parameters) (galEls []uint64) {
+	logits, err := s.LogitsLayout(params, lib.NbSamples)
+	if err != nil {
+		panic(err)
+	}
+	m := map[uint64]bool{}
+	for _, galEl := range argmax.GaloisElements(params, lib.ArgmaxParameters, logits.NumCts()) {
+		m[galEl] = true
+	}
+	galEls = maps.Keys(m)
+	slices.Sort(galEls)
+	return
+}
+
+func (s *Server) OutputSoftMaxGaloisElements(params hefloat.Parameters) (galEls []uint64) {
+	logits, err := s.LogitsLayout(params, lib.NbSamples)
+	if err != nil {
+		panic(err)
+	}
+	m := map[uint64]bool{}
+	for _, galEl := range output.GaloisElements(params, lib.OutputSoftMaxParameters, logits.NumCts()) {
+		m[galEl] = true
+	}
+	galEls = maps.Keys(m)
+	slices.Sort(galEls)
+	return
+}
@@ -0,0 +1,320 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+
+	"app/lib"
+	"app/matrix"
+
+	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// InputLayout returns the layout of n encrypted samples expected by RunEncryptedTensor.
+func InputLayout(n int) matrix.Layout {
+	return packedLayout(lib.NbMatPerCtIn, n)
+}
+
+// MergedLayout returns the layout of n samples from the input of
+// RunEncryptedTensor up to the split of the heads, and from their merge to
+// Combine: that of s.Packing at the input of Embed, or InputLayout if nil.
+func (s *Server) MergedLayout(n int) matrix.Layout {
+	return packedLayout(s.SamplesPerCt("Embed"), n)
+}
+
+// packedLayout returns the layout of n lib.Rows x lib.Cols
+// samples with matPerCt samples per ciphertext.
+func packedLayout(matPerCt, n int) matrix.Layout {
+	return matrix.NewLayout(lib.Rows, lib.Cols, 0, matPerCt, n)
+}
+
+// SplitLayout returns the layout of the s.Attention.Heads heads of the matrices
+// of in, each stored as a single s.Attention.HeadDims tile, with the heads per
+// ciphertext of s.Packing after the split (see HeadMatPerCt).
+func (s *Server) SplitLayout(in matrix.Layout) (out matrix.Layout, err error) {
+	if out, err = in.Split(s.Attention.Heads, 0, 0); err != nil {
+		return out, fmt.Errorf("[matrix.Layout].Split: %w", err)
+	}
+	if out, err = out.Tile(s.Attention.HeadDims); err != nil {
+		return out, fmt.Errorf("[matrix.Layout].Tile: %w", err)
+	}
+	out.MatPerCt = s.HeadMatPerCt()
+	return
+}
+
+// HeadMatPerCt returns the number of heads per ciphertext from the split to
+// the merge of the heads: those of the samples per ciphertext of s.Packing
+// at QMulKT, or s.Attention.HeadMatPerCt if s.Packing is nil.
+func (s *Server) HeadMatPerCt() int {
+	if k := s.packingStage("QMulKT"); k >= 0 {
+		return s.Packing.SamplesPerCt(k) * s.Attention.Heads
+	}
+	return s.Attention.HeadMatPerCt
+}
+
+// HeadParameters returns s.Attention with the heads per ciphertext of
+// HeadMatPerCt, e.g. for the parameters of the encrypted softmax.
+func (s *Server) HeadParameters() lib.Attention {
+	a := s.Attention
+	a.HeadMatPerCt = s.HeadMatPerCt()
+	return a
+}
+
+// OutputLayout returns the layout of the logits returned by RunEncryptedTensor for the given input layout,
+// before their compaction (see CompactTensor) with Argmax or Probabilities.
+func OutputLayout(in matrix.Layout) (out matrix.Layout, err error) {
+
+	if out, err = in.Pool(); err != nil {
+		return out, fmt.Errorf("[matrix.Layout].Pool: %w", err)
+	}
+
+	if out, err = out.Resize(lib.Classes, lib.Cols-lib.Classes); err != nil {
+		return out, fmt.Errorf("[matrix.Layout].Resize: %w", err)
+	}
+
+	return
+}
+
+// EmbedTensor returns the embedding of the samples of in (see EmbedEncrypted).
+func (s *Server) EmbedTensor(in *matrix.Tensor) (out *matrix.Tensor, err error) {
+
+	if err = in.Expect(s.MergedLayout(len(in.Samples))); err != nil {
+		return
+	}
+
+	var cts []rlwe.Ciphertext
+	if cts, err = s.EmbedEncrypted(in.Cts); err != nil {
+		return
+	}
+
+	return matrix.NewTensor(in.Layout, cts)
+}
+
+// PositionalEncodingTensor adds the positional encoding to in (see PositionalEncodingEncrypted).
+func (s *Server) PositionalEncodingTensor(in *matrix.Tensor) (err error) {
+
+	if err = in.Expect(s.MergedLayout(len(in.Samples))); err != nil {
+		return
+	}
+
+	return s.PositionalEncodingEncrypted(in.Cts, in.Cts)
+}
+
+// QKVTensor returns the queries, keys and values of in (see QKVEncrypted).
+func (s *Server) QKVTensor(in *matrix.Tensor, minLevel int) (Q, K, V *matrix.Tensor, err error) {
+
+	if err = in.Expect(s.MergedLayout(len(in.Samples))); err != nil {
+		return
+	}
+
+	var q, k, v []rlwe.Ciphertext
+	if q, k, v, err = s.QKVEncrypted(in.Cts, minLevel); err != nil {
+		return
+	}
+
+	if Q, err = matrix.NewTensor(in.Layout, q); err != nil {
+		return
+	}
+
+	if K, err = matrix.NewTensor(in.Layout, k); err != nil {
+		return
+	}
+
+	if V, err = matrix.NewTensor(in.Layout, v); err != nil {
+		return
+	}
+
+	return
+}
+
+// QMulKTTensor replaces the split matrices Q by Q x K^T (see QMulKTEncrypted).
+func (s *Server) QMulKTTensor(Q, K *matrix.Tensor) (err error) {
+
+	if err = s.checkSplit(Q, K); err != nil {
+		return
+	}
+
+	var layout matrix.Layout
+	if layout, err = Q.Resize(s.Attention.HeadDims, 0); err != nil {
+		return fmt.Errorf("[matrix.Layout].Resize: %w", err)
+	}
+
+	if err = s.QMulKTEncrypted(Q.Cts, K.Cts, Q.Cts); err != nil {
+		return
+	}
+
+	return Q.Relayout(layout)
+}
+
+// QKTMulVTensor replaces the split matrices QKT by QKT x V (see QKTMulVEncrypted).
+func (s *Server) QKTMulVTensor(QKT, V *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	want := QKT.Layout
+	want.Cols, want.Padd = s.Attention.HeadDims, 0
+
+	if err = QKT.Expect(want); err != nil {
+		return
+	}
+
+	if err = s.checkSplit(V, V); err != nil {
+		return
+	}
+
+	if !slices.Equal(QKT.Samples, V.Samples) {
+		return fmt.Errorf("invalid layout: QKT and V do not store the same samples")
+	}
+
+	if err = s.QKTMulVEncrypted(QKT.Cts, V.Cts, QKT.Cts, btp); err != nil {
+		return
+	}
+
+	return QKT.Relayout(V.Layout)
+}
+
+// SoftMaxTensor replaces the rows of the split matrices QKT by their softmax (see SoftMaxEncrypted).
+func (s *Server) SoftMaxTensor(QKT *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	var want matrix.Layout
+	if want, err = s.SplitLayout(s.MergedLayout(len(QKT.Samples))); err != nil {
+		return
+	}
+
+	if want, err = want.Resize(s.Attention.HeadDims, 0); err != nil {
+		return fmt.Errorf("[matrix.Layout].Resize: %w", err)
+	}
+
+	if err = QKT.Expect(want); err != nil {
+		return
+	}
+
+	return s.SoftMaxEncrypted(QKT.Cts, btp)
+}
+
+// CombineTensor adds the combination of the heads QKTMulV to in (see CombineEncrypted).
+func (s *Server) CombineTensor(in, QKTMulV *matrix.Tensor) (err error) {
+
+	if err = in.Expect(s.MergedLayout(len(in.Samples))); err != nil {
+		return
+	}
+
+	if err = QKTMulV.Expect(in.Layout); err != nil {
+		return
+	}
+
+	if !slices.Equal(in.Samples, QKTMulV.Samples) {
+		return fmt.Errorf("invalid layout: in and QKTMulV do not store the same samples")
+	}
+
+	return s.CombineEncrypted(in.Cts, QKTMulV.Cts)
+}
+
+// FNNTensor adds the feed-forward network of in to in (see FNNEncrypted),
+// with any number of samples per ciphertext.
+func (s *Server) FNNTensor(in *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	if err = in.Expect(packedLayout(in.MatPerCt, len(in.Samples))); err != nil {
+		return
+	}
+
+	return s.FNNEncrypted(in.Cts, btp)
+}
+
+// ClassifierTensor maps the pooled rows to the logits (see ClassifierEncrypted).
+func (s *Server) ClassifierTensor(in *matrix.Tensor, minLevel int) (err error) {
+
+	want := in.Layout
+	want.Rows, want.Cols, want.Padd = 1, lib.Cols, 0
+
+	if err = in.Expect(want); err != nil {
+		return
+	}
+
+	var layout matrix.Layout
+	if layout, err = in.Resize(lib.Classes, lib.Cols-lib.Classes); err != nil {
+		return fmt.Errorf("[matrix.Layout].Resize: %w", err)
+	}
+
+	if err = s.ClassifierEncrypted(in.Cts, minLevel); err != nil {
+		return
+	}
+
+	return in.Relayout(layout)
+}
+
+// ArgmaxTensor replaces the logits by the one-hot encoding of their argmax (see ArgmaxEncrypted).
+func (s *Server) ArgmaxTensor(in *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	if err = checkLogits(in); err != nil {
+		return
+	}
+
+	var cts []rlwe.Ciphertext
+	if cts, err = s.ArgmaxEncrypted(in.Cts, btp); err != nil {
+		return
+	}
+
+	return in.Replace(cts)
+}
+
+// OutputSoftMaxTensor replaces the logits by their softmax (see OutputSoftMaxEncrypted).
+func (s *Server) OutputSoftMaxTensor(in *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	if err = checkLogits(in); err != nil {
+		return
+	}
+
+	var cts []rlwe.Ciphertext
+	if cts, err = s.OutputSoftMaxEncrypted(in.Cts, btp); err != nil {
+		return
+	}
+
+	return in.Replace(cts)
+}
+
+// SanitizeTensor sanitizes the one-hot output of the argmax (see SanitizeEncrypted).
+func (s *Server) SanitizeTensor(in *matrix.Tensor, btp he.Bootstrapper[rlwe.Ciphertext]) (err error) {
+
+	if err = checkLogits(in); err != nil {
+		return
+	}
+
+	var cts []rlwe.Ciphertext
+	if cts, err = s.SanitizeEncrypted(in.Cts, btp); err != nil {
+		return
+	}
+
+	return in.Replace(cts)
+}
+
+// checkLogits returns an error if in is not a batch of 1 x lib.Classes logits
+// padded to lib.Cols, with any number of samples per ciphertext.
+func checkLogits(in *matrix.Tensor) (err error) {
+
+	want := in.Layout
+	want.Rows, want.Cols, want.Padd, want.PaddRows, want.Heads = 1, lib.Classes, lib.Cols-lib.Classes, 0, 1
+
+	return in.Expect(want)
+}
+
+// checkSplit returns an error if A and B are not batches of the
+// same heads of lib.Rows x s.Attention.HeadCols matrices padded to s.Attention.HeadDims.
+func (s *Server) checkSplit(A, B *matrix.Tensor) (err error) {
+
+	want, err := s.SplitLayout(s.MergedLayout(len(A.Samples)))
+	if err != nil {
+		return
+	}
+
+	for _, t := range []*matrix.Tensor{A, B} {
+		if err = t.Expect(want); err != nil {
+			return
+		}
+	}
+
+	if !slices.Equal(A.Samples, B.Samples) {
+		return fmt.Errorf("invalid layout: tensors do not store the same samples")
+	}
+
+	return
+}
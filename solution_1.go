@@ -3,15 +3,19 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"time"
 
 	"app/bootstrapping"
 	"app/client"
+	"app/keys"
 	"app/lib"
 	"app/matrix/normalization"
 	"app/matrix/relu"
 	"app/matrix/softmax"
 	"app/matrix/softmax/innermax"
+	"app/oracle"
+	"app/refresh"
 	"app/server"
 	"app/utils"
 
@@ -20,8 +24,20 @@ import (
 
 var input_path = flag.String("i", "./data/example_AA_sequences.list", "input path")
 var debug = flag.Bool("debug", false, "debug mode")
+var oracleLog = flag.String("oracle-log", "", "writes the audit log of the debug oracle to the given file instead of the standard error (requires -debug)")
 var dummy = flag.Bool("dummy", false, "uses dummy bootstrapping")
+var simulated = flag.Bool("simulated", false, "uses dummy bootstrapping with the error of the bootstrapping profile")
+var interactive = flag.Bool("interactive", false, "replaces the bootstrapping by the client-aided refresh with an in-process client")
 var verify = flag.Bool("verify", false, "verifies predictions against plaintext model")
+var argmax = flag.Bool("argmax", false, "returns the one-hot encoding of the predicted class instead of the logits")
+var probabilities = flag.Bool("probabilities", false, "returns the class probabilities instead of the logits")
+var sanitizeOutput = flag.Bool("sanitize", false, "binarizes and floods the noise of the returned argmax for circuit privacy (requires -argmax)")
+var packing = flag.Bool("packing", false, "repacks the samples between the stages as planned by server.OptimizePacking")
+var pkPath = flag.String("pk", "", "exports the public key to the given path and encrypts the input with it")
+var btpProfile = flag.String("btp-profile", lib.BootstrappingProfileName, "bootstrapping profile (balanced, precision, latency or low-memory)")
+var heads = flag.Int("heads", lib.DefaultHeads, "number of attention heads of the model, one of 1, 2, 4, 8 or 16 (the model in ./weights has 4)")
+var clientKeys = flag.String("client-keys", "", "generates the secret keys in the given directory on the first run and loads them on the next runs (requires -server-keys)")
+var serverKeys = flag.String("server-keys", "", "generates the bootstrapping keys in the given directory on the first run and loads them on the next runs (requires -client-keys)")
 
 func main() {
 
@@ -34,6 +50,12 @@ func main() {
 
 	flag.Parse()
 
+	conf := lib.DefaultConfiguration()
+	conf.Bootstrapping = *btpProfile
+	if err := conf.SetSignPolynomials(); err != nil {
+		panic(err)
+	}
+
 	now := time.Now()
 
 	params := lib.NewParameters()
@@ -47,7 +69,20 @@ func main() {
 		params.LogDefaultScale())
 
 	kgen := rlwe.NewKeyGenerator(params)
-	sk := kgen.GenSecretKeyNew()
+
+	var sk *rlwe.SecretKey
+	var err error
+	if (*clientKeys == "") != (*serverKeys == "") {
+		panic(fmt.Errorf("-client-keys and -server-keys must be set together"))
+	}
+
+	if *clientKeys != "" && !*dummy && !*simulated && !*interactive {
+		if sk, err = conf.GenBootstrappingKeys(params, *clientKeys, *serverKeys); err != nil {
+			panic(err)
+		}
+	} else {
+		sk = kgen.GenSecretKeyNew()
+	}
 
 	c := client.NewClient(params, sk)
 
@@ -61,8 +96,26 @@ func main() {
 
 	if *dummy {
 		btp = lib.NewDummyBootstrapper(params, sk)
+	} else if *simulated {
+		if btp, err = conf.NewSimulatedBootstrapper(params, sk, [32]byte{}); err != nil {
+			panic(err)
+		}
+	} else if *interactive {
+		btp = lib.NewInteractiveBootstrapper(params, refresh.NewLoopback(refresh.NewClient(params, sk)))
 	} else {
-		btp = lib.NewBootstrapper(params, sk)
+		start := time.Now()
+		if *serverKeys != "" {
+			fmt.Println("Loading Bootstrapping Keys")
+			if btp, err = conf.LoadBootstrapper(params, *serverKeys); err != nil {
+				panic(err)
+			}
+		} else {
+			fmt.Println("Generating Bootstrapping Keys")
+			if btp, err = conf.NewBootstrapper(params, sk); err != nil {
+				panic(err)
+			}
+		}
+		fmt.Printf("%s\n", time.Since(start))
 
 		paramsBTP := btp.BootstrappingParameters()
 
@@ -77,30 +130,67 @@ func main() {
 			paramsBTP.BootstrappingParameters.LogDefaultScale())
 	}
 
-	btp.Debug = *debug
+	if *debug {
 
-	s := server.NewServer("./weights", lib.NumCPU)
+		// The audit log is kept apart from the predictions on the standard output.
+		audit := os.Stderr
+		if *oracleLog != "" {
+			if audit, err = os.OpenFile(*oracleLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600); err != nil {
+				panic(err)
+			}
+			defer audit.Close()
+		}
 
-	if *debug {
-		s.Sk = sk
+		btp.Oracle = oracle.NewSecretKeyOracle(params, sk, audit)
+	}
+
+	s := server.NewServerWithParameters("./weights", lib.NumCPU, params, *heads)
+	s.Argmax = *argmax
+	s.Probabilities = *probabilities
+
+	if *packing {
+		if s.Packing, err = s.OptimizePacking(params); err != nil {
+			panic(err)
+		}
+		fmt.Println(s.Packing)
+	}
+
+	if *sanitizeOutput {
+		s.Sanitizer = lib.NewSanitizer(params, kgen.GenPublicKeyNew(sk))
 	}
 
 	km := c.GetKeyManager(lib.MaxConcurrentGaloisKeys, sk)
 
 	s.SetKeyManager(km)
 
-	ct, err := c.EncryptNew(data, 0, lib.NbMatPerCtIn)
+	// Data-collection endpoint encrypting with the exported public key
+	enc := c.Encryptor
+	if *pkPath != "" {
+		if err = keys.StorePublicKey(*pkPath, params, kgen.GenPublicKeyNew(sk)); err != nil {
+			panic(err)
+		}
+
+		pk, err := keys.LoadPublicKey(*pkPath, params, lib.MinimumSecurity)
+		if err != nil {
+			panic(err)
+		}
+
+		enc = client.NewEncryptionClient(params, pk).Encryptor
+	}
+
+	ct, err := enc.EncryptTensorNew(data, 0, s.SamplesPerCt("Embed"))
 	if err != nil {
 		panic(err)
 	}
 
-	if ct, err = s.RunEncrypted(ct, btp); err != nil {
+	if ct, err = s.RunEncryptedTensor(ct, btp); err != nil {
 		panic(err)
 	}
 
-	result, err := c.DecryptNew(ct, 1, lib.Classes, lib.Cols-lib.Classes, lib.Rows*lib.NbMatPerCtIn)
-
-	result = client.GetResults(result)
+	result, err := c.DecryptTensorNew(ct)
+	if err != nil {
+		panic(err)
+	}
 
 	if err = c.Dump("./result/pred_enc.csv", result); err != nil {
 		panic(err)
@@ -132,18 +222,8 @@ var SoftMaxParameters = softmax.Parameters{
 	InvMax:      50,
 	InvDeg:      31,
 	InvSqrtIter: 2,
-	K:           lib.Rows,
-	ToTVecSize:  lib.NbMatPerCtIn * lib.Rows * lib.Rows * lib.Split,
 	MaxParameters: innermax.Parameters{
 		AbsMax: 60,
-		CoeffsString: [][]string{
-			{"0", "0.864988119247", "0", "-0.288377920222", "0", "0.173071422437", "0", "-0.123816194144", "0", "0.096394642789", "0", "-0.078902169290", "0", "0.066945006659", "0", "-0.058020100531", "0", "0.051332266735", "0", "-0.046482123811", "0", "0.042138711193", "0", "-0.038528692000", "0", "0.035978793808", "0", "-0.033437153812", "0", "0.031680300044", "0", "-0.335319740231"},
-			{"0", "1.266504742898", "0", "-0.404596922995", "0", "0.222869371220", "0", "-0.139872558754", "0", "0.091346906097", "0", "-0.059846068329", "0", "0.038558545509", "0", "-0.024103625427", "0", "0.014456545301", "0", "-0.008227671984", "0", "0.004387825299", "0", "-0.002157400000", "0", "0.000955384598", "0", "-0.000366901589", "0", "0.000113649172", "0", "-0.000023655795"},
-		},
-		CoeffsFloat: [][]float64{
-			{0, 0.864988119247, 0, -0.288377920222, 0, 0.173071422437, 0, -0.123816194144, 0, 0.096394642789, 0, -0.078902169290, 0, 0.066945006659, 0, -0.058020100531, 0, 0.051332266735, 0, -0.046482123811, 0, 0.042138711193, 0, -0.038528692000, 0, 0.035978793808, 0, -0.033437153812, 0, 0.031680300044, 0, -0.335319740231},
-			{0, 1.266504742898, 0, -0.404596922995, 0, 0.222869371220, 0, -0.139872558754, 0, 0.091346906097, 0, -0.059846068329, 0, 0.038558545509, 0, -0.024103625427, 0, 0.014456545301, 0, -0.008227671984, 0, 0.004387825299, 0, -0.002157400000, 0, 0.000955384598, 0, -0.000366901589, 0, 0.000113649172, 0, -0.000023655795},
-		},
 	},
 }
 
@@ -161,6 +241,7 @@ var Norm1Parameters = normalization.Parameters{
 	InvSqrtIter:    1,
 	BootstrapAfter: true,
 	ToTVecSize:     lib.NbMatPerCtIn * lib.Rows * lib.Cols,
+	Epsilon:        1e-6,
 }
 
 /*
@@ -178,17 +259,11 @@ var Norm2Parameters = normalization.Parameters{
 	BootstrapBefore: true,
 	BootstrapAfter:  true,
 	ToTVecSize:      lib.NbMatPerCtIn * lib.Rows * lib.Cols,
+	Epsilon:         1e-6,
 }
 
-// hefloat.GenMinimaxCompositePolynomial(512, 10, 15, []int{255, 63}, bignum.Sign)
+// The coefficients of the ReLU and of the max of the softmax are the
+// composite polynomials of the configuration, see lib.Configuration.SetSignPolynomials.
 var ReLUParameters = relu.Parameters{
-	CoeffsFloat: [][]float64{
-		{0, 0.762624277144258, 0, -0.254211515271906, 0, 0.152531081324984, 0, -0.108955197152342, 0, 0.084747470549085, 0, -0.069343539898539, 0, 0.058680122421113, 0, -0.050860920656460, 0, 0.044882102944092, 0, -0.040162591936501, 0, 0.036342567537934, 0, -0.033187287163105, 0, 0.030537242627738, 0, -0.028280254591926, 0, 0.026334964806040, 0, -0.024640953695875, 0, 0.023152581142191, 0, -0.021834685992973, 0, 0.020659571285113, 0, -0.019605185886683, 0, 0.018653924354774, 0, -0.017791479861489, 0, 0.017005944397341, 0, -0.016287427012307, 0, 0.015627793892036, 0, -0.015020185138093, 0, 0.014458641481989, 0, -0.013938080246983, 0, 0.013454256086254, 0, -0.013003489160657, 0, 0.012582469889852, 0, -0.012188310772315, 0, 0.011818584714956, 0, -0.011471169358299, 0, 0.011144082208602, 0, -0.010835528004918, 0, 0.010544027490451, 0, -0.010268316272171, 0, 0.010007117380252, 0, -0.009759211324878, 0, 0.009523676838757, 0, -0.009299758927712, 0, 0.009086557598268, 0, -0.008883182776046, 0, 0.008689079447000, 0, -0.008503801958784, 0, 0.008326650096089, 0, -0.008156939797580, 0, 0.007994374502078, 0, -0.007838702698588, 0, 0.007689320685736, 0, -0.007545679774350, 0, 0.007407690391034, 0, -0.007275219515252, 0, 0.007147680309141, 0, -0.007024640549262, 0, 0.006906201634427, 0, -0.006792260548964, 0, 0.006682197924732, 0, -0.006575738409800, 0, 0.006473137378112, 0, -0.006374219070479, 0, 0.006278355690561, 0, -0.006185476549591, 0, 0.006095892798779, 0, -0.006009302100837, 0, 0.005925155423643, 0, -0.005843571689380, 0, 0.005764804336032, 0, -0.005688454663122, 0, 0.005614123364796, 0, -0.005542042188997, 0, 0.005472350447218, 0, -0.005404619815629, 0, 0.005338612481341, 0, -0.005274605927879, 0, 0.005212614485580, 0, -0.005152213176345, 0, 0.005093332218960, 0, -0.005036266451860, 0, 0.004980871936824, 0, -0.004926756919342, 0, 0.004874054720733, 0, -0.004823003247109, 0, 0.004773279625942, 0, -0.004724636835620, 0, 0.004677371100699, 0, -0.004631532976605, 0, 0.004586718771423, 0, -0.004542924408592, 0, 0.004500464831644, 0, -0.004459148885465, 0, 0.004418656087280, 0, -0.004379222425400, 0, 0.004340998783801, 0, -0.004303621081644, 0, 0.004267029785679, 0, -0.004231542119279, 0, 0.004197003873487, 0, -0.004163122004199, 0, 0.004130141881589, 0, -0.004098179933047, 0, 0.004066875633355, 0, -0.004036214250760, 0, 0.004006525553240, 0, -0.003977678630022, 0, 0.003949330875258, 0, -0.003921649808488, 0, 0.003894931300166, 0, -0.003868917177509, 0, 0.003843296648700, 0, -0.003818374760630, 0, 0.003794371286562, 0, -0.003770887286012, 0, 0.003747838810980, 0, -0.003725605666276, 0, 0.003703991432474, 0, -0.003682735746915, 0, 0.003662325133773, 0, -0.003642658276935, 0, 0.003622986831546, 0, -0.003603900452643, 0, 0.003586173102685, 0, -0.003568815850615, 0, 0.003551081703711, 0, -0.003533777979210, 0, 0.003518491264333, 0, -0.402770163388345},
-		{0, 1.271394952644717, 0, -0.418908924174358, 0, 0.245571575138300, 0, -0.169385755345298, 0, 0.125729582605323, 0, -0.097009562066101, 0, 0.076478923882815, 0, -0.061000626530583, 0, 0.048918551516010, 0, -0.039273241583373, 0, 0.031466063228896, 0, -0.025099035277211, 0, 0.019892095009181, 0, -0.015637742316596, 0, 0.012175062649524, 0, -0.009374380172474, 0, 0.007127999698664, 0, -0.005344544030635, 0, 0.003945451102087, 0, -0.002862771540468, 0, 0.002037737358615, 0, -0.001419770737502, 0, 0.000965726103163, 0, -0.000639239547602, 0, 0.000410113532089, 0, -0.000253701067931, 0, 0.000150277688521, 0, -0.000084404781936, 0, 0.000044296653679, 0, -0.000021207592430, 0, 0.000008855556142, 0, -0.000003056946315},
-	},
-	CoeffsString: [][]string{
-		{"0", "0.762624277144258", "0", "-0.254211515271906", "0", "0.152531081324984", "0", "-0.108955197152342", "0", "0.084747470549085", "0", "-0.069343539898539", "0", "0.058680122421113", "0", "-0.050860920656460", "0", "0.044882102944092", "0", "-0.040162591936501", "0", "0.036342567537934", "0", "-0.033187287163105", "0", "0.030537242627738", "0", "-0.028280254591926", "0", "0.026334964806040", "0", "-0.024640953695875", "0", "0.023152581142191", "0", "-0.021834685992973", "0", "0.020659571285113", "0", "-0.019605185886683", "0", "0.018653924354774", "0", "-0.017791479861489", "0", "0.017005944397341", "0", "-0.016287427012307", "0", "0.015627793892036", "0", "-0.015020185138093", "0", "0.014458641481989", "0", "-0.013938080246983", "0", "0.013454256086254", "0", "-0.013003489160657", "0", "0.012582469889852", "0", "-0.012188310772315", "0", "0.011818584714956", "0", "-0.011471169358299", "0", "0.011144082208602", "0", "-0.010835528004918", "0", "0.010544027490451", "0", "-0.010268316272171", "0", "0.010007117380252", "0", "-0.009759211324878", "0", "0.009523676838757", "0", "-0.009299758927712", "0", "0.009086557598268", "0", "-0.008883182776046", "0", "0.008689079447000", "0", "-0.008503801958784", "0", "0.008326650096089", "0", "-0.008156939797580", "0", "0.007994374502078", "0", "-0.007838702698588", "0", "0.007689320685736", "0", "-0.007545679774350", "0", "0.007407690391034", "0", "-0.007275219515252", "0", "0.007147680309141", "0", "-0.007024640549262", "0", "0.006906201634427", "0", "-0.006792260548964", "0", "0.006682197924732", "0", "-0.006575738409800", "0", "0.006473137378112", "0", "-0.006374219070479", "0", "0.006278355690561", "0", "-0.006185476549591", "0", "0.006095892798779", "0", "-0.006009302100837", "0", "0.005925155423643", "0", "-0.005843571689380", "0", "0.005764804336032", "0", "-0.005688454663122", "0", "0.005614123364796", "0", "-0.005542042188997", "0", "0.005472350447218", "0", "-0.005404619815629", "0", "0.005338612481341", "0", "-0.005274605927879", "0", "0.005212614485580", "0", "-0.005152213176345", "0", "0.005093332218960", "0", "-0.005036266451860", "0", "0.004980871936824", "0", "-0.004926756919342", "0", "0.004874054720733", "0", "-0.004823003247109", "0", "0.004773279625942", "0", "-0.004724636835620", "0", "0.004677371100699", "0", "-0.004631532976605", "0", "0.004586718771423", "0", "-0.004542924408592", "0", "0.004500464831644", "0", "-0.004459148885465", "0", "0.004418656087280", "0", "-0.004379222425400", "0", "0.004340998783801", "0", "-0.004303621081644", "0", "0.004267029785679", "0", "-0.004231542119279", "0", "0.004197003873487", "0", "-0.004163122004199", "0", "0.004130141881589", "0", "-0.004098179933047", "0", "0.004066875633355", "0", "-0.004036214250760", "0", "0.004006525553240", "0", "-0.003977678630022", "0", "0.003949330875258", "0", "-0.003921649808488", "0", "0.003894931300166", "0", "-0.003868917177509", "0", "0.003843296648700", "0", "-0.003818374760630", "0", "0.003794371286562", "0", "-0.003770887286012", "0", "0.003747838810980", "0", "-0.003725605666276", "0", "0.003703991432474", "0", "-0.003682735746915", "0", "0.003662325133773", "0", "-0.003642658276935", "0", "0.003622986831546", "0", "-0.003603900452643", "0", "0.003586173102685", "0", "-0.003568815850615", "0", "0.003551081703711", "0", "-0.003533777979210", "0", "0.003518491264333", "0", "-0.402770163388345"},
-		{"0", "1.271394952644717", "0", "-0.418908924174358", "0", "0.245571575138300", "0", "-0.169385755345298", "0", "0.125729582605323", "0", "-0.097009562066101", "0", "0.076478923882815", "0", "-0.061000626530583", "0", "0.048918551516010", "0", "-0.039273241583373", "0", "0.031466063228896", "0", "-0.025099035277211", "0", "0.019892095009181", "0", "-0.015637742316596", "0", "0.012175062649524", "0", "-0.009374380172474", "0", "0.007127999698664", "0", "-0.005344544030635", "0", "0.003945451102087", "0", "-0.002862771540468", "0", "0.002037737358615", "0", "-0.001419770737502", "0", "0.000965726103163", "0", "-0.000639239547602", "0", "0.000410113532089", "0", "-0.000253701067931", "0", "0.000150277688521", "0", "-0.000084404781936", "0", "0.000044296653679", "0", "-0.000021207592430", "0", "0.000008855556142", "0", "-0.000003056946315"},
-	},
 	AbsMax: 60,
 }
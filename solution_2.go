@@ -3,15 +3,19 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"time"
 
 	"app/bootstrapping"
 	"app/client"
+	"app/keys"
 	"app/lib"
 	"app/matrix/normalization"
 	"app/matrix/relu"
 	"app/matrix/softmax"
 	"app/matrix/softmax/innermax"
+	"app/oracle"
+	"app/refresh"
 	"app/server"
 	"app/utils"
 
@@ -20,8 +24,20 @@ import (
 
 var input_path = flag.String("i", "./data/example_AA_sequences.list", "input path")
 var debug = flag.Bool("debug", false, "debug mode")
+var oracleLog = flag.String("oracle-log", "", "writes the audit log of the debug oracle to the given file instead of the standard error (requires -debug)")
 var dummy = flag.Bool("dummy", false, "uses dummy bootstrapping")
+var simulated = flag.Bool("simulated", false, "uses dummy bootstrapping with the error of the bootstrapping profile")
+var interactive = flag.Bool("interactive", false, "replaces the bootstrapping by the client-aided refresh with an in-process client")
 var verify = flag.Bool("verify", false, "verifies predictions against plaintext model")
+var argmax = flag.Bool("argmax", false, "returns the one-hot encoding of the predicted class instead of the logits")
+var probabilities = flag.Bool("probabilities", false, "returns the class probabilities instead of the logits")
+var sanitizeOutput = flag.Bool("sanitize", false, "binarizes and floods the noise of the returned argmax for circuit privacy (requires -argmax)")
+var packing = flag.Bool("packing", false, "repacks the samples between the stages as planned by server.OptimizePacking")
+var pkPath = flag.String("pk", "", "exports the public key to the given path and encrypts the input with it")
+var btpProfile = flag.String("btp-profile", lib.BootstrappingProfileName, "bootstrapping profile (balanced, precision, latency or low-memory)")
+var heads = flag.Int("heads", lib.DefaultHeads, "number of attention heads of the model, one of 1, 2, 4, 8 or 16 (the model in ./weights has 4)")
+var clientKeys = flag.String("client-keys", "", "generates the secret keys in the given directory on the first run and loads them on the next runs (requires -server-keys)")
+var serverKeys = flag.String("server-keys", "", "generates the bootstrapping keys in the given directory on the first run and loads them on the next runs (requires -client-keys)")
 
 func main() {
 
@@ -34,6 +50,15 @@ func main() {
 
 	flag.Parse()
 
+	conf := lib.DefaultConfiguration()
+	conf.Bootstrapping = *btpProfile
+	conf.SoftMaxSign = "sign_p512_a3_e3_d15"
+	conf.ReLUSign = "sign_p512_a5_e7_d127"
+
+	if err := conf.SetSignPolynomials(); err != nil {
+		panic(err)
+	}
+
 	now := time.Now()
 
 	params := lib.NewParameters()
@@ -47,7 +72,20 @@ func main() {
 		params.LogDefaultScale())
 
 	kgen := rlwe.NewKeyGenerator(params)
-	sk := kgen.GenSecretKeyNew()
+
+	var sk *rlwe.SecretKey
+	var err error
+	if (*clientKeys == "") != (*serverKeys == "") {
+		panic(fmt.Errorf("-client-keys and -server-keys must be set together"))
+	}
+
+	if *clientKeys != "" && !*dummy && !*simulated && !*interactive {
+		if sk, err = conf.GenBootstrappingKeys(params, *clientKeys, *serverKeys); err != nil {
+			panic(err)
+		}
+	} else {
+		sk = kgen.GenSecretKeyNew()
+	}
 
 	c := client.NewClient(params, sk)
 
@@ -61,8 +99,26 @@ func main() {
 
 	if *dummy {
 		btp = lib.NewDummyBootstrapper(params, sk)
+	} else if *simulated {
+		if btp, err = conf.NewSimulatedBootstrapper(params, sk, [32]byte{}); err != nil {
+			panic(err)
+		}
+	} else if *interactive {
+		btp = lib.NewInteractiveBootstrapper(params, refresh.NewLoopback(refresh.NewClient(params, sk)))
 	} else {
-		btp = lib.NewBootstrapper(params, sk)
+		start := time.Now()
+		if *serverKeys != "" {
+			fmt.Println("Loading Bootstrapping Keys")
+			if btp, err = conf.LoadBootstrapper(params, *serverKeys); err != nil {
+				panic(err)
+			}
+		} else {
+			fmt.Println("Generating Bootstrapping Keys")
+			if btp, err = conf.NewBootstrapper(params, sk); err != nil {
+				panic(err)
+			}
+		}
+		fmt.Printf("%s\n", time.Since(start))
 
 		paramsBTP := btp.BootstrappingParameters()
 
@@ -77,30 +133,67 @@ func main() {
 			paramsBTP.BootstrappingParameters.LogDefaultScale())
 	}
 
-	btp.Debug = *debug
+	if *debug {
 
-	s := server.NewServer("./weights", lib.NumCPU)
+		// The audit log is kept apart from the predictions on the standard output.
+		audit := os.Stderr
+		if *oracleLog != "" {
+			if audit, err = os.OpenFile(*oracleLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600); err != nil {
+				panic(err)
+			}
+			defer audit.Close()
+		}
 
-	if *debug {
-		s.Sk = sk
+		btp.Oracle = oracle.NewSecretKeyOracle(params, sk, audit)
+	}
+
+	s := server.NewServerWithParameters("./weights", lib.NumCPU, params, *heads)
+	s.Argmax = *argmax
+	s.Probabilities = *probabilities
+
+	if *packing {
+		if s.Packing, err = s.OptimizePacking(params); err != nil {
+			panic(err)
+		}
+		fmt.Println(s.Packing)
+	}
+
+	if *sanitizeOutput {
+		s.Sanitizer = lib.NewSanitizer(params, kgen.GenPublicKeyNew(sk))
 	}
 
 	km := c.GetKeyManager(lib.MaxConcurrentGaloisKeys, sk)
 
 	s.SetKeyManager(km)
 
-	ct, err := c.EncryptNew(data, 0, lib.NbMatPerCtIn)
+	// Data-collection endpoint encrypting with the exported public key
+	enc := c.Encryptor
+	if *pkPath != "" {
+		if err = keys.StorePublicKey(*pkPath, params, kgen.GenPublicKeyNew(sk)); err != nil {
+			panic(err)
+		}
+
+		pk, err := keys.LoadPublicKey(*pkPath, params, lib.MinimumSecurity)
+		if err != nil {
+			panic(err)
+		}
+
+		enc = client.NewEncryptionClient(params, pk).Encryptor
+	}
+
+	ct, err := enc.EncryptTensorNew(data, 0, s.SamplesPerCt("Embed"))
 	if err != nil {
 		panic(err)
 	}
 
-	if ct, err = s.RunEncrypted(ct, btp); err != nil {
+	if ct, err = s.RunEncryptedTensor(ct, btp); err != nil {
 		panic(err)
 	}
 
-	result, err := c.DecryptNew(ct, 1, lib.Classes, lib.Cols-lib.Classes, lib.Rows*lib.NbMatPerCtIn)
-
-	result = client.GetResults(result)
+	result, err := c.DecryptTensorNew(ct)
+	if err != nil {
+		panic(err)
+	}
 
 	if err = c.Dump("./result/pred_enc.csv", result); err != nil {
 		panic(err)
@@ -139,17 +232,9 @@ var SoftMaxParameters = softmax.Parameters{
 	InvMin:      0.5,
 	InvMax:      256,
 	InvDeg:      31,
-	K:           lib.Rows,
-	ToTVecSize:  lib.NbMatPerCtIn * lib.Rows * lib.Rows * lib.Split,
 	InvSqrtIter: 2,
 	MaxParameters: innermax.Parameters{
 		AbsMax: 60,
-		CoeffsString: [][]string{
-			{"0", "1.27020217932", "0", "-0.41513217792", "0", "0.23969221445", "0", "-0.16067723908", "0", "0.11530467170", "0", "-0.08537291689", "0", "0.06375404757", "0", "-0.10285141221"},
-		},
-		CoeffsFloat: [][]float64{
-			{0, 1.27020217932, 0, -0.41513217792, 0, 0.23969221445, 0, -0.16067723908, 0, 0.11530467170, 0, -0.08537291689, 0, 0.06375404757, 0, -0.10285141221},
-		},
 	},
 }
 
@@ -167,6 +252,7 @@ var Norm1Parameters = normalization.Parameters{
 	InvSqrtIter:    1,
 	BootstrapAfter: true,
 	ToTVecSize:     lib.NbMatPerCtIn * lib.Rows * lib.Cols,
+	Epsilon:        1e-6,
 }
 
 /*
@@ -181,15 +267,11 @@ var Norm2Parameters = normalization.Parameters{
 	InvSqrtMax: 280,
 	InvSqrtDeg: 31,
 	ToTVecSize: lib.NbMatPerCtIn * lib.Rows * lib.Cols,
+	Epsilon:    1e-6,
 }
 
-// hefloat.GenMinimaxCompositePolynomial(512, 5, 10, []int{127}, bignum.Sign)
+// The coefficients of the ReLU and of the max of the softmax are the
+// composite polynomials of the configuration, see lib.Configuration.SetSignPolynomials.
 var ReLUParameters = relu.Parameters{
-	CoeffsFloat: [][]float64{
-		{0, 1.272129035899513, 0, -0.421091879255116, 0, 0.249146260085848, 0, -0.174259510661971, 0, 0.131777951391126, 0, -0.104081189870054, 0, 0.084401445655405, 0, -0.069587192942794, 0, 0.057974710905923, 0, -0.048603776548697, 0, 0.040881513298993, 0, -0.034421666471770, 0, 0.028961023059828, 0, -0.024312877767999, 0, 0.020340082936784, 0, -0.016938465046402, 0, 0.014026336178818, 0, -0.011537731080080, 0, 0.009417941026674, 0, -0.007620579730076, 0, 0.006105439236114, 0, -0.004837036299154, 0, 0.003783963601656, 0, -0.002917977991100, 0, 0.002213425327161, 0, -0.001647277156547, 0, 0.001198804963407, 0, -0.000849322063176, 0, 0.000582094452881, 0, -0.000382336269914, 0, 0.000237122355400, 0, -0.000187573707069},
-	},
-	CoeffsString: [][]string{
-		{"0", "1.272129035899513", "0", "-0.421091879255116", "0", "0.249146260085848", "0", "-0.174259510661971", "0", "0.131777951391126", "0", "-0.104081189870054", "0", "0.084401445655405", "0", "-0.069587192942794", "0", "0.057974710905923", "0", "-0.048603776548697", "0", "0.040881513298993", "0", "-0.034421666471770", "0", "0.028961023059828", "0", "-0.024312877767999", "0", "0.020340082936784", "0", "-0.016938465046402", "0", "0.014026336178818", "0", "-0.011537731080080", "0", "0.009417941026674", "0", "-0.007620579730076", "0", "0.006105439236114", "0", "-0.004837036299154", "0", "0.003783963601656", "0", "-0.002917977991100", "0", "0.002213425327161", "0", "-0.001647277156547", "0", "0.001198804963407", "0", "-0.000849322063176", "0", "0.000582094452881", "0", "-0.000382336269914", "0", "0.000237122355400", "0", "-0.000187573707069"},
-	},
 	AbsMax: 50,
 }
@@ -2,12 +2,18 @@ package qkv
 
 import (
 	"fmt"
+	"math"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"app/client"
+	"app/layers/embedding"
 	"app/lib"
 	"app/server"
+	"app/utils"
+	"app/weights"
 
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -19,8 +25,8 @@ func TestEmbedding(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelEncryption)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -64,3 +70,49 @@ func TestEmbedding(t *testing.T) {
 		fmt.Println(stats)
 	}
 }
+
+func TestEmbeddingCoefficients(t *testing.T) {
+
+	lut := weights.LoadEmbeddingLUT("../weights")
+
+	coeffs, err := server.GetEmbeddingCoefficients("../weights")
+	require.NoError(t, err)
+
+	// The shipped coefficients interpolate the 25x128 table of the model
+	// at every token with the target error of embedding.Fit.
+	p := embedding.NewParameters(lib.A, lib.B, lib.K)
+	for j := range coeffs {
+		for i := 0; i <= p.K; i++ {
+			have := utils.ChebEval(coeffs[j], -1, 1, float64(i)*p.A+p.B)
+			require.LessOrEqual(t, math.Abs(have-lut.At(i+1, j)), p.MaxErr, "column %d, token %d", j, i)
+		}
+	}
+
+	// The shipped key matches the table and the coefficients file, which are
+	// thus read without being fitted again, and a modified file does not.
+	csv, err := os.ReadFile("../weights/embedding_coefficients.csv")
+	require.NoError(t, err)
+
+	key, err := os.ReadFile("../weights/embedding_coefficients.sha256")
+	require.NoError(t, err)
+
+	require.Equal(t, strings.TrimSpace(string(key)), embedding.Key(lut, lib.A, lib.B, lib.K, csv))
+
+	csv[len(csv)/2] ^= 1
+	require.NotEqual(t, strings.TrimSpace(string(key)), embedding.Key(lut, lib.A, lib.B, lib.K, csv))
+
+	// A mismatch is an error rather than a fit at runtime.
+	dir := t.TempDir()
+	table, err := os.ReadFile("../weights/embedding.csv")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dir+"/embedding.csv", table, 0644))
+	require.NoError(t, os.WriteFile(dir+"/embedding_coefficients.csv", csv, 0644))
+	require.NoError(t, os.WriteFile(dir+"/embedding_coefficients.sha256", key, 0644))
+
+	_, err = server.GetEmbeddingCoefficients(dir)
+	require.ErrorContains(t, err, "does not match")
+
+	require.NoError(t, os.Remove(dir+"/embedding_coefficients.sha256"))
+	_, err = server.GetEmbeddingCoefficients(dir)
+	require.Error(t, err)
+}
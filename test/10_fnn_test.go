@@ -2,6 +2,9 @@ package qkv
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +12,7 @@ import (
 	"app/client"
 	"app/lib"
 	"app/server"
+	"app/weights"
 
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -20,8 +24,8 @@ func TestFNN(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -74,3 +78,34 @@ func TestFNN(t *testing.T) {
 		fmt.Println(stats)
 	}
 }
+
+func TestFNNWeights(t *testing.T) {
+
+	write := func(n int) (dir string) {
+		dir = t.TempDir()
+		values := make([]string, n)
+		for i := range values {
+			values[i] = strconv.Itoa(i % 7)
+		}
+		require.NoError(t, os.WriteFile(dir+"/transformer_block_fnn_weights.csv", []byte(strings.Join(values, ",")), 0644))
+		return
+	}
+
+	// The hidden width of the model is inferred from the file.
+	w0, b0, w1, b1 := weights.LoadTransformerBlockFNNWeights("../weights")
+	_, hidden := w0.Dims()
+	require.Equal(t, 2*lib.Cols, hidden)
+	require.Len(t, b0, hidden)
+	require.Len(t, b1, lib.Cols)
+	rows, _ := w1.Dims()
+	require.Equal(t, hidden, rows)
+
+	// A wider FNN, e.g. 128x512.
+	w0, _, _, _ = weights.LoadTransformerBlockFNNWeights(write(512*(2*lib.Cols+1) + lib.Cols))
+	_, hidden = w0.Dims()
+	require.Equal(t, 512, hidden)
+
+	// A file which is not of the form Cols*H + H + H*Cols + Cols is rejected.
+	require.Panics(t, func() { weights.LoadTransformerBlockFNNWeights(write(512*(2*lib.Cols+1) + lib.Cols + 1)) })
+	require.Panics(t, func() { weights.LoadTransformerBlockFNNWeights(write(lib.Cols)) })
+}
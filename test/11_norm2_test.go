@@ -20,8 +20,8 @@ func TestNorm2(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"app/client"
+	"app/keys"
 	"app/lib"
 	"app/server"
 
@@ -19,8 +20,8 @@ func TestPooling(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -61,7 +62,7 @@ func TestPooling(t *testing.T) {
 
 	outPlain = s.PoolingApproximate(outPlain)
 
-	outEnc, err = s.PoolingEncrypted(outEnc)
+	outEnc, err = s.PoolingEncrypted(outEnc, 0)
 	require.NoError(t, err)
 
 	outHave, err := c.DecryptNew(outEnc, 1, lib.Cols, 0, lib.NbMatPerCtIn*lib.Rows)
@@ -72,3 +73,74 @@ func TestPooling(t *testing.T) {
 		fmt.Println(stats)
 	}
 }
+
+func TestPoolingCompact(t *testing.T) {
+
+	// 200 samples with 3 samples per ciphertext are pooled into 2 ciphertexts of
+	// 150 and 50 samples, which the compaction packs into a single ciphertext.
+	// As in RunEncryptedTensor, the pooled ciphertexts keep a level for the
+	// compaction, which leaves the level of the classifier.
+	n := 200
+
+	params := lib.NewParametersCustom(lib.LogN, 3)
+
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
+	}
+
+	ecd := hefloat.NewEncoder(params)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	s := server.NewServerWithParameters("../weights", lib.NumCPU, params, lib.DefaultHeads)
+
+	pooled, err := s.PooledLayout(n)
+	require.NoError(t, err)
+
+	compactGalEls := s.CompactGaloisElements(params, pooled)
+	require.NotEmpty(t, compactGalEls)
+
+	// Only the keys derived from the layouts are generated.
+	galEls := append(s.PoolingGaloisElements(params), compactGalEls...)
+
+	t.Logf("GaloisElements: %d\n", len(galEls))
+
+	s.SetKeyManager(keys.NewManagerFromKeys(kgen.GenRelinearizationKeyNew(sk), kgen.GenGaloisKeysNew(galEls, sk)))
+
+	c := client.NewClient(params, sk)
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", 0, n)
+	require.NoError(t, err)
+
+	outEnc, err := c.EncryptTensorNew(data, 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	outEnc, err = s.PoolingTensor(outEnc, 1)
+	require.NoError(t, err)
+	require.NoError(t, outEnc.Expect(pooled))
+	require.Equal(t, 2, outEnc.NumCts())
+	require.Equal(t, 2, outEnc.Cts[0].Level())
+
+	compacted, err := s.CompactTensor(outEnc)
+	require.NoError(t, err)
+	require.True(t, compacted)
+	require.Equal(t, 1, outEnc.NumCts())
+	require.Equal(t, lib.LevelClassifier, outEnc.Cts[0].Level())
+
+	logits, err := s.LogitsLayout(params, n)
+	require.NoError(t, err)
+	require.NoError(t, outEnc.Expect(logits))
+
+	outPlain := s.PoolingExact(data)
+
+	outHave, err := c.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+	require.Len(t, outHave, n)
+
+	// The pooling and the compaction leave about 30 bits of precision.
+	for i := range outPlain {
+		stats := hefloat.GetPrecisionStats(params, ecd, nil, outPlain[i].RawMatrix().Data, outHave[i].RawMatrix().Data, 0, false)
+		require.GreaterOrEqual(t, stats.MinPrec.Real, 25.0, "sample %d", i)
+	}
+}
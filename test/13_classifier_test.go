@@ -19,8 +19,8 @@ func TestClassifier(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, 12)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -62,9 +62,9 @@ func TestClassifier(t *testing.T) {
 	outPlain = s.PoolingApproximate(outPlain)
 	outPlain = s.ClassifierApproximate(outPlain)
 
-	outEnc, err = s.PoolingEncrypted(outEnc)
+	outEnc, err = s.PoolingEncrypted(outEnc, 0)
 	require.NoError(t, err)
-	require.NoError(t, s.ClassifierEncrypted(outEnc))
+	require.NoError(t, s.ClassifierEncrypted(outEnc, 0))
 
 	outHave, err := c.DecryptNew(outEnc, 1, lib.Classes, lib.Cols-lib.Classes, lib.NbMatPerCtIn*lib.Rows)
 	require.NoError(t, err)
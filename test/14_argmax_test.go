@@ -0,0 +1,93 @@
+package qkv
+
+import (
+	"testing"
+
+	"app/bootstrapping"
+	"app/client"
+	"app/lib"
+	"app/server"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestArgmax checks that the logits of the model on the real samples are
+// within the calibrated range of lib.ArgmaxParameters, whose lower bound
+// pads the blocks of the comparisons, and that the approximate and the
+// encrypted argmax (ArgmaxTensor, after the pooling and the classifier)
+// flag them as specified by the Threshold.
+func TestArgmax(t *testing.T) {
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
+	}
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	s := server.NewServerWithParameters("../weights", lib.NumCPU, params, lib.DefaultHeads)
+
+	galEls := append(s.PoolingGaloisElements(params), s.ClassifierGaloisElements(params)...)
+	galEls = append(galEls, s.ArgmaxGaloisElements(params)...)
+
+	c := client.NewClient(params, sk)
+
+	s.SetKeyManager(c.GetKeyManager(len(galEls), sk))
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", lib.SamplesStart, lib.SamplesEnd)
+	require.NoError(t, err)
+
+	outPlain := s.UpToNorm2(data)
+
+	outEnc, err := c.EncryptTensorNew(outPlain, 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	logits := s.ClassifierApproximate(s.PoolingApproximate(outPlain))
+
+	p := lib.ArgmaxParameters
+	require.NoError(t, p.CheckRange())
+
+	for i := range logits {
+		require.GreaterOrEqual(t, mat.Min(logits[i]), p.Min, "sample %d", i)
+		require.LessOrEqual(t, mat.Max(logits[i]), p.Max, "sample %d", i)
+	}
+
+	outEnc, err = s.PoolingTensor(outEnc, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.ClassifierTensor(outEnc, 0))
+	require.NoError(t, s.ArgmaxTensor(outEnc, bootstrapping.NewDummyBootstrapper(1, params, sk)))
+
+	outHave, err := c.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+	require.Len(t, outHave, len(logits))
+
+	have := make([]*mat.Dense, len(logits))
+	for i := range logits {
+		have[i] = mat.DenseCopyOf(logits[i])
+	}
+
+	s.ArgmaxApproximate(have)
+
+	// Logits within Threshold of the max are flagged,
+	// logits below max - 2*Threshold are not.
+	for i := range logits {
+		x := logits[i].RawMatrix().Data
+		max := mat.Max(logits[i])
+		for _, res := range []*mat.Dense{have[i], outHave[i]} {
+			for j, c := range res.RawMatrix().Data {
+				switch {
+				case x[j] >= max-p.Threshold:
+					require.InDelta(t, 1, c, 1e-2, "sample %d, class %d", i, j)
+				case x[j] < max-2*p.Threshold:
+					require.InDelta(t, 0, c, 1e-2, "sample %d, class %d", i, j)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package qkv
+
+import (
+	"fmt"
+	"testing"
+
+	"app/bootstrapping"
+	"app/client"
+	"app/lib"
+	"app/server"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutputSoftMax runs OutputSoftMaxTensor on the encrypted logits of the
+// model on the real samples, after the pooling and the classifier, and checks
+// that the logits are within the calibrated range of lib.OutputSoftMaxParameters,
+// whose lower bound pads the blocks of the max and of the sum.
+func TestOutputSoftMax(t *testing.T) {
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
+	}
+
+	ecd := hefloat.NewEncoder(params)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	s := server.NewServerWithParameters("../weights", lib.NumCPU, params, lib.DefaultHeads)
+
+	galEls := append(s.PoolingGaloisElements(params), s.ClassifierGaloisElements(params)...)
+	galEls = append(galEls, s.OutputSoftMaxGaloisElements(params)...)
+
+	t.Logf("GaloisElements: %d\n", len(galEls))
+
+	c := client.NewClient(params, sk)
+
+	s.SetKeyManager(c.GetKeyManager(len(galEls), sk))
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", lib.SamplesStart, lib.SamplesEnd)
+	require.NoError(t, err)
+
+	outPlain := s.UpToNorm2(data)
+
+	outEnc, err := c.EncryptTensorNew(outPlain, 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	logits := s.ClassifierApproximate(s.PoolingApproximate(outPlain))
+
+	p := lib.OutputSoftMaxParameters
+	require.NoError(t, p.CheckRange())
+
+	for i := range logits {
+		require.GreaterOrEqual(t, mat.Min(logits[i]), p.Min, "sample %d", i)
+		require.LessOrEqual(t, mat.Max(logits[i]), p.Max, "sample %d", i)
+	}
+
+	s.OutputSoftMaxExact(logits)
+
+	outEnc, err = s.PoolingTensor(outEnc, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.ClassifierTensor(outEnc, 0))
+	require.NoError(t, s.OutputSoftMaxTensor(outEnc, bootstrapping.NewDummyBootstrapper(1, params, sk)))
+
+	outHave, err := c.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+	require.Len(t, outHave, len(logits))
+
+	for i := range logits {
+		stats := hefloat.GetPrecisionStats(params, ecd, nil, logits[i].RawMatrix().Data, outHave[i].RawMatrix().Data, 0, true)
+		fmt.Println(stats)
+		require.InDeltaSlice(t, logits[i].RawMatrix().Data, outHave[i].RawMatrix().Data, 1e-2, "sample %d", i)
+	}
+}
@@ -0,0 +1,98 @@
+package qkv
+
+import (
+	"math"
+	"testing"
+
+	"app/bootstrapping"
+	"app/client"
+	"app/lib"
+	"app/server"
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSanitize checks that the sanitization of lib.SanitizeParameters, which
+// provides circuit privacy, binarizes and floods the encrypted argmax of the
+// real samples (see TestArgmax) with the estimated loss of precision.
+func TestSanitize(t *testing.T) {
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
+	}
+
+	p := lib.SanitizeParameters
+	require.NoError(t, p.CheckLambda())
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	s := server.NewServerWithParameters("../weights", lib.NumCPU, params, lib.DefaultHeads)
+	s.Argmax = true
+	s.Sanitizer = lib.NewSanitizer(params, kgen.GenPublicKeyNew(sk))
+
+	galEls := append(s.PoolingGaloisElements(params), s.ClassifierGaloisElements(params)...)
+	galEls = append(galEls, s.ArgmaxGaloisElements(params)...)
+
+	c := client.NewClient(params, sk)
+
+	s.SetKeyManager(c.GetKeyManager(len(galEls), sk))
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", lib.SamplesStart, lib.SamplesEnd)
+	require.NoError(t, err)
+
+	outPlain := s.UpToNorm2(data)
+
+	outEnc, err := c.EncryptTensorNew(outPlain, 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	outEnc, err = s.PoolingTensor(outEnc, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.ClassifierTensor(outEnc, 0))
+	require.NoError(t, s.ArgmaxTensor(outEnc, bootstrapping.NewDummyBootstrapper(1, params, sk)))
+
+	// The argmax binarized in the clear
+	want, err := c.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+
+	h := func(x float64) float64 { return x * x * (3 - 2*x) }
+
+	for i := range want {
+		for j, x := range want[i].RawMatrix().Data {
+			for range p.Rounds + 1 {
+				x = h(x)
+			}
+			want[i].RawMatrix().Data[j] = x
+		}
+	}
+
+	btp, err := lib.NewSimulatedBootstrapper(params, sk, [32]byte{})
+	require.NoError(t, err)
+
+	require.NoError(t, s.SanitizeTensor(outEnc, btp))
+	require.Equal(t, p.Level, outEnc.Cts[0].Level())
+
+	have, err := c.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+	require.Len(t, have, len(want))
+
+	_, noise := utils.Precision(have, want)
+
+	LogPrecisionLoss := p.LogPrecisionLoss(params)
+
+	t.Logf("Lambda=%d: avg |err| = 2^%.2f, estimate = 2^%.2f", p.Lambda, math.Log2(noise), LogPrecisionLoss)
+
+	require.Less(t, math.Log2(noise), LogPrecisionLoss+1)
+
+	// The ties of the argmax (see TestArgmax) may swap the index of the max
+	for i := range want {
+		for j, x := range want[i].RawMatrix().Data {
+			require.InDelta(t, x, have[i].At(0, j), 0.1, "sample %d, class %d", i, j)
+		}
+	}
+}
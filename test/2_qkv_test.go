@@ -19,8 +19,8 @@ func TestQKV(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -57,7 +57,7 @@ func TestQKV(t *testing.T) {
 	cts, err = c.EncryptNew(in, 0, lib.NbMatPerCtIn)
 	require.NoError(t, err)
 
-	QEnc, KEnc, VEnc, err := s.QKVEncrypted(cts)
+	QEnc, KEnc, VEnc, err := s.QKVEncrypted(cts, 0)
 	require.NoError(t, err)
 
 	QPlain, KPlain, VPlain := s.QKVApproximate(in)
@@ -9,6 +9,8 @@ import (
 	"app/lib"
 	"app/server"
 
+	"gonum.org/v1/gonum/mat"
+
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
 
@@ -19,8 +21,8 @@ func TestSplitHeads(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, 1)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -64,35 +66,60 @@ func TestSplitHeads(t *testing.T) {
 	VEnc, err = c.EncryptNew(VPlain, 0, lib.NbMatPerCtIn)
 	require.NoError(t, err)
 
-	require.NoError(t, s.SplitHeadsEncrypted(QEnc, KEnc, VEnc))
+	QEnc, KEnc, VEnc, err = s.SplitHeadsEncrypted(QEnc, KEnc, VEnc)
+	require.NoError(t, err)
 
 	QSplitPlain, KSplitPlain, VSplitPlain := s.SplitHeadsApproximate(QPlain, KPlain, VPlain)
 
-	QSplitEnc, err := c.DecryptNew(QEnc, lib.Rows, lib.Cols/lib.Split, lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	QSplitEnc, err := c.DecryptNew(QEnc, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
+	QSplitEnc = unpadHeads(QSplitEnc, lib.Rows, s.Attention.HeadCols)
 	for i := range QSplitPlain {
 		for j := range QSplitPlain[i] {
-			stats := hefloat.GetPrecisionStats(params, ecd, nil, QSplitPlain[i][j].RawMatrix().Data, QSplitEnc[i*lib.Split+j].RawMatrix().Data, 0, true)
+			stats := hefloat.GetPrecisionStats(params, ecd, nil, QSplitPlain[i][j].RawMatrix().Data, QSplitEnc[i*s.Attention.Heads+j].RawMatrix().Data, 0, true)
 			fmt.Println(stats)
 		}
 	}
 
-	KSplitEnc, err := c.DecryptNew(KEnc, lib.Rows, lib.Cols/lib.Split, lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	KSplitEnc, err := c.DecryptNew(KEnc, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
+	KSplitEnc = unpadHeads(KSplitEnc, lib.Rows, s.Attention.HeadCols)
 	for i := range KSplitPlain {
 		for j := range KSplitPlain[i] {
-			stats := hefloat.GetPrecisionStats(params, ecd, nil, KSplitPlain[i][j].RawMatrix().Data, KSplitEnc[i*lib.Split+j].RawMatrix().Data, 0, true)
+			stats := hefloat.GetPrecisionStats(params, ecd, nil, KSplitPlain[i][j].RawMatrix().Data, KSplitEnc[i*s.Attention.Heads+j].RawMatrix().Data, 0, true)
 			fmt.Println(stats)
 		}
 	}
 
-	VSplitEnc, err := c.DecryptNew(VEnc, lib.Rows, lib.Cols/lib.Split, lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	VSplitEnc, err := c.DecryptNew(VEnc, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
+	VSplitEnc = unpadHeads(VSplitEnc, lib.Rows, s.Attention.HeadCols)
 
 	for i := range VSplitPlain {
 		for j := range VSplitPlain[i] {
-			stats := hefloat.GetPrecisionStats(params, ecd, nil, VSplitPlain[i][j].RawMatrix().Data, VSplitEnc[i*lib.Split+j].RawMatrix().Data, 0, true)
+			stats := hefloat.GetPrecisionStats(params, ecd, nil, VSplitPlain[i][j].RawMatrix().Data, VSplitEnc[i*s.Attention.Heads+j].RawMatrix().Data, 0, true)
 			fmt.Println(stats)
 		}
 	}
 }
+
+// padHeads returns the heads padded with zeros to rows x cols matrices,
+// as stored by the split layout (see server.SplitLayout).
+func padHeads(heads []*mat.Dense, rows, cols int) (padded []*mat.Dense) {
+	padded = make([]*mat.Dense, len(heads))
+	for i := range heads {
+		padded[i] = mat.NewDense(rows, cols, nil)
+		r, c := heads[i].Dims()
+		padded[i].Slice(0, r, 0, c).(*mat.Dense).Copy(heads[i])
+	}
+	return
+}
+
+// unpadHeads returns the top left rows x cols matrices of the heads.
+func unpadHeads(heads []*mat.Dense, rows, cols int) (unpadded []*mat.Dense) {
+	unpadded = make([]*mat.Dense, len(heads))
+	for i := range heads {
+		unpadded[i] = mat.DenseCopyOf(heads[i].Slice(0, rows, 0, cols))
+	}
+	return
+}
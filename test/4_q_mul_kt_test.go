@@ -20,8 +20,8 @@ func TestQMulKT(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, 4)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -57,22 +57,23 @@ func TestQMulKT(t *testing.T) {
 
 	var QSplitEnc, KSplitEnc []rlwe.Ciphertext
 
-	QSplitEnc, err = c.EncryptNew(utils.Flatten(QSplitPlain), lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	QSplitEnc, err = c.EncryptNew(padHeads(utils.Flatten(QSplitPlain), s.Attention.HeadDims, s.Attention.HeadDims), 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
 
-	KSplitEnc, err = c.EncryptNew(utils.Flatten(KSplitPlain), lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	KSplitEnc, err = c.EncryptNew(padHeads(utils.Flatten(KSplitPlain), s.Attention.HeadDims, s.Attention.HeadDims), 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
 
 	QMulKTEnc := QSplitEnc
 
 	require.NoError(t, s.QMulKTEncrypted(QSplitEnc, KSplitEnc, QMulKTEnc))
 
-	QMulKTHave, err := c.DecryptNew(QMulKTEnc, lib.Rows, lib.Rows, 0, lib.NbMatPerCtIn*lib.Split)
+	QMulKTHave, err := c.DecryptNew(QMulKTEnc, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
+	QMulKTHave = unpadHeads(QMulKTHave, lib.Rows, lib.Rows)
 	for i := range QMulKTSplitPlain {
 		for j := range QMulKTSplitPlain[i] {
 			fmt.Println(QMulKTSplitPlain[i][j].RawMatrix().Data[:8])
-			stats := hefloat.GetPrecisionStats(params, ecd, nil, QMulKTSplitPlain[i][j].RawMatrix().Data, QMulKTHave[i*lib.Split+j].RawMatrix().Data, 0, true)
+			stats := hefloat.GetPrecisionStats(params, ecd, nil, QMulKTSplitPlain[i][j].RawMatrix().Data, QMulKTHave[i*s.Attention.Heads+j].RawMatrix().Data, 0, true)
 			fmt.Println(stats)
 		}
 	}
@@ -2,6 +2,7 @@ package qkv
 
 import (
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"app/client"
 	"app/lib"
+	"app/oracle"
 	"app/server"
 	"app/utils"
 
@@ -22,8 +24,8 @@ func TestSoftMax(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -33,7 +35,7 @@ func TestSoftMax(t *testing.T) {
 
 	//btp := lib.NewDummyBootstrapper(params, sk)
 	btp := lib.NewBootstrapper(params, sk)
-	btp.Debug = true
+	btp.Oracle = oracle.NewSecretKeyOracle(params, sk, os.Stdout)
 
 	now := time.Now()
 	fmt.Printf("Server: ")
@@ -66,7 +68,7 @@ func TestSoftMax(t *testing.T) {
 
 	fmt.Println(QMulKTPlain[0][0].RawMatrix().Data[:8])
 
-	QMulKTEnc, err = c.EncryptNew(utils.Flatten(QMulKTPlain), 0, lib.NbMatPerCtIn*lib.Split)
+	QMulKTEnc, err = c.EncryptNew(padHeads(utils.Flatten(QMulKTPlain), s.Attention.HeadDims, s.Attention.HeadDims), 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
 
 	SoftMaxPlain := QMulKTPlain
@@ -75,8 +77,9 @@ func TestSoftMax(t *testing.T) {
 
 	require.NoError(t, s.SoftMaxEncrypted(QMulKTEnc, btp))
 	_ = ecd
-	SoftMaxHave, err := c.DecryptNew(QMulKTEnc, lib.Rows, lib.Rows, 0, lib.NbMatPerCtIn*lib.Split)
+	SoftMaxHave, err := c.DecryptNew(QMulKTEnc, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
+	SoftMaxHave = unpadHeads(SoftMaxHave, lib.Rows, lib.Rows)
 	for i := range SoftMaxPlain {
 		for j := range SoftMaxPlain[i] {
 
@@ -86,12 +89,12 @@ func TestSoftMax(t *testing.T) {
 						if k != 0 && k%lib.Rows == 0 {
 							fmt.Println()
 						}
-						fmt.Printf("%4d %15.10f %15.10f\n", k, SoftMaxPlain[i][j].RawMatrix().Data[k], SoftMaxHave[i*lib.Split+j].RawMatrix().Data[k])
+						fmt.Printf("%4d %15.10f %15.10f\n", k, SoftMaxPlain[i][j].RawMatrix().Data[k], SoftMaxHave[i*s.Attention.Heads+j].RawMatrix().Data[k])
 					}
 				}
 			*/
 
-			stats := hefloat.GetPrecisionStats(params, ecd, nil, SoftMaxPlain[i][j].RawMatrix().Data, SoftMaxHave[i*lib.Split+j].RawMatrix().Data, 0, true)
+			stats := hefloat.GetPrecisionStats(params, ecd, nil, SoftMaxPlain[i][j].RawMatrix().Data, SoftMaxHave[i*s.Attention.Heads+j].RawMatrix().Data, 0, true)
 			fmt.Println(stats)
 		}
 	}
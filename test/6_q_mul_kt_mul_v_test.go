@@ -20,8 +20,8 @@ func TestQMulKTMulV(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, 3)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -56,10 +56,10 @@ func TestQMulKTMulV(t *testing.T) {
 
 	var QMulKTEnc, VEnc []rlwe.Ciphertext
 
-	QMulKTEnc, err = c.EncryptNew(utils.Flatten(QMulKTPlain), 0, lib.NbMatPerCtIn*lib.Split)
+	QMulKTEnc, err = c.EncryptNew(padHeads(utils.Flatten(QMulKTPlain), s.Attention.HeadDims, s.Attention.HeadDims), 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
 
-	VEnc, err = c.EncryptNew(utils.Flatten(VPlain), lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	VEnc, err = c.EncryptNew(padHeads(utils.Flatten(VPlain), s.Attention.HeadDims, s.Attention.HeadDims), 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
 
 	QKTMulVEnc := QMulKTEnc
@@ -67,11 +67,12 @@ func TestQMulKTMulV(t *testing.T) {
 
 	QKTMulVPlain := s.QKTMulVApproximate(QMulKTPlain, VPlain)
 
-	QKTMulVHave, err := c.DecryptNew(QKTMulVEnc, lib.Rows, lib.Cols/lib.Split, lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	QKTMulVHave, err := c.DecryptNew(QKTMulVEnc, s.Attention.HeadDims, s.Attention.HeadDims, 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
+	QKTMulVHave = unpadHeads(QKTMulVHave, lib.Rows, s.Attention.HeadCols)
 	for i := range QKTMulVPlain {
 		for j := range QKTMulVPlain[i] {
-			stats := hefloat.GetPrecisionStats(params, ecd, nil, QKTMulVPlain[i][j].RawMatrix().Data, QKTMulVHave[i*lib.Split+j].RawMatrix().Data, 0, true)
+			stats := hefloat.GetPrecisionStats(params, ecd, nil, QKTMulVPlain[i][j].RawMatrix().Data, QKTMulVHave[i*s.Attention.Heads+j].RawMatrix().Data, 0, true)
 			fmt.Println(stats)
 		}
 	}
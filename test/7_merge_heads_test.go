@@ -20,8 +20,8 @@ func TestMergeHeads(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, 1)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -55,10 +55,11 @@ func TestMergeHeads(t *testing.T) {
 	QMulKTMulVPlain := s.UpToQMulKTMulV(data)
 
 	var QMulKTMulVEnc []rlwe.Ciphertext
-	QMulKTMulVEnc, err = c.EncryptNew(utils.Flatten(QMulKTMulVPlain), lib.Padding, lib.NbMatPerCtIn*lib.Split)
+	QMulKTMulVEnc, err = c.EncryptNew(padHeads(utils.Flatten(QMulKTMulVPlain), s.Attention.HeadDims, s.Attention.HeadDims), 0, s.Attention.HeadMatPerCt)
 	require.NoError(t, err)
 
-	require.NoError(t, s.MergeHeadsEncrypted(QMulKTMulVEnc))
+	QMulKTMulVEnc, err = s.MergeHeadsEncrypted(QMulKTMulVEnc)
+	require.NoError(t, err)
 
 	MergeHeadsPlain := s.MergeHeadsApproximate(QMulKTMulVPlain)
 
@@ -20,8 +20,8 @@ func TestNorm1(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -74,3 +74,64 @@ func TestNorm1(t *testing.T) {
 		fmt.Println(stats)
 	}
 }
+
+func TestNorm1Repacked(t *testing.T) {
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	if err := lib.CheckHeads(params, 2); err != nil {
+		panic(err)
+	}
+
+	ecd := hefloat.NewEncoder(params)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	btp := bootstrapping.NewDummyBootstrapper(lib.NumCPU, params, sk)
+
+	// With 2 heads, the split packs 4 samples per ciphertext, which
+	// the residual keeps, and Norm1 is repacked to 5 samples.
+	s := server.NewServerWithParameters("../weights", lib.NumCPU, params, 2)
+
+	var err error
+	s.Packing, err = s.OptimizePacking(params)
+	require.NoError(t, err)
+	require.NoError(t, s.CheckPacking(params))
+
+	matPerCt := s.SamplesPerCt("Norm1")
+	require.Greater(t, matPerCt, s.SamplesPerCt("Combine"))
+
+	galEls := append(s.NormalizationGaloisElements(params), s.PackingGaloisElements(params)...)
+
+	t.Logf("GaloisElements: %d\n", len(galEls))
+
+	c := client.NewClient(params, sk)
+	s.SetKeyManager(c.GetKeyManager(len(galEls), sk))
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", lib.SamplesStart, lib.SamplesEnd)
+	require.NoError(t, err)
+
+	outPlain := s.UpToCombine(data)
+
+	// The output of Combine has the packing of the input.
+	outEnc, err := c.EncryptTensorNew(outPlain, 0, s.SamplesPerCt("Combine"))
+	require.NoError(t, err)
+
+	level := outEnc.Cts[0].Level()
+
+	require.NoError(t, s.RepackTensor(outEnc, "Norm1"))
+	require.Equal(t, matPerCt, outEnc.MatPerCt)
+	require.Equal(t, level-1, outEnc.Cts[0].Level())
+
+	s.Norm1Approximate(outPlain)
+
+	require.NoError(t, s.Norm1Tensor(outEnc, btp))
+
+	outHave, err := c.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+	for i := range outPlain {
+		stats := hefloat.GetPrecisionStats(params, ecd, nil, outPlain[i].RawMatrix().Data, outHave[i].RawMatrix().Data, 0, true)
+		fmt.Println(stats)
+	}
+}
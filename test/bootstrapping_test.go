@@ -2,14 +2,20 @@ package qkv
 
 import (
 	"fmt"
+	"math"
+	"os"
 	"testing"
 	"time"
-	"app/lib"
+
+	"app/bootstrapping"
 	"app/client"
+	"app/lib"
+	"app/oracle"
 	"app/server"
 
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/sampling"
 
 	"github.com/stretchr/testify/require"
 )
@@ -18,8 +24,8 @@ func TestBootstrapping(t *testing.T) {
 
 	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
 
-	if tot := lib.NbMatPerCtIn * lib.Split * (lib.Rows * (lib.Cols/lib.Split + lib.Padding)); tot > params.MaxSlots() {
-		panic(fmt.Errorf("invalid parameters: %d slots < split * #padded matrices = %d", params.MaxSlots(), tot))
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
 	}
 
 	ecd := hefloat.NewEncoder(params)
@@ -27,7 +33,7 @@ func TestBootstrapping(t *testing.T) {
 	kgen := rlwe.NewKeyGenerator(params)
 	sk := kgen.GenSecretKeyNew()
 	btp := lib.NewBootstrapper(params, sk)
-	btp.Debug = true
+	btp.Oracle = oracle.NewSecretKeyOracle(params, sk, os.Stdout)
 
 	c := client.NewClient(params, sk)
 	s := server.NewServer("../weights", lib.NumCPU)
@@ -53,3 +59,175 @@ func TestBootstrapping(t *testing.T) {
 		fmt.Println(stats)
 	}
 }
+
+// TestBootstrappingProfiles prints, for each of lib.BootstrappingProfiles,
+// the summary of its parameters and the latency and the model of the error
+// of the bootstrapping (see bootstrapping.MeasureNoise), and checks that the
+// error of the bootstrapping of uniform values in [-a, a] is at most one bit
+// larger than the one of the profile, measured at LogN=13 and brought to
+// the degree of the parameters by bootstrapping.NoiseModel.At.
+func TestBootstrappingProfiles(t *testing.T) {
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	for _, profile := range lib.BootstrappingProfiles {
+
+		t.Run(profile.Name, func(t *testing.T) {
+
+			c := lib.DefaultConfiguration()
+			c.Bootstrapping = profile.Name
+
+			summary, err := c.BootstrappingSummary()
+			require.NoError(t, err)
+			fmt.Println(summary)
+
+			btp, err := c.NewBootstrapper(params, sk)
+			require.NoError(t, err)
+			require.Equal(t, lib.LevelBootstrapping, btp.OutputLevel())
+			require.Equal(t, summary.Depth, btp.Depth())
+
+			now := time.Now()
+			noise, err := bootstrapping.MeasureNoise(btp, params, sk, sampling.NewSource([32]byte{}))
+			require.NoError(t, err)
+			fmt.Printf("%s: %s per bootstrapping, error %s (profile %s)\n", profile.Name, time.Since(now)/3, noise, profile.Noise)
+
+			want := profile.Noise.At(params.LogN())
+
+			for _, a := range []float64{1, 8, 64} {
+				rms := a / math.Sqrt(3)
+				require.LessOrEqual(t, math.Log2(noise.StdDev(rms)), math.Log2(want.StdDev(rms))+1)
+			}
+		})
+	}
+}
+
+// TestNoiseGrowth checks, for each of lib.BootstrappingProfiles, the
+// extrapolation of the model of the error to larger degrees: the model
+// measured at LogN=12, with its growth from LogN=11, brought to LogN=13 by
+// bootstrapping.NoiseModel.At, must be at most one bit larger than the one
+// of the profile, measured at LogN=13, on uniform values in [-a, a]. If it
+// is more than one bit smaller, the error grows faster than linearly in
+// LogN, and the model of the profile brought to lib.LogN must be at most
+// half a bit smaller than the one with the growth of the excess over
+// LogN=11 (see bootstrapping.NoiseModel.WithExcessGrowth).
+func TestNoiseGrowth(t *testing.T) {
+
+	for _, profile := range lib.BootstrappingProfiles {
+
+		t.Run(profile.Name, func(t *testing.T) {
+
+			base := measureProfileNoise(t, profile.Name, profile.Noise.LogN-2)
+			low := measureProfileNoise(t, profile.Name, profile.Noise.LogN-1)
+
+			have, err := low.WithGrowth(base)
+			require.NoError(t, err)
+
+			have = have.At(profile.Noise.LogN)
+
+			fmt.Printf("%s: extrapolated error %s (profile %s)\n", profile.Name, have, profile.Noise)
+
+			var optimistic bool
+			for _, a := range []float64{1, 8, 64} {
+				rms := a / math.Sqrt(3)
+				want := math.Log2(profile.Noise.StdDev(rms))
+				require.LessOrEqual(t, math.Log2(have.StdDev(rms)), want+1)
+				optimistic = optimistic || math.Log2(have.StdDev(rms)) < want-1
+			}
+
+			if !optimistic {
+				return
+			}
+
+			excess, err := profile.Noise.WithExcessGrowth(base, low)
+			require.NoError(t, err)
+
+			fmt.Printf("%s: growth of the excess %s\n", profile.Name, excess)
+
+			for _, a := range []float64{1, 8, 64} {
+				rms := a / math.Sqrt(3)
+				want := math.Log2(excess.At(lib.LogN).StdDev(rms))
+				require.GreaterOrEqual(t, math.Log2(profile.Noise.At(lib.LogN).StdDev(rms)), want-0.5, "the error of the profile grows faster than its model")
+			}
+		})
+	}
+}
+
+// measureProfileNoise returns the model of the error of the bootstrapping of
+// the given profile with residual parameters of degree 2^LogN.
+func measureProfileNoise(t *testing.T, profile string, LogN int) bootstrapping.NoiseModel {
+
+	c := lib.DefaultConfiguration()
+	c.LogN = LogN
+	c.Bootstrapping = profile
+
+	params, err := c.Parameters(lib.LevelBootstrapping)
+	require.NoError(t, err)
+
+	btpParams, err := c.BootstrappingParameters()
+	require.NoError(t, err)
+
+	sk := rlwe.NewKeyGenerator(params).GenSecretKeyNew()
+
+	btp, err := bootstrapping.NewBootstrapper(1, btpParams, sk)
+	require.NoError(t, err)
+
+	noise, err := bootstrapping.MeasureNoise(btp, params, sk, sampling.NewSource([32]byte{}))
+	require.NoError(t, err)
+
+	return noise
+}
+
+// TestSimulatedBootstrapping checks that the simulated bootstrapper resets
+// the level of the ciphertexts and that its error matches, within half a
+// bit, the one of the real bootstrapping of the default profile on uniform
+// values in [-a, a], for magnitudes a other than those of the measurement
+// of the profile. The real bootstrapping is the one of residual parameters
+// of degree 2^13, on which the profiles are measured, which fit in the
+// memory of a laptop but are not secure.
+func TestSimulatedBootstrapping(t *testing.T) {
+
+	profile, err := lib.GetBootstrappingProfile(lib.BootstrappingProfileName)
+	require.NoError(t, err)
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	btp, err := lib.NewSimulatedBootstrapper(params, sk, [32]byte{})
+	require.NoError(t, err)
+	require.Equal(t, lib.LevelBootstrapping, btp.OutputLevel())
+	require.Equal(t, 0, btp.MinimumInputLevel())
+
+	c := lib.DefaultConfiguration()
+	c.LogN = profile.Noise.LogN
+
+	params, err = c.Parameters(lib.LevelBootstrapping)
+	require.NoError(t, err)
+
+	btpParams, err := c.BootstrappingParameters()
+	require.NoError(t, err)
+
+	kgen = rlwe.NewKeyGenerator(params)
+	sk = kgen.GenSecretKeyNew()
+
+	actual, err := bootstrapping.NewBootstrapper(1, btpParams, sk)
+	require.NoError(t, err)
+
+	simulated := bootstrapping.NewSimulatedBootstrapper(1, params, sk, profile.Noise, [32]byte{})
+
+	for _, a := range []float64{2, 16, 32} {
+
+		_, want, err := bootstrapping.MeasureError(actual, params, sk, sampling.NewSource([32]byte{}), a)
+		require.NoError(t, err)
+
+		_, have, err := bootstrapping.MeasureError(simulated, params, sk, sampling.NewSource([32]byte{}), a)
+		require.NoError(t, err)
+
+		fmt.Printf("[-%v, %v]: error 2^%.2f (bootstrapping 2^%.2f)\n", a, a, math.Log2(have), math.Log2(want))
+		require.InDelta(t, math.Log2(want), math.Log2(have), 0.5)
+	}
+}
@@ -0,0 +1,117 @@
+package qkv
+
+import (
+	"testing"
+
+	"app/client"
+	"app/lib"
+	"app/server"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCalibration checks that the ranges recorded by RunApproximate on a
+// few samples are within the intervals of lib, which were calibrated on all
+// the samples, and that Ranges.Parameters widens them by the margin.
+func TestCalibration(t *testing.T) {
+
+	s := server.NewServer("../weights", lib.NumCPU)
+	c := new(client.Client)
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", lib.SamplesStart, lib.SamplesStart+4)
+	require.NoError(t, err)
+
+	want := s.RunApproximate(data)
+
+	r := server.NewRanges()
+	s.Ranges = &r
+	s.Probabilities = true
+	have := s.RunApproximate(data)
+	r.Print(0.1)
+
+	// The recorder does not change the circuit.
+	s.Ranges = nil
+	require.Equal(t, s.RunApproximate(data), have)
+	s.Probabilities = false
+	require.Equal(t, s.RunApproximate(data), want)
+
+	for name, rg := range map[string]server.Range{
+		"SoftMaxMax":  r.SoftMaxMax,
+		"SoftMaxExp":  r.SoftMaxExp,
+		"SoftMaxNorm": r.SoftMaxNorm,
+		"Norm1":       r.Norm1,
+		"FNN":         r.FNN,
+		"Norm2":       r.Norm2,
+		"Logits":      r.Logits,
+		"Spread":      r.LogitsSpread,
+		"OutputExp":   r.OutputExp,
+		"OutputNorm":  r.OutputNorm,
+	} {
+		require.LessOrEqual(t, rg.Min, rg.Max, name)
+	}
+
+	t.Run("Ranges", func(t *testing.T) {
+		sm := lib.SoftMaxParameters
+		require.LessOrEqual(t, r.SoftMaxMax.Max, float64(sm.MaxParameters.AbsMax))
+		require.GreaterOrEqual(t, r.SoftMaxExp.Min, sm.ExpMin)
+		require.LessOrEqual(t, r.SoftMaxExp.Max, sm.ExpMax)
+		require.GreaterOrEqual(t, r.SoftMaxNorm.Min, sm.InvMin)
+		require.LessOrEqual(t, r.SoftMaxNorm.Max, sm.InvMax)
+		require.GreaterOrEqual(t, r.Norm1.Min, lib.Norm1Parameters.InvSqrtMin)
+		require.LessOrEqual(t, r.Norm1.Max, lib.Norm1Parameters.InvSqrtMax)
+		require.LessOrEqual(t, r.FNN.AbsMax(), lib.FNNActivationParameters().AbsMax)
+		require.GreaterOrEqual(t, r.Norm2.Min, lib.Norm2Parameters.InvSqrtMin)
+		require.LessOrEqual(t, r.Norm2.Max, lib.Norm2Parameters.InvSqrtMax)
+		require.LessOrEqual(t, r.LogitsSpread.Max, float64(lib.OutputSoftMaxParameters.MaxParameters.AbsMax))
+		require.LessOrEqual(t, r.LogitsSpread.Max, r.Logits.Max-r.Logits.Min)
+		require.GreaterOrEqual(t, r.Logits.Min, lib.ArgmaxParameters.Min)
+		require.GreaterOrEqual(t, r.Logits.Min, lib.OutputSoftMaxParameters.Min)
+		require.LessOrEqual(t, r.Logits.Max, lib.OutputSoftMaxParameters.Max)
+		require.LessOrEqual(t, r.Logits.Max, lib.ArgmaxParameters.Max)
+	})
+
+	t.Run("Margin", func(t *testing.T) {
+
+		margin := 0.1
+
+		p := r.Parameters(margin)
+
+		expWidth := r.SoftMaxExp.Max - r.SoftMaxExp.Min
+		require.LessOrEqual(t, p.SoftMax.ExpMin, r.SoftMaxExp.Min-margin*expWidth)
+		require.GreaterOrEqual(t, p.SoftMax.ExpMax, r.SoftMaxExp.Max+margin*expWidth)
+		require.LessOrEqual(t, p.SoftMax.InvMin, r.SoftMaxNorm.Min/(1+margin))
+		require.GreaterOrEqual(t, p.SoftMax.InvMax, r.SoftMaxNorm.Max*(1+margin))
+		require.GreaterOrEqual(t, float64(p.SoftMax.MaxParameters.AbsMax), r.SoftMaxMax.Max*(1+margin))
+
+		for _, norm := range []struct {
+			have, want server.Range
+		}{
+			{server.Range{Min: p.Norm1.InvSqrtMin, Max: p.Norm1.InvSqrtMax}, r.Norm1},
+			{server.Range{Min: p.Norm2.InvSqrtMin, Max: p.Norm2.InvSqrtMax}, r.Norm2},
+		} {
+			require.LessOrEqual(t, norm.have.Min, norm.want.Min/(1+margin))
+			require.GreaterOrEqual(t, norm.have.Max, norm.want.Max*(1+margin))
+		}
+
+		require.GreaterOrEqual(t, p.FNNAbsMax, r.FNN.AbsMax()*(1+margin))
+
+		outExpWidth := r.OutputExp.Max - r.OutputExp.Min
+		require.LessOrEqual(t, p.OutputSoftMax.ExpMin, r.OutputExp.Min-margin*outExpWidth)
+		require.LessOrEqual(t, p.OutputSoftMax.ExpMin, -float64(p.OutputSoftMax.MaxParameters.AbsMax))
+		require.GreaterOrEqual(t, p.OutputSoftMax.ExpMax, r.OutputExp.Max+margin*outExpWidth)
+		require.LessOrEqual(t, p.OutputSoftMax.InvMin, r.OutputNorm.Min/(1+margin))
+		require.GreaterOrEqual(t, p.OutputSoftMax.InvMax, r.OutputNorm.Max*(1+margin))
+
+		logitsWidth := r.Logits.Max - r.Logits.Min
+		require.LessOrEqual(t, p.Argmax.Min, r.Logits.Min-margin*logitsWidth)
+		require.GreaterOrEqual(t, p.Argmax.Max, r.Logits.Max+margin*logitsWidth)
+		require.NoError(t, p.Argmax.CheckRange())
+		require.NoError(t, p.OutputSoftMax.CheckRange())
+		require.Equal(t, p.Argmax.Min, p.OutputSoftMax.Min)
+
+		// The other parameters are those of lib.
+		require.Equal(t, lib.SoftMaxParameters.ExpDeg, p.SoftMax.ExpDeg)
+		require.Equal(t, lib.Norm1Parameters.Epsilon, p.Norm1.Epsilon)
+		require.Equal(t, lib.Norm2Parameters.BootstrapBefore, p.Norm2.BootstrapBefore)
+	})
+}
@@ -0,0 +1,101 @@
+package qkv
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"app/client"
+	"app/keys"
+	"app/lib"
+	"app/multiparty"
+	"app/server"
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMultiparty evaluates the pooling and the classifier on the samples
+// encrypted under the collective public key of 3 parties, with the collective
+// relinearization and Galois keys, after a refresh among the parties at the
+// minimum level of multiparty.Bootstrapper, and threshold-decrypts the logits
+// of one ciphertext of samples.
+func TestMultiparty(t *testing.T) {
+
+	params := lib.NewParametersCustom(lib.LogN, lib.LevelBootstrapping)
+
+	if err := lib.CheckHeads(params, lib.DefaultHeads); err != nil {
+		panic(err)
+	}
+
+	ecd := hefloat.NewEncoder(params)
+
+	P := multiparty.NewParties(params, 3)
+
+	pk, err := P.GenPublicKeyNew()
+	require.NoError(t, err)
+
+	rlk, err := P.GenRelinearizationKeyNew(pk)
+	require.NoError(t, err)
+
+	s := server.NewServerWithParameters("../weights", lib.NumCPU, params, lib.DefaultHeads)
+
+	galEls := append(s.PoolingGaloisElements(params), s.ClassifierGaloisElements(params)...)
+
+	gks, err := P.GenGaloisKeysNew(galEls)
+	require.NoError(t, err)
+
+	s.SetKeyManager(keys.NewManagerFromKeys(rlk, gks))
+
+	btp, err := multiparty.NewBootstrapper(params, P)
+	require.NoError(t, err)
+
+	// The refreshed ciphertexts are at the level of the bootstrapped
+	// ciphertexts expected by the stages.
+	require.Equal(t, lib.LevelBootstrapping, btp.OutputLevel())
+
+	c := client.NewEncryptionClient(params, pk)
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	outPlain := s.UpToNorm2(data)
+
+	outEnc, err := c.EncryptTensorNew(outPlain, 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	// As at the end of Norm2 in the circuit
+	m := btp.MinimumInputLevel()
+
+	for i := range outEnc.Cts {
+		outEnc.Cts[i].ResizeQ(m)
+	}
+
+	outEnc.Cts, err = btp.BootstrapMany(outEnc.Cts)
+	require.NoError(t, err)
+	require.Equal(t, len(outEnc.Cts), btp.Count)
+	require.Equal(t, lib.LevelBootstrapping, outEnc.Cts[0].Level())
+
+	outEnc, err = s.PoolingTensor(outEnc, m)
+	require.NoError(t, err)
+	require.NoError(t, s.ClassifierTensor(outEnc, m))
+
+	outHave, err := P.DecryptTensorNew(outEnc)
+	require.NoError(t, err)
+
+	want := s.ClassifierApproximate(s.PoolingApproximate(outPlain))
+	require.Len(t, outHave, len(want))
+
+	for i := range want {
+		stats := hefloat.GetPrecisionStats(params, ecd, nil, want[i].RawMatrix().Data, outHave[i].RawMatrix().Data, 0, true)
+		fmt.Println(stats)
+	}
+
+	_, noise := utils.Precision(outHave, want)
+
+	t.Logf("avg |err| = 2^%.2f", math.Log2(noise))
+
+	require.Less(t, math.Log2(noise), -10.0)
+}
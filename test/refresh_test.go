@@ -0,0 +1,74 @@
+package qkv
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"app/client"
+	"app/lib"
+	"app/refresh"
+	"app/server"
+	"app/utils"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/rlwe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunEncryptedRefresh evaluates the whole encrypted circuit, with the
+// argmax, on one ciphertext of samples with the client-aided refresh over
+// the loopback transport, whose minimum input level is 1.
+func TestRunEncryptedRefresh(t *testing.T) {
+
+	params := lib.NewParameters()
+
+	ecd := hefloat.NewEncoder(params)
+
+	kgen := rlwe.NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+
+	now := time.Now()
+	fmt.Printf("Server: ")
+	s := server.NewServer("../weights", lib.NumCPU)
+	s.Argmax = true
+	fmt.Printf("%s\n", time.Since(now))
+
+	_, maxconcurrentkeys := s.GaloisElements(params)
+
+	c := client.NewClient(params, sk)
+	s.SetKeyManager(c.GetKeyManager(maxconcurrentkeys, sk))
+
+	transport := refresh.NewLoopback(refresh.NewClient(params, sk))
+	btp := lib.NewInteractiveBootstrapper(params, transport)
+	require.Equal(t, 1, btp.MinimumInputLevel())
+
+	data, _, err := c.Load("../data/example_AA_sequences.list", 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	in, err := c.EncryptTensorNew(data, 0, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+
+	out, err := s.RunEncryptedTensor(in, btp)
+	require.NoError(t, err)
+
+	layout, err := s.LogitsLayout(params, lib.NbMatPerCtIn)
+	require.NoError(t, err)
+	require.NoError(t, out.Expect(layout))
+
+	have, err := c.DecryptTensorNew(out)
+	require.NoError(t, err)
+
+	want := s.RunExact(data)
+	require.Len(t, have, len(want))
+
+	for i := range want {
+		stats := hefloat.GetPrecisionStats(params, ecd, nil, want[i].RawMatrix().Data, have[i].RawMatrix().Data, 0, true)
+		fmt.Println(stats)
+	}
+
+	accuracy, _ := utils.Precision(have, want)
+	require.Equal(t, 1.0, accuracy)
+	require.Greater(t, transport.BytesSent, 0)
+}
@@ -1,6 +1,8 @@
 package weights
 
 import (
+	"fmt"
+
 	"app/lib"
 	"app/utils"
 
@@ -22,7 +24,8 @@ func LoadEmbeddingLUT(path string) (w *mat.Dense) {
 		panic(err)
 	}
 
-	return mat.NewDense(25, lib.Cols, data[0][:25*lib.Cols])
+	rows := int(lib.K) + 2 // padding + tokens 0, ..., K
+	return mat.NewDense(rows, lib.Cols, data[0][:rows*lib.Cols])
 }
 
 func LoadTransformerBlockValueWeights(path string) (w *mat.Dense, b []float64) {
@@ -85,22 +88,46 @@ func LoadTransformerBlockNorm2Weights(path string) (gamma, beta []float64) {
 	return weights[0][:lib.Cols], weights[0][128 : 128+lib.Cols]
 }
 
+// LoadTransformerBlockFNNWeights returns the weights and biases of the two
+// layers of the FNN, stored as Cols x H, H, H x Cols and Cols values, whose
+// hidden width H is inferred from the length of the file. It panics if the
+// length is not of this form or if the file has several rows.
 func LoadTransformerBlockFNNWeights(path string) (w0 *mat.Dense, b0 []float64, w1 *mat.Dense, b1 []float64) {
 
-	weights, err := utils.ReadFile(path+"/transformer_block_fnn_weights.csv", ',', 0, false, lib.NumCPU)
+	file := path + "/transformer_block_fnn_weights.csv"
+
+	weights, err := utils.ReadFile(file, ',', 0, false, lib.NumCPU)
 	if err != nil {
 		panic(err)
 	}
 
+	if len(weights) != 1 {
+		panic(fmt.Errorf("invalid %s: #rows=%d != 1", file, len(weights)))
+	}
+
+	n := len(weights[0])
+
+	// n = Cols*H + H + H*Cols + Cols
+	if n <= lib.Cols || (n-lib.Cols)%(2*lib.Cols+1) != 0 {
+		panic(fmt.Errorf("invalid %s: %d values is not Cols*H + H + H*Cols + Cols for Cols=%d", file, n, lib.Cols))
+	}
+
+	hidden := (n - lib.Cols) / (2*lib.Cols + 1)
+
 	var ptr int
-	w0 = mat.NewDense(lib.Cols, 2*lib.Cols, weights[0][ptr:ptr+lib.Cols*2*lib.Cols])
-	ptr += 128 * 256
-	b0 = weights[0][ptr : ptr+2*lib.Cols]
-	ptr += 256
-	w1 = mat.NewDense(2*lib.Cols, lib.Cols, weights[0][ptr:ptr+2*lib.Cols*lib.Cols])
-	ptr += 256 * 128
+	w0 = mat.NewDense(lib.Cols, hidden, weights[0][ptr:ptr+lib.Cols*hidden])
+	ptr += lib.Cols * hidden
+	b0 = weights[0][ptr : ptr+hidden]
+	ptr += hidden
+	w1 = mat.NewDense(hidden, lib.Cols, weights[0][ptr:ptr+hidden*lib.Cols])
+	ptr += hidden * lib.Cols
 	b1 = weights[0][ptr : ptr+lib.Cols]
-	ptr += 128
+	ptr += lib.Cols
+
+	if ptr != n {
+		panic(fmt.Errorf("invalid %s: %d values read out of %d", file, ptr, n))
+	}
+
 	return
 }
 